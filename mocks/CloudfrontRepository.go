@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	context "context"
 	cloudfront "github.com/aws/aws-sdk-go/service/cloudfront"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +13,13 @@ type CloudfrontRepository struct {
 	mock.Mock
 }
 
-// ListAllDistributions provides a mock function with given fields:
-func (_m *CloudfrontRepository) ListAllDistributions() ([]*cloudfront.DistributionSummary, error) {
-	ret := _m.Called()
+// ListAllDistributions provides a mock function with given fields: _a0
+func (_m *CloudfrontRepository) ListAllDistributions(_a0 context.Context) ([]*cloudfront.DistributionSummary, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*cloudfront.DistributionSummary
-	if rf, ok := ret.Get(0).(func() []*cloudfront.DistributionSummary); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*cloudfront.DistributionSummary); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*cloudfront.DistributionSummary)
@@ -26,8 +27,8 @@ func (_m *CloudfrontRepository) ListAllDistributions() ([]*cloudfront.Distributi
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
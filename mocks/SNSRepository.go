@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	context "context"
 	mock "github.com/stretchr/testify/mock"
 
 	sns "github.com/aws/aws-sdk-go/service/sns"
@@ -13,13 +14,13 @@ type SNSRepository struct {
 	mock.Mock
 }
 
-// ListAllSubscriptions provides a mock function with given fields:
-func (_m *SNSRepository) ListAllSubscriptions() ([]*sns.Subscription, error) {
-	ret := _m.Called()
+// ListAllSubscriptions provides a mock function with given fields: _a0
+func (_m *SNSRepository) ListAllSubscriptions(_a0 context.Context) ([]*sns.Subscription, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*sns.Subscription
-	if rf, ok := ret.Get(0).(func() []*sns.Subscription); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*sns.Subscription); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*sns.Subscription)
@@ -27,8 +28,8 @@ func (_m *SNSRepository) ListAllSubscriptions() ([]*sns.Subscription, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -36,13 +37,13 @@ func (_m *SNSRepository) ListAllSubscriptions() ([]*sns.Subscription, error) {
 	return r0, r1
 }
 
-// ListAllTopics provides a mock function with given fields:
-func (_m *SNSRepository) ListAllTopics() ([]*sns.Topic, error) {
-	ret := _m.Called()
+// ListAllTopics provides a mock function with given fields: _a0
+func (_m *SNSRepository) ListAllTopics(_a0 context.Context) ([]*sns.Topic, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*sns.Topic
-	if rf, ok := ret.Get(0).(func() []*sns.Topic); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*sns.Topic); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*sns.Topic)
@@ -50,8 +51,8 @@ func (_m *SNSRepository) ListAllTopics() ([]*sns.Topic, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
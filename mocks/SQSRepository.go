@@ -2,20 +2,24 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // SQSRepository is an autogenerated mock type for the SQSRepository type
 type SQSRepository struct {
 	mock.Mock
 }
 
-// ListAllQueues provides a mock function with given fields:
-func (_m *SQSRepository) ListAllQueues() ([]*string, error) {
-	ret := _m.Called()
+// ListAllQueues provides a mock function with given fields: _a0
+func (_m *SQSRepository) ListAllQueues(_a0 context.Context) ([]*string, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*string
-	if rf, ok := ret.Get(0).(func() []*string); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*string); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*string)
@@ -23,8 +27,8 @@ func (_m *SQSRepository) ListAllQueues() ([]*string, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1,6 +1,10 @@
 package alerter
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
 
 type Alerts map[string][]Alert
 
@@ -9,6 +13,12 @@ type Alert interface {
 	ShouldIgnoreResource() bool
 }
 
+// LocatedAlert is implemented by alerts about resources of a single account or region
+type LocatedAlert interface {
+	Alert
+	Location() resource.Meta
+}
+
 type FakeAlert struct {
 	Msg            string
 	IgnoreResource bool
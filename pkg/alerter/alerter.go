@@ -2,6 +2,7 @@ package alerter
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/cloudskiff/driftctl/pkg/resource"
 )
@@ -11,6 +12,7 @@ type AlerterInterface interface {
 }
 
 type Alerter struct {
+	lock     sync.RWMutex
 	alerts   Alerts
 	alertsCh chan Alerts
 	doneCh   chan bool
@@ -31,6 +33,7 @@ func NewAlerter() *Alerter {
 func (a *Alerter) run() {
 	defer func() { a.doneCh <- true }()
 	for alert := range a.alertsCh {
+		a.lock.Lock()
 		for k, v := range alert {
 			if val, ok := a.alerts[k]; ok {
 				a.alerts[k] = append(val, v...)
@@ -38,16 +41,21 @@ func (a *Alerter) run() {
 				a.alerts[k] = v
 			}
 		}
+		a.lock.Unlock()
 	}
 }
 
 func (a *Alerter) SetAlerts(alerts Alerts) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
 	a.alerts = alerts
 }
 
 func (a *Alerter) Retrieve() Alerts {
 	close(a.alertsCh)
 	<-a.doneCh
+	a.lock.RLock()
+	defer a.lock.RUnlock()
 	return a.alerts
 }
 
@@ -58,13 +66,22 @@ func (a *Alerter) SendAlert(key string, alert Alert) {
 }
 
 func (a *Alerter) IsResourceIgnored(res resource.Resource) bool {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
 	alert, alertExists := a.alerts[fmt.Sprintf("%s.%s", res.TerraformType(), res.TerraformId())]
 	wildcardAlert, wildcardAlertExists := a.alerts[res.TerraformType()]
 	shouldIgnoreAlert := a.shouldBeIgnored(alert)
-	shouldIgnoreWildcardAlert := a.shouldBeIgnored(wildcardAlert)
+	shouldIgnoreWildcardAlert := a.shouldBeIgnoredAt(wildcardAlert, resource.MetadataOf(res))
 	return (alertExists && shouldIgnoreAlert) || (wildcardAlertExists && shouldIgnoreWildcardAlert)
 }
 
+// IsTypeIgnored returns true when resources of the given type are ignored, e.g. as they could not be enumerated
+func (a *Alerter) IsTypeIgnored(ty resource.ResourceType) bool {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	return a.shouldBeIgnored(a.alerts[ty.String()])
+}
+
 func (a *Alerter) shouldBeIgnored(alert []Alert) bool {
 	for _, a := range alert {
 		if a.ShouldIgnoreResource() {
@@ -73,3 +90,17 @@ func (a *Alerter) shouldBeIgnored(alert []Alert) bool {
 	}
 	return false
 }
+
+// shouldBeIgnoredAt is like shouldBeIgnored, alerts about a single account or region only ignore resources found there
+func (a *Alerter) shouldBeIgnoredAt(alert []Alert, location resource.Meta) bool {
+	for _, a := range alert {
+		if !a.ShouldIgnoreResource() {
+			continue
+		}
+		if located, ok := a.(LocatedAlert); ok && !resource.SameLocation(located.Location(), location) {
+			continue
+		}
+		return true
+	}
+	return false
+}
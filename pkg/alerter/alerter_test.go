@@ -160,3 +160,88 @@ func TestAlerter_IgnoreResources(t *testing.T) {
 		})
 	}
 }
+
+func TestAlerter_IsTypeIgnored(t *testing.T) {
+	alerter := NewAlerter()
+	alerter.SetAlerts(Alerts{
+		"fakeres": {
+			&FakeAlert{"Should not be ignored", false},
+			&FakeAlert{"Should be ignored", true},
+		},
+		"other": {
+			&FakeAlert{"Should not be ignored", false},
+		},
+		"ignored.foobar": {
+			&FakeAlert{"Should be ignored", true},
+		},
+	})
+
+	cases := map[resource.ResourceType]bool{
+		"fakeres": true,
+		"other":   false,
+		"ignored": false,
+		"unknown": false,
+	}
+	for ty, expected := range cases {
+		if got := alerter.IsTypeIgnored(ty); got != expected {
+			t.Errorf("Got %+v for %s, expected %+v", got, ty, expected)
+		}
+	}
+}
+
+type fakeLocatedAlert struct {
+	FakeAlert
+	location resource.Meta
+}
+
+func (f *fakeLocatedAlert) Location() resource.Meta {
+	return f.location
+}
+
+func TestAlerter_IsResourceIgnored_InLocation(t *testing.T) {
+	alerter := NewAlerter()
+	alerter.SetAlerts(Alerts{
+		"fakeres": {
+			&fakeLocatedAlert{FakeAlert{"Should be ignored in us-east-1", true}, resource.Meta{Account: "123456789", Region: "us-east-1"}},
+		},
+	})
+
+	cases := map[string]struct {
+		meta     resource.Meta
+		expected bool
+	}{
+		"same location":  {resource.Meta{Account: "123456789", Region: "us-east-1"}, true},
+		"other region":   {resource.Meta{Account: "123456789", Region: "eu-west-3"}, false},
+		"other account":  {resource.Meta{Account: "987654321", Region: "us-east-1"}, false},
+		"no location":    {resource.Meta{}, true},
+		"only in region": {resource.Meta{Region: "us-east-1"}, true},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			res := &resource.AbstractResource{Id: "foobar", Type: "fakeres", Meta: c.meta}
+			if got := alerter.IsResourceIgnored(res); got != c.expected {
+				t.Errorf("Got %+v, expected %+v", got, c.expected)
+			}
+		})
+	}
+}
+
+func TestAlerter_ConcurrentReadsAndAlerts(t *testing.T) {
+	alerter := NewAlerter()
+	done := make(chan bool)
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			alerter.SendAlert("fakeres", &FakeAlert{"Should be ignored", true})
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		alerter.IsTypeIgnored("fakeres")
+		alerter.IsResourceIgnored(&resource2.FakeResource{Type: "fakeres", Id: "foobar"})
+	}
+	<-done
+
+	if got := len(alerter.Retrieve()["fakeres"]); got != 100 {
+		t.Errorf("Got %d alerts, expected 100", got)
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/cloudskiff/driftctl/pkg/alerter"
 	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/stats"
 )
 
 type Change struct {
@@ -34,14 +35,37 @@ type Summary struct {
 	TotalManaged   int `json:"total_managed"`
 }
 
+// IncompleteScan tells that resources of a type could not be enumerated in an account or region,
+// those resources are neither reported as missing nor as unmanaged
+type IncompleteScan struct {
+	Type    string `json:"type"`
+	Account string `json:"account,omitempty"`
+	Region  string `json:"region,omitempty"`
+	Error   string `json:"error"`
+}
+
+// IgnoreRule is a rule of an ignore file, reported when it ignored nothing or expired
+type IgnoreRule struct {
+	Rule    string `json:"rule"`
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Owner   string `json:"owner,omitempty"`
+	Expires string `json:"expires,omitempty"`
+}
+
 type Analysis struct {
-	unmanaged   []resource.Resource
-	managed     []resource.Resource
-	deleted     []resource.Resource
-	differences []Difference
-	summary     Summary
-	alerts      alerter.Alerts
-	Duration    time.Duration
+	unmanaged       []resource.Resource
+	managed         []resource.Resource
+	deleted         []resource.Resource
+	differences     []Difference
+	summary         Summary
+	alerts          alerter.Alerts
+	incompleteScans []IncompleteScan
+	unusedRules     []IgnoreRule
+	expiredRules    []IgnoreRule
+	stats           *stats.Report
+	baseline        *Baseline
+	Duration        time.Duration
 }
 
 type serializableDifference struct {
@@ -57,6 +81,13 @@ type serializableAnalysis struct {
 	Differences []serializableDifference               `json:"differences"`
 	Coverage    int                                    `json:"coverage"`
 	Alerts      map[string][]alerter.SerializableAlert `json:"alerts"`
+	Accounts    map[string]Summary                     `json:"accounts,omitempty"`
+	Providers   map[string]Summary                     `json:"providers,omitempty"`
+	Incomplete  []IncompleteScan                       `json:"incomplete_scans,omitempty"`
+	Unused      []IgnoreRule                           `json:"unused_ignore_rules,omitempty"`
+	Expired     []IgnoreRule                           `json:"expired_ignore_rules,omitempty"`
+	Stats       *stats.Report                          `json:"stats,omitempty"`
+	Baseline    *serializableBaseline                  `json:"baseline,omitempty"`
 }
 
 type GenDriftIgnoreOptions struct {
@@ -64,6 +95,13 @@ type GenDriftIgnoreOptions struct {
 	ExcludeDeleted   bool
 	ExcludeDrifted   bool
 	InputPath        string
+	// Fields ignores changed fields of changed resources instead of whole resources
+	Fields bool
+	// WildcardThreshold is the number of resources of a type a field has to change on to be ignored
+	// for every resource of this type, 0 disables it
+	WildcardThreshold int
+	// MergePath is an existing ignore file to add missing rules to
+	MergePath string
 }
 
 func (a Analysis) MarshalJSON() ([]byte, error) {
@@ -93,6 +131,18 @@ func (a Analysis) MarshalJSON() ([]byte, error) {
 	}
 	bla.Summary = a.summary
 	bla.Coverage = a.Coverage()
+	bla.Accounts = a.SummaryByAccount()
+	bla.Incomplete = a.incompleteScans
+	bla.Unused = a.unusedRules
+	bla.Expired = a.expiredRules
+	bla.Stats = a.stats
+	if a.baseline != nil {
+		bla.Baseline = a.baseline.serializable()
+	}
+	// Summaries are only split by provider when several ones were scanned
+	if providers := a.SummaryByProvider(); len(providers) > 1 {
+		bla.Providers = providers
+	}
 
 	return json.Marshal(bla)
 }
@@ -102,33 +152,33 @@ func (a *Analysis) UnmarshalJSON(bytes []byte) error {
 	if err := json.Unmarshal(bytes, &bla); err != nil {
 		return err
 	}
+	serialized := func(res resource.Resource) *resource.SerializedResource {
+		return &resource.SerializedResource{
+			Id:                      res.TerraformId(),
+			Type:                    res.TerraformType(),
+			Meta:                    resource.MetadataOf(res),
+			HumanReadableAttributes: resource.HumanReadableAttributes(res),
+		}
+	}
 	for _, u := range bla.Unmanaged {
-		a.AddUnmanaged(&resource.SerializedResource{
-			Id:   u.TerraformId(),
-			Type: u.TerraformType(),
-		})
+		a.AddUnmanaged(serialized(u.Resource))
 	}
 	for _, d := range bla.Deleted {
-		a.AddDeleted(&resource.SerializedResource{
-			Id:   d.TerraformId(),
-			Type: d.TerraformType(),
-		})
+		a.AddDeleted(serialized(d.Resource))
 	}
 	for _, m := range bla.Managed {
-		a.AddManaged(&resource.SerializedResource{
-			Id:   m.TerraformId(),
-			Type: m.TerraformType(),
-		})
+		a.AddManaged(serialized(m.Resource))
 	}
 	for _, di := range bla.Differences {
 		a.AddDifference(Difference{
-			Res: &resource.SerializedResource{
-				Id:   di.Res.TerraformId(),
-				Type: di.Res.TerraformType(),
-			},
+			Res:       serialized(di.Res.Resource),
 			Changelog: di.Changelog,
 		})
 	}
+	a.AddIncompleteScan(bla.Incomplete...)
+	a.AddUnusedIgnoreRules(bla.Unused...)
+	a.AddExpiredIgnoreRules(bla.Expired...)
+	a.stats = bla.Stats
 	if len(bla.Alerts) > 0 {
 		a.alerts = make(alerter.Alerts)
 		for k, v := range bla.Alerts {
@@ -169,6 +219,18 @@ func (a *Analysis) AddDifference(diffs ...Difference) {
 	a.summary.TotalDrifted += len(diffs)
 }
 
+func (a *Analysis) AddIncompleteScan(scans ...IncompleteScan) {
+	a.incompleteScans = append(a.incompleteScans, scans...)
+}
+
+func (a *Analysis) AddUnusedIgnoreRules(rules ...IgnoreRule) {
+	a.unusedRules = append(a.unusedRules, rules...)
+}
+
+func (a *Analysis) AddExpiredIgnoreRules(rules ...IgnoreRule) {
+	a.expiredRules = append(a.expiredRules, rules...)
+}
+
 func (a *Analysis) SetAlerts(alerts alerter.Alerts) {
 	a.alerts = alerts
 }
@@ -200,6 +262,100 @@ func (a *Analysis) Summary() Summary {
 	return a.summary
 }
 
+// SummaryByAccount returns a summary for each account resources were read from.
+// Resources without account, like resources missing on cloud provider, are not counted.
+func (a *Analysis) SummaryByAccount() map[string]Summary {
+	return a.summaryBy(func(res resource.Resource) string {
+		return resource.MetadataOf(res).Account
+	})
+}
+
+// SummaryByProvider returns a summary for each provider, named after the prefix of resource types (e.g. aws)
+func (a *Analysis) SummaryByProvider() map[string]Summary {
+	return a.summaryBy(func(res resource.Resource) string {
+		return strings.SplitN(res.TerraformType(), "_", 2)[0]
+	})
+}
+
+// SummaryByType returns a summary for each resource type
+func (a *Analysis) SummaryByType() map[string]Summary {
+	return a.summaryBy(func(res resource.Resource) string {
+		return res.TerraformType()
+	})
+}
+
+// summaryBy splits the summary by the key of each resource, resources with an empty key are not counted
+func (a *Analysis) summaryBy(keyOf func(res resource.Resource) string) map[string]Summary {
+	summaries := make(map[string]Summary)
+	count := func(res resource.Resource, update func(summary *Summary)) {
+		key := keyOf(res)
+		if key == "" {
+			return
+		}
+		summary := summaries[key]
+		update(&summary)
+		summaries[key] = summary
+	}
+
+	for _, res := range a.managed {
+		count(res, func(summary *Summary) {
+			summary.TotalResources++
+			summary.TotalManaged++
+		})
+	}
+	for _, res := range a.unmanaged {
+		count(res, func(summary *Summary) {
+			summary.TotalResources++
+			summary.TotalUnmanaged++
+		})
+	}
+	for _, res := range a.deleted {
+		count(res, func(summary *Summary) {
+			summary.TotalResources++
+			summary.TotalDeleted++
+		})
+	}
+	for _, d := range a.differences {
+		count(d.Res, func(summary *Summary) {
+			summary.TotalDrifted++
+		})
+	}
+
+	if len(summaries) == 0 {
+		return nil
+	}
+	return summaries
+}
+
+// IncompleteScans returns resource types that could not be enumerated
+func (a *Analysis) IncompleteScans() []IncompleteScan {
+	return a.incompleteScans
+}
+
+// IsComplete returns false when resources of at least one type could not be enumerated
+func (a *Analysis) IsComplete() bool {
+	return len(a.incompleteScans) == 0
+}
+
+// UnusedIgnoreRules returns rules of ignore files that ignored nothing during the scan
+func (a *Analysis) UnusedIgnoreRules() []IgnoreRule {
+	return a.unusedRules
+}
+
+// ExpiredIgnoreRules returns rules of ignore files that were not applied as they expired
+func (a *Analysis) ExpiredIgnoreRules() []IgnoreRule {
+	return a.expiredRules
+}
+
+func (a *Analysis) SetStats(stats *stats.Report) {
+	a.stats = stats
+}
+
+// Stats returns timings and API calls of the scan, nil when they were not recorded
+func (a *Analysis) Stats() *stats.Report {
+	return a.stats
+}
+
 func (a *Analysis) Alerts() alerter.Alerts {
 	return a.alerts
 }
@@ -236,7 +392,12 @@ func (a *Analysis) DriftIgnoreList(opts GenDriftIgnoreOptions) (int, string) {
 		list = append(list, "# Missing resources")
 		addResources(a.Deleted()...)
 	}
-	if !opts.ExcludeDrifted && a.Summary().TotalDrifted > 0 {
+	if !opts.ExcludeDrifted && a.Summary().TotalDrifted > 0 && opts.Fields {
+		list = append(list, "# Changed fields")
+		list = append(list, changedFieldRules(a.Differences(), opts.WildcardThreshold)...)
+		resourceCount += len(a.Differences())
+	}
+	if !opts.ExcludeDrifted && a.Summary().TotalDrifted > 0 && !opts.Fields {
 		list = append(list, "# Changed resources")
 		addDifferences(a.Differences()...)
 	}
@@ -244,6 +405,50 @@ func (a *Analysis) DriftIgnoreList(opts GenDriftIgnoreOptions) (int, string) {
 	return resourceCount, strings.Join(list, "\n")
 }
 
+// changedFieldRules returns a type.id.path rule for each changed field, a field that changed on
+// at least threshold resources of a type is rather ignored for all of them with a type.*.path rule
+func changedFieldRules(diffs []Difference, threshold int) []string {
+	type field struct {
+		ty, path string
+	}
+	changedOn := make(map[field]map[string]struct{})
+	for _, d := range diffs {
+		for _, change := range d.Changelog {
+			f := field{d.Res.TerraformType(), escapePath(change.Path)}
+			if changedOn[f] == nil {
+				changedOn[f] = make(map[string]struct{})
+			}
+			changedOn[f][d.Res.TerraformId()] = struct{}{}
+		}
+	}
+
+	var rules []string
+	seen := make(map[string]struct{})
+	for _, d := range diffs {
+		for _, change := range d.Changelog {
+			f := field{d.Res.TerraformType(), escapePath(change.Path)}
+			rule := fmt.Sprintf("%s.%s.%s", f.ty, escapeKey(d.Res.TerraformId()), f.path)
+			if threshold > 0 && len(changedOn[f]) >= threshold {
+				rule = fmt.Sprintf("%s.*.%s", f.ty, f.path)
+			}
+			if _, exists := seen[rule]; exists {
+				continue
+			}
+			seen[rule] = struct{}{}
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+func escapePath(path []string) string {
+	escaped := make([]string, 0, len(path))
+	for _, key := range path {
+		escaped = append(escaped, escapeKey(key))
+	}
+	return strings.Join(escaped, ".")
+}
+
 func SortDifferences(diffs []Difference) []Difference {
 	sort.SliceStable(diffs, func(i, j int) bool {
 		if diffs[i].Res.TerraformType() != diffs[j].Res.TerraformType() {
@@ -1,6 +1,10 @@
 package analyser
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
 	"github.com/r3labs/diff/v2"
 
@@ -36,6 +40,92 @@ func (c *ComputedDiffAlert) ShouldIgnoreResource() bool {
 	return false
 }
 
+// ScanIncompleteAlert is sent when resources of a type could not be enumerated in an account or region.
+// Resources of this type and location are ignored, as they would be reported as missing otherwise.
+type ScanIncompleteAlert struct {
+	resourceType string
+	location     resource.Meta
+	err          error
+}
+
+func NewScanIncompleteAlert(resourceType string, location resource.Meta, err error) *ScanIncompleteAlert {
+	return &ScanIncompleteAlert{resourceType, location, err}
+}
+
+func (s *ScanIncompleteAlert) Message() string {
+	if s.location.Account == "" && s.location.Region == "" {
+		return fmt.Sprintf("Ignoring %s from drift calculation: Scan incomplete: %s", s.resourceType, s.err)
+	}
+	return fmt.Sprintf("Ignoring %s in %s from drift calculation: Scan incomplete: %s", s.resourceType, describeLocation(s.location), s.err)
+}
+
+// Location returns the account and region resources could not be enumerated in, unknown fields stand for any
+func (s *ScanIncompleteAlert) Location() resource.Meta {
+	return s.location
+}
+
+func (s *ScanIncompleteAlert) ShouldIgnoreResource() bool {
+	return true
+}
+
+// ExpiredIgnoreRuleAlert is sent for each rule of an ignore file that was not applied as it expired
+type ExpiredIgnoreRuleAlert struct {
+	rule IgnoreRule
+}
+
+func NewExpiredIgnoreRuleAlert(rule IgnoreRule) *ExpiredIgnoreRuleAlert {
+	return &ExpiredIgnoreRuleAlert{rule}
+}
+
+func (e *ExpiredIgnoreRuleAlert) Message() string {
+	msg := fmt.Sprintf("Ignore rule %s (%s:%d) expired on %s and is no longer applied", e.rule.Rule, e.rule.File, e.rule.Line, e.rule.Expires)
+	if e.rule.Owner != "" {
+		msg += fmt.Sprintf(", owner: %s", e.rule.Owner)
+	}
+	return msg
+}
+
+func (e *ExpiredIgnoreRuleAlert) ShouldIgnoreResource() bool {
+	return false
+}
+
+// AmbiguousResourceAlert is sent when a resource from IaC matches remote resources of several accounts or regions,
+// as the one it manages cannot be told apart. Its drift is not computed.
+type AmbiguousResourceAlert struct {
+	resourceType string
+	resourceId   string
+	locations    []resource.Meta
+}
+
+func NewAmbiguousResourceAlert(res resource.Resource, locations []resource.Meta) *AmbiguousResourceAlert {
+	return &AmbiguousResourceAlert{res.TerraformType(), res.TerraformId(), locations}
+}
+
+func (a *AmbiguousResourceAlert) Message() string {
+	locations := make([]string, 0, len(a.locations))
+	for _, location := range a.locations {
+		locations = append(locations, describeLocation(location))
+	}
+	return fmt.Sprintf("Unable to tell which resource %s.%s manages, it exists in %s: skipping its drift calculation", a.resourceType, a.resourceId, strings.Join(locations, ", "))
+}
+
+func (a *AmbiguousResourceAlert) ShouldIgnoreResource() bool {
+	return false
+}
+
+func describeLocation(location resource.Meta) string {
+	switch {
+	case location.Account != "" && location.Region != "":
+		return fmt.Sprintf("account %s in %s", location.Account, location.Region)
+	case location.Account != "":
+		return fmt.Sprintf("account %s", location.Account)
+	case location.Region != "":
+		return location.Region
+	default:
+		return "an unknown location"
+	}
+}
+
 type Analyzer struct {
 	alerter *alerter.Alerter
 }
@@ -45,6 +135,14 @@ type Filter interface {
 	IsFieldIgnored(res resource.Resource, path []string) bool
 }
 
+// IgnoreRuleReporter is implemented by filters able to tell which of their rules ignored nothing or expired.
+// Rules that may match resources of a type marked as unchecked are not reported as unused.
+type IgnoreRuleReporter interface {
+	MarkTypeUnchecked(ty resource.ResourceType)
+	UnusedRules() []IgnoreRule
+	ExpiredRules() []IgnoreRule
+}
+
 func NewAnalyzer(alerter *alerter.Alerter) Analyzer {
 	return Analyzer{alerter}
 }
@@ -54,62 +152,70 @@ func (a Analyzer) Analyze(remoteResources, resourcesFromState []resource.Resourc
 
 	// Iterate on remote resources and filter ignored resources
 	filteredRemoteResource := make([]resource.Resource, 0, len(remoteResources))
+	// Rules on attributes may only match one side of a managed resource, as attributes may have drifted.
+	// Ignored remote resources are kept aside so that their state counterpart is ignored too.
+	ignoredRemoteIndex := resource.NewIndex(nil)
+	// Fields of resources ignored by an alert are not checked against rules
+	alertIgnoredTypes := make(map[resource.ResourceType]struct{})
 	for _, remoteRes := range remoteResources {
-		if filter.IsResourceIgnored(remoteRes) || a.alerter.IsResourceIgnored(remoteRes) {
+		if filter.IsResourceIgnored(remoteRes) {
+			ignoredRemoteIndex.Add(remoteRes)
+			continue
+		}
+		if a.alerter.IsResourceIgnored(remoteRes) {
+			alertIgnoredTypes[resource.ResourceType(remoteRes.TerraformType())] = struct{}{}
+			ignoredRemoteIndex.Add(remoteRes)
 			continue
 		}
 		filteredRemoteResource = append(filteredRemoteResource, remoteRes)
 	}
 
+	// Index remote resources by type and id, matched ones are taken out of the
+	// index so it will remain only unmanaged ones
+	remoteIndex := resource.NewIndex(filteredRemoteResource)
+
 	haveComputedDiff := false
+	// Resources matching resources of several locations are only resolved once every other resource has been matched
+	var ambiguous []resource.Resource
 	for _, stateRes := range resourcesFromState {
-		i, remoteRes, found := findCorrespondingRes(filteredRemoteResource, stateRes)
-
-		if filter.IsResourceIgnored(stateRes) || a.alerter.IsResourceIgnored(stateRes) {
+		if filter.IsResourceIgnored(stateRes) {
+			remoteIndex.Take(stateRes)
 			continue
 		}
-
-		if !found {
-			analysis.AddDeleted(stateRes)
+		if a.alerter.IsResourceIgnored(stateRes) {
+			alertIgnoredTypes[resource.ResourceType(stateRes.TerraformType())] = struct{}{}
+			remoteIndex.Take(stateRes)
 			continue
 		}
-
-		// Remove managed resources, so it will remain only unmanaged ones
-		filteredRemoteResource = removeResourceByIndex(i, filteredRemoteResource)
-		analysis.AddManaged(stateRes)
-
-		var delta diff.Changelog
-		delta, _ = diff.Diff(stateRes.Attributes(), remoteRes.Attributes())
-
-		if len(delta) == 0 {
+		if len(matchingLocations(stateRes, remoteIndex, ignoredRemoteIndex)) > 1 {
+			ambiguous = append(ambiguous, stateRes)
 			continue
 		}
+		if a.analyzeStateResource(&analysis, stateRes, remoteIndex, ignoredRemoteIndex, filter) {
+			haveComputedDiff = true
+		}
+	}
 
-		changelog := make([]Change, 0, len(delta))
-		for _, change := range delta {
-			if filter.IsFieldIgnored(stateRes, change.Path) {
-				continue
+	for _, stateRes := range ambiguous {
+		if locations := matchingLocations(stateRes, remoteIndex, ignoredRemoteIndex); len(locations) > 1 {
+			a.alerter.SendAlert(fmt.Sprintf("%s.%s", stateRes.TerraformType(), stateRes.TerraformId()), NewAmbiguousResourceAlert(stateRes, locations))
+			// Any of the matching resources could be the managed one, none of them is reported as unmanaged
+			for {
+				if _, found := remoteIndex.Take(stateRes); !found {
+					break
+				}
 			}
-			c := Change{Change: change}
-			resSchema := stateRes.Schema()
-			if resSchema != nil {
-				c.Computed = resSchema.IsComputedField(c.Path)
-				c.JsonString = resSchema.IsJsonStringField(c.Path)
-			}
-			if c.Computed {
-				haveComputedDiff = true
-			}
-			changelog = append(changelog, c)
+			analysis.AddManaged(stateRes)
+			continue
 		}
-		if len(changelog) > 0 {
-			analysis.AddDifference(Difference{
-				Res:       stateRes,
-				Changelog: changelog,
-			})
+		if a.analyzeStateResource(&analysis, stateRes, remoteIndex, ignoredRemoteIndex, filter) {
+			haveComputedDiff = true
 		}
 	}
 
-	if a.hasUnmanagedSecurityGroupRules(filteredRemoteResource) {
+	unmanagedResources := remoteIndex.Remaining()
+
+	if a.hasUnmanagedSecurityGroupRules(unmanagedResources) {
 		a.alerter.SendAlert("", newUnmanagedSecurityGroupRulesAlert())
 	}
 
@@ -117,32 +223,134 @@ func (a Analyzer) Analyze(remoteResources, resourcesFromState []resource.Resourc
 		a.alerter.SendAlert("", NewComputedDiffAlert())
 	}
 
+	// Rules are only known to be unused once every resource went through the filter
+	if reporter, ok := filter.(IgnoreRuleReporter); ok {
+		// Resources of types the alerter ignores did not go through the filter
+		for _, ty := range resource.GetSupportedTypes() {
+			if a.alerter.IsTypeIgnored(ty) {
+				reporter.MarkTypeUnchecked(ty)
+			}
+		}
+		for ty := range alertIgnoredTypes {
+			reporter.MarkTypeUnchecked(ty)
+		}
+		analysis.AddUnusedIgnoreRules(reporter.UnusedRules()...)
+		analysis.AddExpiredIgnoreRules(reporter.ExpiredRules()...)
+		for _, rule := range reporter.ExpiredRules() {
+			a.alerter.SendAlert("", NewExpiredIgnoreRuleAlert(rule))
+		}
+	}
+
 	// Add remaining unmanaged resources
-	analysis.AddUnmanaged(filteredRemoteResource...)
+	analysis.AddUnmanaged(unmanagedResources...)
 
 	// Sort resources by Terraform Id
 	// The purpose is to have a predictable output
 	analysis.SortResources()
 
 	analysis.SetAlerts(a.alerter.Retrieve())
+	for _, alerts := range analysis.Alerts() {
+		for _, alert := range alerts {
+			if incomplete, ok := alert.(*ScanIncompleteAlert); ok {
+				analysis.AddIncompleteScan(IncompleteScan{
+					Type:    incomplete.resourceType,
+					Account: incomplete.location.Account,
+					Region:  incomplete.location.Region,
+					Error:   incomplete.err.Error(),
+				})
+			}
+		}
+	}
+	sort.SliceStable(analysis.incompleteScans, func(i, j int) bool {
+		a, b := analysis.incompleteScans[i], analysis.incompleteScans[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Account != b.Account {
+			return a.Account < b.Account
+		}
+		return a.Region < b.Region
+	})
 
 	return analysis, nil
 }
 
-func findCorrespondingRes(resources []resource.Resource, res resource.Resource) (int, resource.Resource, bool) {
-	for i, r := range resources {
-		if resource.IsSameResource(res, r) {
-			return i, r, true
+// analyzeStateResource matches a resource from IaC with its remote counterpart and computes its drift.
+// It returns true when a computed field drifted.
+func (a Analyzer) analyzeStateResource(analysis *Analysis, stateRes resource.Resource, remoteIndex, ignoredRemoteIndex *resource.Index, filter Filter) bool {
+	if ignoredRemoteIndex.Contains(stateRes) {
+		remoteIndex.Take(stateRes)
+		return false
+	}
+
+	remoteRes, found := remoteIndex.Take(stateRes)
+	if !found {
+		analysis.AddDeleted(stateRes)
+		return false
+	}
+
+	// State resources do not always know the account and the region they live in, inherit them from the remote one
+	if r, ok := stateRes.(resource.ResourceWithMeta); ok {
+		remoteMeta := resource.MetadataOf(remoteRes)
+		if r.Metadata().Account == "" {
+			r.Metadata().Account = remoteMeta.Account
+		}
+		if r.Metadata().Region == "" {
+			r.Metadata().Region = remoteMeta.Region
+		}
+	}
+
+	analysis.AddManaged(stateRes)
+
+	var delta diff.Changelog
+	delta, _ = diff.Diff(stateRes.Attributes(), remoteRes.Attributes())
+
+	if len(delta) == 0 {
+		return false
+	}
+
+	haveComputedDiff := false
+	changelog := make([]Change, 0, len(delta))
+	for _, change := range delta {
+		if filter.IsFieldIgnored(stateRes, change.Path) {
+			continue
+		}
+		c := Change{Change: change}
+		resSchema := stateRes.Schema()
+		if resSchema != nil {
+			c.Computed = resSchema.IsComputedField(c.Path)
+			c.JsonString = resSchema.IsJsonStringField(c.Path)
 		}
+		if c.Computed {
+			haveComputedDiff = true
+		}
+		changelog = append(changelog, c)
+	}
+	if len(changelog) > 0 {
+		analysis.AddDifference(Difference{
+			Res:       stateRes,
+			Changelog: changelog,
+		})
 	}
-	return -1, nil, false
+	return haveComputedDiff
 }
 
-func removeResourceByIndex(i int, resources []resource.Resource) []resource.Resource {
-	if i == len(resources)-1 {
-		return resources[:len(resources)-1]
+// matchingLocations returns the distinct accounts and regions of remote resources matching a resource from IaC
+func matchingLocations(stateRes resource.Resource, indexes ...*resource.Index) []resource.Meta {
+	var locations []resource.Meta
+	seen := make(map[resource.Meta]struct{})
+	for _, index := range indexes {
+		for _, match := range index.Matches(stateRes) {
+			meta := resource.MetadataOf(match)
+			location := resource.Meta{Account: meta.Account, Region: meta.Region}
+			if _, exist := seen[location]; exist {
+				continue
+			}
+			seen[location] = struct{}{}
+			locations = append(locations, location)
+		}
 	}
-	return append(resources[:i], resources[i+1:]...)
+	return locations
 }
 
 // hasUnmanagedSecurityGroupRules returns true if we find at least one unmanaged
@@ -2,6 +2,7 @@ package analyser
 
 import (
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -1047,6 +1048,302 @@ func TestAnalyze(t *testing.T) {
 	}
 }
 
+func TestAnalyze_IncompleteScan(t *testing.T) {
+	filter := &mocks.Filter{}
+	filter.On("IsResourceIgnored", mock.Anything).Return(false)
+	filter.On("IsFieldIgnored", mock.Anything, mock.Anything).Return(false)
+
+	al := alerter.NewAlerter()
+	al.SetAlerts(alerter.Alerts{
+		"aws_iam_role": {NewScanIncompleteAlert("aws_iam_role", resource.Meta{}, errors.New("Throttling: Rate exceeded"))},
+	})
+
+	managed := &resource.AbstractResource{Id: "bucket", Type: "aws_s3_bucket"}
+	analysis, err := NewAnalyzer(al).Analyze(
+		[]resource.Resource{
+			managed,
+			&resource.AbstractResource{Id: "unmanaged-role", Type: "aws_iam_role"},
+		},
+		[]resource.Resource{
+			&resource.AbstractResource{Id: "bucket", Type: "aws_s3_bucket"},
+			&resource.AbstractResource{Id: "missing-role", Type: "aws_iam_role"},
+		},
+		filter,
+	)
+
+	assert.Nil(t, err)
+	assert.False(t, analysis.IsComplete())
+	assert.Equal(t, []IncompleteScan{{Type: "aws_iam_role", Error: "Throttling: Rate exceeded"}}, analysis.IncompleteScans())
+	assert.Equal(t, Summary{TotalResources: 1, TotalManaged: 1}, analysis.Summary())
+}
+
+func TestAnalyze_IncompleteScanInOneRegion(t *testing.T) {
+	filter := &mocks.Filter{}
+	filter.On("IsResourceIgnored", mock.Anything).Return(false)
+	filter.On("IsFieldIgnored", mock.Anything, mock.Anything).Return(false)
+
+	al := alerter.NewAlerter()
+	al.SetAlerts(alerter.Alerts{
+		"aws_kms_key": {NewScanIncompleteAlert("aws_kms_key", resource.Meta{Account: "123456789", Region: "us-east-1"}, errors.New("Throttling: Rate exceeded"))},
+	})
+
+	analysis, err := NewAnalyzer(al).Analyze(
+		[]resource.Resource{
+			&resource.AbstractResource{Id: "key-us", Type: "aws_kms_key", Meta: resource.Meta{Account: "123456789", Region: "us-east-1"}},
+			&resource.AbstractResource{Id: "key-eu", Type: "aws_kms_key", Meta: resource.Meta{Account: "123456789", Region: "eu-west-3"}},
+		},
+		[]resource.Resource{},
+		filter,
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []IncompleteScan{{Type: "aws_kms_key", Account: "123456789", Region: "us-east-1", Error: "Throttling: Rate exceeded"}}, analysis.IncompleteScans())
+	assert.Equal(t, []resource.Resource{
+		&resource.AbstractResource{Id: "key-eu", Type: "aws_kms_key", Meta: resource.Meta{Account: "123456789", Region: "eu-west-3"}},
+	}, analysis.Unmanaged())
+}
+
+func TestScanIncompleteAlert_Message(t *testing.T) {
+	err := errors.New("Throttling: Rate exceeded")
+	assert.Equal(t, "Ignoring aws_iam_role from drift calculation: Scan incomplete: Throttling: Rate exceeded", NewScanIncompleteAlert("aws_iam_role", resource.Meta{}, err).Message())
+	assert.Equal(t, "Ignoring aws_kms_key in account 123456789 in us-east-1 from drift calculation: Scan incomplete: Throttling: Rate exceeded", NewScanIncompleteAlert("aws_kms_key", resource.Meta{Account: "123456789", Region: "us-east-1"}, err).Message())
+	assert.Equal(t, "Ignoring aws_kms_key in eu-west-3 from drift calculation: Scan incomplete: Throttling: Rate exceeded", NewScanIncompleteAlert("aws_kms_key", resource.Meta{Region: "eu-west-3"}, err).Message())
+}
+
+func TestAnalyze_ResourceIgnoredOnOneSide(t *testing.T) {
+	// Like an attribute rule matching a tag that only exists on the cloud provider
+	remoteInstance := &resource.AbstractResource{Id: "i-node", Type: "aws_instance", Attrs: &resource.Attributes{
+		"tags": map[string]interface{}{"kubernetes.io/cluster/prod": "owned"},
+	}}
+	stateBucket := &resource.AbstractResource{Id: "logs", Type: "aws_s3_bucket", Attrs: &resource.Attributes{
+		"tags": map[string]interface{}{"Sandbox": "true"},
+	}}
+	filter := &mocks.Filter{}
+	filter.On("IsResourceIgnored", remoteInstance).Return(true)
+	filter.On("IsResourceIgnored", stateBucket).Return(true)
+	filter.On("IsResourceIgnored", mock.Anything).Return(false)
+	filter.On("IsFieldIgnored", mock.Anything, mock.Anything).Return(false)
+
+	analysis, err := NewAnalyzer(alerter.NewAlerter()).Analyze(
+		[]resource.Resource{
+			remoteInstance,
+			&resource.AbstractResource{Id: "logs", Type: "aws_s3_bucket", Attrs: &resource.Attributes{}},
+		},
+		[]resource.Resource{
+			&resource.AbstractResource{Id: "i-node", Type: "aws_instance", Attrs: &resource.Attributes{}},
+			stateBucket,
+		},
+		filter,
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Summary{}, analysis.Summary())
+}
+
+func TestAnalyze_SameIdInSeveralRegions(t *testing.T) {
+	filter := &mocks.Filter{}
+	filter.On("IsResourceIgnored", mock.Anything).Return(false)
+	filter.On("IsFieldIgnored", mock.Anything, mock.Anything).Return(false)
+
+	remoteAlias := func(region string) *resource.AbstractResource {
+		return &resource.AbstractResource{
+			Id:    "alias/aws/ebs",
+			Type:  "aws_kms_alias",
+			Attrs: &resource.Attributes{"arn": "arn:aws:kms:" + region + ":123456789012:alias/aws/ebs"},
+			Meta:  resource.Meta{Account: "123456789012", Region: region},
+		}
+	}
+	stateAlias := &resource.AbstractResource{
+		Id:    "alias/aws/ebs",
+		Type:  "aws_kms_alias",
+		Attrs: &resource.Attributes{"arn": "arn:aws:kms:eu-west-3:123456789012:alias/aws/ebs"},
+		Meta:  resource.Meta{Region: "eu-west-3"},
+	}
+	usEast1, euWest3, usWest2 := remoteAlias("us-east-1"), remoteAlias("eu-west-3"), remoteAlias("us-west-2")
+
+	analysis, err := NewAnalyzer(alerter.NewAlerter()).Analyze(
+		[]resource.Resource{usEast1, euWest3, usWest2},
+		[]resource.Resource{stateAlias},
+		filter,
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []resource.Resource{stateAlias}, analysis.Managed())
+	assert.Equal(t, []resource.Resource{usEast1, usWest2}, analysis.Unmanaged())
+	assert.Empty(t, analysis.Differences())
+	assert.Equal(t, resource.Meta{Account: "123456789012", Region: "eu-west-3"}, stateAlias.Meta)
+}
+
+func TestAnalyze_SameIdInSeveralAccounts(t *testing.T) {
+	role := func(account string) *resource.AbstractResource {
+		return &resource.AbstractResource{
+			Id:    "OrganizationAccountAccessRole",
+			Type:  "aws_iam_role",
+			Attrs: &resource.Attributes{},
+			Meta:  resource.Meta{Account: account},
+		}
+	}
+
+	t.Run("resources are matched within their account", func(t *testing.T) {
+		filter := &mocks.Filter{}
+		filter.On("IsResourceIgnored", mock.Anything).Return(false)
+		filter.On("IsFieldIgnored", mock.Anything, mock.Anything).Return(false)
+
+		remoteA, remoteB, stateB := role("111111111111"), role("222222222222"), role("222222222222")
+		al := alerter.NewAlerter()
+		analysis, err := NewAnalyzer(al).Analyze(
+			[]resource.Resource{remoteA, remoteB},
+			[]resource.Resource{stateB},
+			filter,
+		)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []resource.Resource{stateB}, analysis.Managed())
+		assert.Equal(t, []resource.Resource{remoteA}, analysis.Unmanaged())
+		assert.Empty(t, analysis.Alerts())
+	})
+
+	t.Run("ignoring a resource of an account does not ignore the one of another account", func(t *testing.T) {
+		remoteA, remoteB, stateB := role("111111111111"), role("222222222222"), role("222222222222")
+		remoteB.Attrs = &resource.Attributes{"description": "drifted"}
+
+		filter := &mocks.Filter{}
+		filter.On("IsResourceIgnored", remoteA).Return(true)
+		filter.On("IsResourceIgnored", mock.Anything).Return(false)
+		filter.On("IsFieldIgnored", mock.Anything, mock.Anything).Return(false)
+
+		analysis, err := NewAnalyzer(alerter.NewAlerter()).Analyze(
+			[]resource.Resource{remoteA, remoteB},
+			[]resource.Resource{stateB},
+			filter,
+		)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []resource.Resource{stateB}, analysis.Managed())
+		assert.Empty(t, analysis.Unmanaged())
+		assert.Len(t, analysis.Differences(), 1)
+	})
+
+	t.Run("resources of an unknown account are matched once others are", func(t *testing.T) {
+		filter := &mocks.Filter{}
+		filter.On("IsResourceIgnored", mock.Anything).Return(false)
+		filter.On("IsFieldIgnored", mock.Anything, mock.Anything).Return(false)
+
+		remoteA, remoteB, stateA, unknown := role("111111111111"), role("222222222222"), role("111111111111"), role("")
+		analysis, err := NewAnalyzer(alerter.NewAlerter()).Analyze(
+			[]resource.Resource{remoteA, remoteB},
+			[]resource.Resource{unknown, stateA},
+			filter,
+		)
+
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []resource.Resource{unknown, stateA}, analysis.Managed())
+		assert.Empty(t, analysis.Unmanaged())
+		assert.Empty(t, analysis.Alerts())
+		assert.Equal(t, "222222222222", unknown.Meta.Account)
+	})
+
+	t.Run("ambiguous resources are reported in an alert", func(t *testing.T) {
+		filter := &mocks.Filter{}
+		filter.On("IsResourceIgnored", mock.Anything).Return(false)
+		filter.On("IsFieldIgnored", mock.Anything, mock.Anything).Return(false)
+
+		remoteA, remoteB, unknown := role("111111111111"), role("222222222222"), role("")
+		analysis, err := NewAnalyzer(alerter.NewAlerter()).Analyze(
+			[]resource.Resource{remoteA, remoteB},
+			[]resource.Resource{unknown},
+			filter,
+		)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []resource.Resource{unknown}, analysis.Managed())
+		assert.Empty(t, analysis.Unmanaged())
+		assert.Empty(t, analysis.Differences())
+		assert.Equal(t, alerter.Alerts{
+			"aws_iam_role.OrganizationAccountAccessRole": []alerter.Alert{
+				NewAmbiguousResourceAlert(unknown, []resource.Meta{{Account: "111111111111"}, {Account: "222222222222"}}),
+			},
+		}, analysis.Alerts())
+		assert.Equal(
+			t,
+			"Unable to tell which resource aws_iam_role.OrganizationAccountAccessRole manages, it exists in account 111111111111, account 222222222222: skipping its drift calculation",
+			analysis.Alerts()["aws_iam_role.OrganizationAccountAccessRole"][0].Message(),
+		)
+	})
+}
+
+type ruleReportingFilter struct {
+	*mocks.Filter
+	unused    []IgnoreRule
+	expired   []IgnoreRule
+	unchecked *[]resource.ResourceType
+}
+
+func (f ruleReportingFilter) MarkTypeUnchecked(ty resource.ResourceType) {
+	if f.unchecked != nil {
+		*f.unchecked = append(*f.unchecked, ty)
+	}
+}
+
+func (f ruleReportingFilter) UnusedRules() []IgnoreRule {
+	return f.unused
+}
+
+func (f ruleReportingFilter) ExpiredRules() []IgnoreRule {
+	return f.expired
+}
+
+func TestAnalyze_IgnoreRules(t *testing.T) {
+	filter := ruleReportingFilter{
+		Filter:  &mocks.Filter{},
+		unused:  []IgnoreRule{{Rule: "aws_s3_bucket.old-logs", File: ".driftignore", Line: 2}},
+		expired: []IgnoreRule{{Rule: "aws_instance.tmp-*", File: ".driftignore", Line: 4, Owner: "team-compute", Expires: "2021-06-30"}},
+	}
+	filter.On("IsResourceIgnored", mock.Anything).Return(false)
+
+	analysis, err := NewAnalyzer(alerter.NewAlerter()).Analyze(
+		[]resource.Resource{&resource.AbstractResource{Id: "tmp-1", Type: "aws_instance"}},
+		[]resource.Resource{},
+		filter,
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, filter.unused, analysis.UnusedIgnoreRules())
+	assert.Equal(t, filter.expired, analysis.ExpiredIgnoreRules())
+	assert.Equal(t, 1, analysis.Summary().TotalUnmanaged)
+	assert.Equal(t, alerter.Alerts{"": []alerter.Alert{NewExpiredIgnoreRuleAlert(filter.expired[0])}}, analysis.Alerts())
+	assert.Equal(t, "Ignore rule aws_instance.tmp-* (.driftignore:4) expired on 2021-06-30 and is no longer applied, owner: team-compute", analysis.Alerts()[""][0].Message())
+}
+
+func TestAnalyze_IgnoreRules_UncheckedTypes(t *testing.T) {
+	var unchecked []resource.ResourceType
+	filter := ruleReportingFilter{
+		Filter:    &mocks.Filter{},
+		unchecked: &unchecked,
+	}
+	filter.On("IsResourceIgnored", mock.Anything).Return(false)
+	filter.On("IsFieldIgnored", mock.Anything, mock.Anything).Return(false)
+
+	al := alerter.NewAlerter()
+	// Enumerating buckets failed, and a route is skipped on its own
+	al.SetAlerts(alerter.Alerts{
+		"aws_s3_bucket": {NewScanIncompleteAlert("aws_s3_bucket", resource.Meta{}, errors.New("access denied"))},
+		"aws_route.r-1": {&alerter.FakeAlert{Msg: "invalid route", IgnoreResource: true}},
+	})
+
+	_, err := NewAnalyzer(al).Analyze(
+		[]resource.Resource{&resource.AbstractResource{Id: "r-1", Type: "aws_route"}},
+		[]resource.Resource{
+			&resource.AbstractResource{Id: "logs", Type: "aws_s3_bucket"},
+			&resource.AbstractResource{Id: "r-1", Type: "aws_route"},
+		},
+		filter,
+	)
+
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []resource.ResourceType{"aws_s3_bucket", "aws_s3_bucket", "aws_route"}, unchecked)
+}
+
 func addSchemaToRes(res resource.Resource, repo resource.SchemaRepositoryInterface) {
 	abstractResource, ok := res.(*resource.AbstractResource)
 	if ok {
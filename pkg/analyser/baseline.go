@@ -0,0 +1,159 @@
+package analyser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+// Drift holds unmanaged, missing and changed resources of one baseline status
+type Drift struct {
+	Unmanaged   []resource.Resource
+	Deleted     []resource.Resource
+	Differences []Difference
+}
+
+func (d Drift) Len() int {
+	return len(d.Unmanaged) + len(d.Deleted) + len(d.Differences)
+}
+
+// Baseline tells which drift of an analysis was already reported by a previous one
+type Baseline struct {
+	// New drift was not reported by the previous analysis
+	New Drift
+	// StillPresent drift was already reported by the previous analysis
+	StillPresent Drift
+	// Resolved drift was reported by the previous analysis but is gone
+	Resolved Drift
+}
+
+// HasNewDrift returns true when at least one resource drifted since the previous analysis
+func (b *Baseline) HasNewDrift() bool {
+	return b.New.Len() > 0
+}
+
+type serializableDrift struct {
+	Unmanaged   []resource.SerializableResource `json:"unmanaged"`
+	Deleted     []resource.SerializableResource `json:"missing"`
+	Differences []serializableDifference        `json:"differences"`
+}
+
+type serializableBaseline struct {
+	New          serializableDrift `json:"new"`
+	StillPresent serializableDrift `json:"still_present"`
+	Resolved     serializableDrift `json:"resolved"`
+}
+
+func (d Drift) serializable() serializableDrift {
+	s := serializableDrift{
+		Unmanaged:   make([]resource.SerializableResource, 0, len(d.Unmanaged)),
+		Deleted:     make([]resource.SerializableResource, 0, len(d.Deleted)),
+		Differences: make([]serializableDifference, 0, len(d.Differences)),
+	}
+	for _, res := range d.Unmanaged {
+		s.Unmanaged = append(s.Unmanaged, resource.SerializableResource{Resource: res})
+	}
+	for _, res := range d.Deleted {
+		s.Deleted = append(s.Deleted, resource.SerializableResource{Resource: res})
+	}
+	for _, di := range d.Differences {
+		s.Differences = append(s.Differences, serializableDifference{
+			Res:       resource.SerializableResource{Resource: di.Res},
+			Changelog: di.Changelog,
+		})
+	}
+	return s
+}
+
+func (b *Baseline) serializable() *serializableBaseline {
+	return &serializableBaseline{
+		New:          b.New.serializable(),
+		StillPresent: b.StillPresent.serializable(),
+		Resolved:     b.Resolved.serializable(),
+	}
+}
+
+// CompareToBaseline classifies drift of the analysis as new, still present or resolved since the previous one.
+// Resources are matched on their type, id, account and region. A changed resource is new when it drifts
+// on attributes it did not drift on in the previous analysis.
+func (a *Analysis) CompareToBaseline(previous *Analysis) {
+	baseline := &Baseline{}
+
+	classify := func(current, previous []resource.Resource) (newRes, stillPresent, resolved []resource.Resource) {
+		seen := make(map[string]struct{}, len(previous))
+		for _, res := range previous {
+			seen[resourceKey(res)] = struct{}{}
+		}
+		found := make(map[string]struct{}, len(current))
+		for _, res := range current {
+			key := resourceKey(res)
+			found[key] = struct{}{}
+			if _, exist := seen[key]; exist {
+				stillPresent = append(stillPresent, res)
+				continue
+			}
+			newRes = append(newRes, res)
+		}
+		for _, res := range previous {
+			if _, exist := found[resourceKey(res)]; !exist {
+				resolved = append(resolved, res)
+			}
+		}
+		return
+	}
+	baseline.New.Unmanaged, baseline.StillPresent.Unmanaged, baseline.Resolved.Unmanaged = classify(a.unmanaged, previous.unmanaged)
+	baseline.New.Deleted, baseline.StillPresent.Deleted, baseline.Resolved.Deleted = classify(a.deleted, previous.deleted)
+
+	previousChanges := make(map[string]map[string]struct{}, len(previous.differences))
+	for _, d := range previous.differences {
+		previousChanges[resourceKey(d.Res)] = changedPaths(d.Changelog)
+	}
+	found := make(map[string]struct{}, len(a.differences))
+	for _, d := range a.differences {
+		key := resourceKey(d.Res)
+		found[key] = struct{}{}
+		paths, exist := previousChanges[key]
+		if !exist {
+			baseline.New.Differences = append(baseline.New.Differences, d)
+			continue
+		}
+		isNew := false
+		for path := range changedPaths(d.Changelog) {
+			if _, exist := paths[path]; !exist {
+				isNew = true
+				break
+			}
+		}
+		if isNew {
+			baseline.New.Differences = append(baseline.New.Differences, d)
+			continue
+		}
+		baseline.StillPresent.Differences = append(baseline.StillPresent.Differences, d)
+	}
+	for _, d := range previous.differences {
+		if _, exist := found[resourceKey(d.Res)]; !exist {
+			baseline.Resolved.Differences = append(baseline.Resolved.Differences, d)
+		}
+	}
+
+	a.baseline = baseline
+}
+
+// Baseline returns drift compared to a previous analysis, nil when there was no comparison
+func (a *Analysis) Baseline() *Baseline {
+	return a.baseline
+}
+
+func resourceKey(res resource.Resource) string {
+	meta := resource.MetadataOf(res)
+	return fmt.Sprintf("%s.%s.%s.%s", res.TerraformType(), res.TerraformId(), meta.Account, meta.Region)
+}
+
+func changedPaths(changelog Changelog) map[string]struct{} {
+	paths := make(map[string]struct{}, len(changelog))
+	for _, change := range changelog {
+		paths[strings.Join(change.Path, ".")] = struct{}{}
+	}
+	return paths
+}
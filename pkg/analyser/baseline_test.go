@@ -0,0 +1,69 @@
+package analyser
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+func TestAnalysis_CompareToBaseline(t *testing.T) {
+	bucket := func(id, region string) *resource.AbstractResource {
+		return &resource.AbstractResource{Id: id, Type: "aws_s3_bucket", Meta: resource.Meta{Region: region}}
+	}
+	change := func(path ...string) Change {
+		return Change{Change: diff.Change{Type: diff.UPDATE, Path: path, From: "a", To: "b"}}
+	}
+
+	previous := &Analysis{}
+	previous.AddUnmanaged(bucket("known", "us-east-1"), bucket("removed", "us-east-1"))
+	previous.AddDeleted(bucket("missing", ""))
+	previous.AddDifference(
+		Difference{Res: bucket("drifted", "us-east-1"), Changelog: Changelog{change("acl")}},
+		Difference{Res: bucket("more-drift", "us-east-1"), Changelog: Changelog{change("acl")}},
+		Difference{Res: bucket("fixed", "us-east-1"), Changelog: Changelog{change("acl")}},
+	)
+
+	// The previous analysis is read back from its JSON output, like scan --baseline does
+	content, err := json.Marshal(previous)
+	if err != nil {
+		t.Fatal(err)
+	}
+	baseline := &Analysis{}
+	if err := json.Unmarshal(content, baseline); err != nil {
+		t.Fatal(err)
+	}
+
+	drifted := Difference{Res: bucket("drifted", "us-east-1"), Changelog: Changelog{change("acl")}}
+	moreDrift := Difference{Res: bucket("more-drift", "us-east-1"), Changelog: Changelog{change("acl"), change("policy")}}
+	analysis := &Analysis{}
+	analysis.AddUnmanaged(bucket("known", "us-east-1"), bucket("known", "eu-west-3"), bucket("new", "us-east-1"))
+	analysis.AddDeleted(bucket("missing", ""))
+	analysis.AddDifference(drifted, moreDrift)
+
+	assert.Nil(t, analysis.Baseline())
+	analysis.CompareToBaseline(baseline)
+	got := analysis.Baseline()
+
+	assert.True(t, got.HasNewDrift())
+	assert.Equal(t, Drift{
+		Unmanaged:   []resource.Resource{bucket("known", "eu-west-3"), bucket("new", "us-east-1")},
+		Differences: []Difference{moreDrift},
+	}, got.New)
+	assert.Equal(t, Drift{
+		Unmanaged:   []resource.Resource{bucket("known", "us-east-1")},
+		Deleted:     []resource.Resource{bucket("missing", "")},
+		Differences: []Difference{drifted},
+	}, got.StillPresent)
+	assert.Equal(t, 2, got.Resolved.Len())
+	assert.Equal(t, "removed", got.Resolved.Unmanaged[0].TerraformId())
+	assert.Equal(t, "fixed", got.Resolved.Differences[0].Res.TerraformId())
+
+	// Drift of the baseline does not count anymore
+	analysis.CompareToBaseline(analysis)
+	assert.False(t, analysis.Baseline().HasNewDrift())
+	assert.Equal(t, 0, analysis.Baseline().Resolved.Len())
+}
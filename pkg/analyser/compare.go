@@ -0,0 +1,203 @@
+package analyser
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+// Category tells how a resource is reported in an analysis
+type Category string
+
+const (
+	CategoryManaged   Category = "managed"
+	CategoryUnmanaged Category = "unmanaged"
+	CategoryMissing   Category = "missing"
+	CategoryChanged   Category = "changed"
+)
+
+// ResourceDiff describes how a resource is reported differently by two analyses
+type ResourceDiff struct {
+	Res resource.Resource
+	// Categories of the resource in both analyses, empty when the resource is not part of one of them
+	Previous Category
+	Current  Category
+	// Changes only found in the current analysis, and changes only found in the previous one
+	NewChanges      Changelog
+	ResolvedChanges Changelog
+}
+
+// AnalysisDiff holds resources reported differently by two analyses
+type AnalysisDiff struct {
+	// Appeared resources are only part of the current analysis
+	Appeared []ResourceDiff
+	// Disappeared resources are only part of the previous analysis
+	Disappeared []ResourceDiff
+	// Moved resources are reported in another category by the current analysis
+	Moved []ResourceDiff
+	// Changed resources drift in both analyses but with different changes
+	Changed []ResourceDiff
+}
+
+func (d *AnalysisDiff) IsEmpty() bool {
+	return len(d.Appeared) == 0 && len(d.Disappeared) == 0 && len(d.Moved) == 0 && len(d.Changed) == 0
+}
+
+type categorizedResource struct {
+	res       resource.Resource
+	category  Category
+	changelog Changelog
+}
+
+// categorize returns resources of the analysis by key, in the order they are reported
+func (a *Analysis) categorize() ([]string, map[string]categorizedResource) {
+	keys := make([]string, 0, a.summary.TotalResources)
+	resources := make(map[string]categorizedResource, a.summary.TotalResources)
+	add := func(res resource.Resource, category Category, changelog Changelog) {
+		key := resourceKey(res)
+		if _, exist := resources[key]; !exist {
+			keys = append(keys, key)
+		}
+		resources[key] = categorizedResource{res, category, changelog}
+	}
+	for _, res := range a.managed {
+		add(res, CategoryManaged, nil)
+	}
+	// Changed resources are managed ones as well, they are categorized as changed
+	for _, d := range a.differences {
+		add(d.Res, CategoryChanged, d.Changelog)
+	}
+	for _, res := range a.unmanaged {
+		add(res, CategoryUnmanaged, nil)
+	}
+	for _, res := range a.deleted {
+		add(res, CategoryMissing, nil)
+	}
+	return keys, resources
+}
+
+// CompareAnalyses returns resources that appeared, disappeared or changed category between two analyses.
+// Resources are matched on their type, id, account and region.
+func CompareAnalyses(previous, current *Analysis) *AnalysisDiff {
+	result := &AnalysisDiff{}
+
+	previousKeys, previousResources := previous.categorize()
+	currentKeys, currentResources := current.categorize()
+
+	for _, key := range currentKeys {
+		cur := currentResources[key]
+		prev, exist := previousResources[key]
+		if !exist {
+			result.Appeared = append(result.Appeared, ResourceDiff{
+				Res:        cur.res,
+				Current:    cur.category,
+				NewChanges: cur.changelog,
+			})
+			continue
+		}
+
+		diff := ResourceDiff{
+			Res:             cur.res,
+			Previous:        prev.category,
+			Current:         cur.category,
+			NewChanges:      changesNotIn(cur.changelog, prev.changelog),
+			ResolvedChanges: changesNotIn(prev.changelog, cur.changelog),
+		}
+		if prev.category != cur.category {
+			result.Moved = append(result.Moved, diff)
+			continue
+		}
+		if len(diff.NewChanges) > 0 || len(diff.ResolvedChanges) > 0 {
+			result.Changed = append(result.Changed, diff)
+		}
+	}
+
+	for _, key := range previousKeys {
+		if _, exist := currentResources[key]; exist {
+			continue
+		}
+		prev := previousResources[key]
+		result.Disappeared = append(result.Disappeared, ResourceDiff{
+			Res:             prev.res,
+			Previous:        prev.category,
+			ResolvedChanges: prev.changelog,
+		})
+	}
+
+	return result
+}
+
+// changesNotIn returns changes of changelog that are not part of other
+func changesNotIn(changelog, other Changelog) Changelog {
+	var result Changelog
+	for _, change := range changelog {
+		found := false
+		for _, o := range other {
+			if change.Type == o.Type && reflect.DeepEqual(change.Path, o.Path) &&
+				reflect.DeepEqual(change.From, o.From) && reflect.DeepEqual(change.To, o.To) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, change)
+		}
+	}
+	return result
+}
+
+type serializableResourceDiff struct {
+	Res             resource.SerializableResource `json:"res"`
+	Previous        Category                      `json:"previous,omitempty"`
+	Current         Category                      `json:"current,omitempty"`
+	NewChanges      Changelog                     `json:"new_changes,omitempty"`
+	ResolvedChanges Changelog                     `json:"resolved_changes,omitempty"`
+}
+
+type AnalysisDiffSummary struct {
+	Appeared    int `json:"total_appeared"`
+	Disappeared int `json:"total_disappeared"`
+	Moved       int `json:"total_moved"`
+	Changed     int `json:"total_changed"`
+}
+
+type serializableAnalysisDiff struct {
+	Summary     AnalysisDiffSummary        `json:"summary"`
+	Appeared    []serializableResourceDiff `json:"appeared"`
+	Disappeared []serializableResourceDiff `json:"disappeared"`
+	Moved       []serializableResourceDiff `json:"moved"`
+	Changed     []serializableResourceDiff `json:"changed"`
+}
+
+func (d *AnalysisDiff) Summary() AnalysisDiffSummary {
+	return AnalysisDiffSummary{
+		Appeared:    len(d.Appeared),
+		Disappeared: len(d.Disappeared),
+		Moved:       len(d.Moved),
+		Changed:     len(d.Changed),
+	}
+}
+
+func (d AnalysisDiff) MarshalJSON() ([]byte, error) {
+	serializable := func(diffs []ResourceDiff) []serializableResourceDiff {
+		result := make([]serializableResourceDiff, 0, len(diffs))
+		for _, diff := range diffs {
+			result = append(result, serializableResourceDiff{
+				Res:             resource.SerializableResource{Resource: diff.Res},
+				Previous:        diff.Previous,
+				Current:         diff.Current,
+				NewChanges:      diff.NewChanges,
+				ResolvedChanges: diff.ResolvedChanges,
+			})
+		}
+		return result
+	}
+	return json.Marshal(serializableAnalysisDiff{
+		Summary:     d.Summary(),
+		Appeared:    serializable(d.Appeared),
+		Disappeared: serializable(d.Disappeared),
+		Moved:       serializable(d.Moved),
+		Changed:     serializable(d.Changed),
+	})
+}
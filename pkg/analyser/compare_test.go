@@ -0,0 +1,55 @@
+package analyser
+
+import (
+	"testing"
+
+	"github.com/r3labs/diff/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+func TestCompareAnalyses(t *testing.T) {
+	res := func(id string) *resource.AbstractResource {
+		return &resource.AbstractResource{Id: id, Type: "aws_s3_bucket"}
+	}
+	change := func(to string) Change {
+		return Change{Change: diff.Change{Type: diff.UPDATE, Path: []string{"acl"}, From: "private", To: to}}
+	}
+
+	previous := &Analysis{}
+	previous.AddManaged(res("managed"), res("drifted"), res("fixed"))
+	previous.AddDifference(
+		Difference{Res: res("drifted"), Changelog: Changelog{change("public-read")}},
+		Difference{Res: res("fixed"), Changelog: Changelog{change("public-read")}},
+	)
+	previous.AddUnmanaged(res("unmanaged"), res("imported"))
+	previous.AddDeleted(res("deleted"))
+
+	current := &Analysis{}
+	current.AddManaged(res("managed"), res("drifted"), res("fixed"), res("imported"))
+	current.AddDifference(Difference{Res: res("drifted"), Changelog: Changelog{change("public-read-write")}})
+	current.AddUnmanaged(res("unmanaged"), res("new"))
+
+	got := CompareAnalyses(previous, current)
+
+	assert.False(t, got.IsEmpty())
+	assert.Equal(t, []ResourceDiff{{Res: res("new"), Current: CategoryUnmanaged}}, got.Appeared)
+	assert.Equal(t, []ResourceDiff{{Res: res("deleted"), Previous: CategoryMissing}}, got.Disappeared)
+	assert.Equal(t, []ResourceDiff{
+		{Res: res("fixed"), Previous: CategoryChanged, Current: CategoryManaged, ResolvedChanges: Changelog{change("public-read")}},
+		{Res: res("imported"), Previous: CategoryUnmanaged, Current: CategoryManaged},
+	}, got.Moved)
+	assert.Equal(t, []ResourceDiff{
+		{
+			Res:             res("drifted"),
+			Previous:        CategoryChanged,
+			Current:         CategoryChanged,
+			NewChanges:      Changelog{change("public-read-write")},
+			ResolvedChanges: Changelog{change("public-read")},
+		},
+	}, got.Changed)
+	assert.Equal(t, AnalysisDiffSummary{Appeared: 1, Disappeared: 1, Moved: 2, Changed: 1}, got.Summary())
+
+	assert.True(t, CompareAnalyses(current, current).IsEmpty())
+}
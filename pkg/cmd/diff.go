@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cloudskiff/driftctl/pkg/analyser"
+	"github.com/cloudskiff/driftctl/pkg/cmd/scan/output"
+)
+
+func NewDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff <previous.json> <current.json>",
+		Short: "Compare two scan results",
+		Long:  "This command reports resources that appeared, disappeared or changed category between two JSON scan results, without scanning again\n\nExample: driftctl diff last-week.json today.json",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFlag, _ := cmd.Flags().GetString("output")
+			out, err := parseOutputFlag(outputFlag)
+			if err != nil {
+				return err
+			}
+
+			previous, err := readAnalysis(args[0])
+			if err != nil {
+				return errors.Wrapf(err, "Unable to read %s", args[0])
+			}
+			current, err := readAnalysis(args[1])
+			if err != nil {
+				return errors.Wrapf(err, "Unable to read %s", args[1])
+			}
+
+			diff := analyser.CompareAnalyses(previous, current)
+
+			if out.Key == output.JSONOutputType {
+				return writeAnalysisDiffJSON(cmd.OutOrStdout(), out.Options["path"], diff)
+			}
+			writeAnalysisDiff(cmd.OutOrStdout(), diff)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(
+		"output",
+		"o",
+		output.Example(output.ConsoleOutputType),
+		"Output format, by default it will write to the console\n"+
+			"Accepted formats are: "+strings.Join(output.SupportedOutputsExample(), ",")+"\n",
+	)
+
+	return cmd
+}
+
+func writeAnalysisDiffJSON(stdout io.Writer, path string, diff *analyser.AnalysisDiff) error {
+	content, err := json.MarshalIndent(diff, "", "\t")
+	if err != nil {
+		return err
+	}
+	if path == "stdout" || path == "/dev/stdout" {
+		_, err = stdout.Write(content)
+		return err
+	}
+	return os.WriteFile(path, content, 0600)
+}
+
+func writeAnalysisDiff(w io.Writer, diff *analyser.AnalysisDiff) {
+	if diff.IsEmpty() {
+		fmt.Fprintln(w, color.GreenString("Both analyses report the same resources."))
+		return
+	}
+
+	describe := func(d analyser.ResourceDiff) string {
+		return fmt.Sprintf("%s (%s)", d.Res.TerraformId(), d.Res.TerraformType())
+	}
+	writeChanges := func(d analyser.ResourceDiff) {
+		for _, change := range d.NewChanges {
+			fmt.Fprintf(w, "      %s %s: %s => %s\n", color.RedString("+"), strings.Join(change.Path, "."), prettifyValue(change.From), prettifyValue(change.To))
+		}
+		for _, change := range d.ResolvedChanges {
+			fmt.Fprintf(w, "      %s %s: %s => %s\n", color.GreenString("-"), strings.Join(change.Path, "."), prettifyValue(change.From), prettifyValue(change.To))
+		}
+	}
+
+	if len(diff.Appeared) > 0 {
+		fmt.Fprintln(w, "Resources that appeared:")
+		for _, d := range diff.Appeared {
+			fmt.Fprintf(w, "  - %s: %s\n", describe(d), d.Current)
+		}
+	}
+	if len(diff.Disappeared) > 0 {
+		fmt.Fprintln(w, "Resources that disappeared:")
+		for _, d := range diff.Disappeared {
+			fmt.Fprintf(w, "  - %s: was %s\n", describe(d), d.Previous)
+		}
+	}
+	if len(diff.Moved) > 0 {
+		fmt.Fprintln(w, "Resources that changed category:")
+		for _, d := range diff.Moved {
+			fmt.Fprintf(w, "  - %s: %s => %s\n", describe(d), d.Previous, d.Current)
+			writeChanges(d)
+		}
+	}
+	if len(diff.Changed) > 0 {
+		fmt.Fprintln(w, "Resources that changed differently:")
+		for _, d := range diff.Changed {
+			fmt.Fprintf(w, "  - %s:\n", describe(d))
+			writeChanges(d)
+		}
+	}
+
+	summary := diff.Summary()
+	fmt.Fprintf(w, "%d appeared, %d disappeared, %d changed category, %d changed differently\n",
+		summary.Appeared, summary.Disappeared, summary.Moved, summary.Changed)
+}
+
+func prettifyValue(value interface{}) string {
+	if value == nil {
+		return "<nil>"
+	}
+	content, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(content)
+}
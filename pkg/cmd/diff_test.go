@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/test"
+)
+
+func TestDiffCmd(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cases := []struct {
+		name     string
+		args     []string
+		expected string
+		err      string
+	}{
+		{
+			name:     "test console output",
+			args:     []string{"testdata/diff/previous.json", "testdata/diff/current.json"},
+			expected: "testdata/diff/output.txt",
+		},
+		{
+			name:     "test json output",
+			args:     []string{"testdata/diff/previous.json", "testdata/diff/current.json", "-o", "json://stdout"},
+			expected: "testdata/diff/output.json",
+		},
+		{
+			name:     "test same analyses",
+			args:     []string{"testdata/diff/current.json", "testdata/diff/current.json"},
+			expected: "testdata/diff/output_empty.txt",
+		},
+		{
+			name: "test json output to a file",
+			args: []string{"testdata/diff/previous.json", "testdata/diff/current.json", "-o", "json://" + path.Join(tmpDir, "diff.json")},
+		},
+		{
+			name: "test missing analysis",
+			args: []string{"testdata/diff/previous.json", "testdata/diff/doesnotexist.json"},
+			err:  "Unable to read testdata/diff/doesnotexist.json: open testdata/diff/doesnotexist.json: no such file or directory",
+		},
+		{
+			name: "test invalid output",
+			args: []string{"testdata/diff/previous.json", "testdata/diff/current.json", "-o", "html://diff.html"},
+			err:  "Unsupported output 'html': \nValid formats are: console://,json://PATH/TO/FILE.json",
+		},
+		{
+			name: "test missing argument",
+			args: []string{"testdata/diff/previous.json"},
+			err:  "accepts 2 arg(s), received 1",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rootCmd := &cobra.Command{Use: "root"}
+			rootCmd.AddCommand(NewDiffCmd())
+
+			output, err := test.Execute(rootCmd, append([]string{"diff"}, c.args...)...)
+			if c.err != "" {
+				assert.EqualError(t, err, c.err)
+				return
+			}
+			assert.Nil(t, err)
+
+			if c.expected != "" {
+				expected, err := os.ReadFile(c.expected)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, string(expected), output)
+			}
+		})
+	}
+
+	expected, _ := os.ReadFile("testdata/diff/output.json")
+	written, err := os.ReadFile(path.Join(tmpDir, "diff.json"))
+	assert.Nil(t, err)
+	assert.Equal(t, string(expected), string(written))
+}
@@ -67,6 +67,10 @@ func NewDriftctlCmd(build build.BuildInterface) *DriftctlCmd {
 
 	cmd.AddCommand(NewScanCmd())
 	cmd.AddCommand(NewGenDriftIgnoreCmd())
+	cmd.AddCommand(NewGenImportCmd())
+	cmd.AddCommand(NewDiffCmd())
+	cmd.AddCommand(NewWatchCmd())
+	cmd.AddCommand(NewServeCmd())
 
 	return cmd
 }
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+
+	"github.com/cloudskiff/driftctl/pkg/filter"
+)
+
+// LoadFilterFile reads named filter expressions from a file like the following one, names are case insensitive:
+//
+//	filters:
+//	  buckets: Type=='aws_s3_bucket'
+//	  managed_by_terraform: Attr.tags.Terraform=='true'
+//	  unmanaged_buckets: ${buckets} && !${managed_by_terraform}
+//
+// Every expression is validated, references included.
+func LoadFilterFile(path string) (filter.NamedExpressions, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, errors.Errorf("Unable to read filter file %s", path)
+	}
+
+	config := viper.New()
+	config.SetConfigFile(path)
+	config.SetConfigType("yaml")
+	if err := config.ReadInConfig(); err != nil {
+		return nil, errors.Wrapf(err, "Unable to parse %s", path)
+	}
+
+	expressions := filter.NamedExpressions(config.GetStringMapString("filters"))
+	if len(expressions) == 0 {
+		return nil, errors.Errorf("No filters found in %s", path)
+	}
+	if err := expressions.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "Invalid filter file %s", path)
+	}
+	return expressions, nil
+}
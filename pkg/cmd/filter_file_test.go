@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/pkg/filter"
+)
+
+func TestLoadFilterFile(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want filter.NamedExpressions
+		err  string
+	}{
+		{
+			name: "valid filter file",
+			path: "testdata/filters/filters.yml",
+			want: filter.NamedExpressions{
+				"buckets":              "Type=='aws_s3_bucket'",
+				"managed_by_terraform": "Attr.tags.Terraform=='true'",
+				"unmanaged_buckets":    "${buckets} && !${managed_by_terraform}",
+			},
+		},
+		{
+			name: "invalid expression",
+			path: "testdata/filters/invalid.yml",
+			err:  "Invalid filter file testdata/filters/invalid.yml: filter sandbox is invalid: unknown field id, resources only have fields Attr, Id, Res, Type",
+		},
+		{
+			name: "no filters",
+			path: "testdata/filters/empty.yml",
+			err:  "No filters found in testdata/filters/empty.yml",
+		},
+		{
+			name: "missing file",
+			path: "testdata/filters/missing.yml",
+			err:  "Unable to read filter file testdata/filters/missing.yml",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadFilterFile(tt.path)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
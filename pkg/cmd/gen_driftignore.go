@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/cloudskiff/driftctl/pkg/analyser"
+	"github.com/cloudskiff/driftctl/pkg/filter"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
@@ -16,18 +18,33 @@ func NewGenDriftIgnoreCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "gen-driftignore",
 		Short: "Generate a .driftignore file based on your scan result",
-		Long:  "This command will generate a new .driftignore file containing your current drifts and send output to /dev/stdout\n\nExample: driftctl scan -o json://stdout | driftctl gen-driftignore -i /dev/stdin > .driftignore",
-		Args:  cobra.NoArgs,
+		Long: "This command will generate a new .driftignore file containing your current drifts and send output to /dev/stdout\n" +
+			"When --merge is used, rules missing from the given file are added to it instead, its content and comments are kept.\n\n" +
+			"Example: driftctl scan -o json://stdout | driftctl gen-driftignore -i /dev/stdin > .driftignore",
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if opts.InputPath == "" {
 				return errors.New("Error: you must specify an input to parse JSON from. Use driftctl gen-driftignore -i <drifts.json>\nGenerate a JSON file using the output flag: driftctl scan -o json://path/to/drifts.json")
 			}
 
+			if opts.WildcardThreshold < 0 {
+				return errors.New("Wildcard threshold cannot be negative")
+			}
+
 			_, list, err := genDriftIgnore(opts)
 			if err != nil {
 				return err
 			}
 
+			if opts.MergePath != "" {
+				added, err := mergeDriftIgnoreFile(opts.MergePath, list)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("%d rule(s) added to %s\n", added, opts.MergePath)
+				return nil
+			}
+
 			fmt.Print(list)
 
 			return nil
@@ -40,23 +57,87 @@ func NewGenDriftIgnoreCmd() *cobra.Command {
 	fl.BoolVar(&opts.ExcludeDeleted, "exclude-missing", false, "Exclude missing resources")
 	fl.BoolVar(&opts.ExcludeDrifted, "exclude-changed", false, "Exclude resources that changed on cloud provider")
 	fl.StringVarP(&opts.InputPath, "input", "i", "", "Input where the JSON should be parsed from")
+	fl.BoolVar(&opts.Fields, "fields", false, "Ignore fields that changed on cloud provider instead of whole changed resources")
+	fl.IntVar(&opts.WildcardThreshold, "wildcard-threshold", 3, "Number of resources of a type a field has to change on to be ignored for every resource of this type, 0 disables it")
+	fl.StringVar(&opts.MergePath, "merge", "", "Existing ignore file to add missing rules to, instead of printing them")
 
 	return cmd
 }
 
 func genDriftIgnore(opts *analyser.GenDriftIgnoreOptions) (int, string, error) {
-	input, err := os.ReadFile(opts.InputPath)
+	analysis, err := readAnalysis(opts.InputPath)
 	if err != nil {
 		return 0, "", err
 	}
 
+	n, list := analysis.DriftIgnoreList(*opts)
+
+	return n, list, nil
+}
+
+// mergeDriftIgnoreFile adds generated rules missing from the ignore file at path, which is created when it does not exist.
+// It returns the number of added rules.
+func mergeDriftIgnoreFile(path, generated string) (int, error) {
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return 0, err
+	}
+	merged, added := mergeDriftIgnore(string(existing), filter.NewDriftIgnore(path), generated)
+	if added == 0 {
+		return 0, nil
+	}
+	if err := os.WriteFile(path, []byte(merged), 0644); err != nil {
+		return 0, err
+	}
+	return added, nil
+}
+
+// mergeDriftIgnore appends generated rules on which the rules of the existing ignore file content do not decide yet.
+// As the last matching rule wins, a generated rule overriding a negated rule of the user is left out.
+// Existing content is kept as is, generated section comments are only kept when some of their rules are added.
+func mergeDriftIgnore(existing string, rules *filter.DriftIgnore, generated string) (string, int) {
+	var added []string
+	seen := make(map[string]struct{})
+	section := ""
+	count := 0
+	for _, line := range strings.Split(generated, "\n") {
+		if strings.HasPrefix(line, "#") {
+			section = line
+			continue
+		}
+		if _, exists := seen[line]; exists || line == "" || rules.Decides(line) {
+			continue
+		}
+		seen[line] = struct{}{}
+		if section != "" {
+			added = append(added, section)
+			section = ""
+		}
+		added = append(added, line)
+		count++
+	}
+	if count == 0 {
+		return existing, 0
+	}
+
+	merged := strings.TrimRight(existing, "\n")
+	if merged != "" {
+		merged += "\n\n"
+	}
+	return merged + strings.Join(added, "\n") + "\n", count
+}
+
+// readAnalysis reads the JSON output of a scan
+func readAnalysis(path string) (*analyser.Analysis, error) {
+	input, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
 	analysis := &analyser.Analysis{}
 	err = json.Unmarshal(input, analysis)
 	if err != nil {
-		return 0, "", err
+		return nil, err
 	}
-
-	n, list := analysis.DriftIgnoreList(*opts)
-
-	return n, list, nil
+	return analysis, nil
 }
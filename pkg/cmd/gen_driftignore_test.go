@@ -5,8 +5,11 @@ import (
 	"errors"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/cloudskiff/driftctl/pkg/filter"
 	"github.com/cloudskiff/driftctl/test"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -49,6 +52,24 @@ func TestGenDriftIgnoreCmd_Input(t *testing.T) {
 			output: "./testdata/output_stdin_valid_filter2.txt",
 			err:    nil,
 		},
+		{
+			name:   "test driftignore content with changed fields",
+			args:   []string{"-i", "./testdata/input_fields.json", "--fields"},
+			output: "./testdata/output_fields.txt",
+			err:    nil,
+		},
+		{
+			name:   "test driftignore content with changed fields without wildcard",
+			args:   []string{"-i", "./testdata/input_fields.json", "--fields", "--wildcard-threshold", "0"},
+			output: "./testdata/output_fields_no_wildcard.txt",
+			err:    nil,
+		},
+		{
+			name:   "test error with negative wildcard threshold",
+			args:   []string{"-i", "./testdata/input_fields.json", "--fields", "--wildcard-threshold", "-1"},
+			output: "",
+			err:    errors.New("Wildcard threshold cannot be negative"),
+		},
 		{
 			name:   "test error when input file does not exist",
 			args:   []string{"-i", "doesnotexist"},
@@ -107,6 +128,65 @@ func TestGenDriftIgnoreCmd_Input(t *testing.T) {
 	}
 }
 
+func TestGenDriftIgnoreCmd_Merge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".driftignore")
+	existing := "# Shared rules\naws_s3_bucket.*.tags.Env # owner=team-data\naws_instance.i-0a1b.tags.Env\n"
+	if err := os.WriteFile(path, []byte(existing), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	rootCmd := &cobra.Command{Use: "root"}
+	rootCmd.AddCommand(NewGenDriftIgnoreCmd())
+	_, err := test.Execute(rootCmd, "gen-driftignore", "-i", "./testdata/input_fields.json", "--fields", "--merge", path)
+	assert.Nil(t, err)
+
+	merged, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, existing+"\n# Changed fields\naws_s3_bucket.assets.versioning.0.enabled\naws_s3_bucket.logs.tags.team\\.name\n", string(merged))
+
+	// Merging again adds nothing
+	_, err = test.Execute(rootCmd, "gen-driftignore", "-i", "./testdata/input_fields.json", "--fields", "--merge", path)
+	assert.Nil(t, err)
+	again, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, string(merged), string(again))
+}
+
+func Test_mergeDriftIgnore(t *testing.T) {
+	rulesOf := func(content string) *filter.DriftIgnore {
+		path := filepath.Join(t.TempDir(), ".driftignore")
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return filter.NewDriftIgnore(path)
+	}
+
+	merged, added := mergeDriftIgnore("", rulesOf(""), "# Missing resources\naws_iam_user.test\n# Changed resources\naws_s3_bucket.logs")
+	assert.Equal(t, "# Missing resources\naws_iam_user.test\n# Changed resources\naws_s3_bucket.logs\n", merged)
+	assert.Equal(t, 2, added)
+
+	existing := "aws_iam_user.test\n\n"
+	merged, added = mergeDriftIgnore(existing, rulesOf(existing), "# Missing resources\naws_iam_user.test\n# Changed resources\naws_s3_bucket.logs")
+	assert.Equal(t, "aws_iam_user.test\n\n# Changed resources\naws_s3_bucket.logs\n", merged)
+	assert.Equal(t, 1, added)
+
+	// Rules already covered by wildcards, or re-included on purpose, are left out
+	existing = "aws_iam_role.*\n!aws_iam_role.prod-*\naws_s3_bucket.*.tags\n!aws_instance.i-keep.tags.Env\n"
+	merged, added = mergeDriftIgnore(existing, rulesOf(existing), strings.Join([]string{
+		"# Resources not covered by IaC",
+		"aws_iam_role.prod-api",
+		"aws_iam_role.test",
+		"aws_iam_user.test",
+		"# Changed fields",
+		"aws_s3_bucket.b.tags.Env",
+		"aws_iam_role.sandbox.description",
+		"aws_instance.*.tags",
+		"aws_instance.i-0a1b.ami",
+	}, "\n"))
+	assert.Equal(t, existing+"\n# Resources not covered by IaC\naws_iam_user.test\n# Changed fields\naws_instance.i-0a1b.ami\n", merged)
+	assert.Equal(t, 2, added)
+}
+
 func TestGenDriftIgnoreCmd_ValidFlags(t *testing.T) {
 	rootCmd := &cobra.Command{Use: "root"}
 	genDriftIgnoreCmd := NewGenDriftIgnoreCmd()
@@ -122,6 +202,8 @@ func TestGenDriftIgnoreCmd_ValidFlags(t *testing.T) {
 		{args: []string{"gen-driftignore", "--exclude-changed=false", "--exclude-missing=false", "--exclude-unmanaged=true"}},
 		{args: []string{"gen-driftignore", "--input", "/dev/stdin"}},
 		{args: []string{"gen-driftignore", "-i", "/dev/stdout"}},
+		{args: []string{"gen-driftignore", "--fields", "--wildcard-threshold", "5"}},
+		{args: []string{"gen-driftignore", "--merge", ".driftignore"}},
 	}
 
 	for _, tt := range cases {
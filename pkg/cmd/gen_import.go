@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cloudskiff/driftctl/pkg/generate"
+)
+
+func NewGenImportCmd() *cobra.Command {
+	var inputPath, format string
+
+	cmd := &cobra.Command{
+		Use:   "gen-import",
+		Short: "Generate Terraform imports for resources not covered by IaC",
+		Long: "This command will generate terraform import commands, or import blocks supported since Terraform 1.5, for resources not covered by IaC found in your scan result and send output to /dev/stdout\n" +
+			"Resource names are suggested from their human readable attributes or their id\n\n" +
+			"Example: driftctl scan -o json://stdout | driftctl gen-import -i /dev/stdin > import.sh",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputPath == "" {
+				return errors.New("Error: you must specify an input to parse JSON from. Use driftctl gen-import -i <drifts.json>\nGenerate a JSON file using the output flag: driftctl scan -o json://path/to/drifts.json")
+			}
+			if !isSupportedImportFormat(format) {
+				return errors.Errorf("Unsupported format %s, accepted formats are: %s", format, strings.Join(generate.SupportedImportFormats, ","))
+			}
+
+			analysis, err := readAnalysis(inputPath)
+			if err != nil {
+				return err
+			}
+
+			imports := generate.Imports(generate.NewNamer(), analysis.Unmanaged())
+			return generate.WriteImports(cmd.OutOrStdout(), format, imports)
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.StringVarP(&inputPath, "input", "i", "", "Input where the JSON should be parsed from")
+	fl.StringVar(&format, "format", generate.ImportCommands, fmt.Sprintf("Format of imports, accepted formats are: %s", strings.Join(generate.SupportedImportFormats, ",")))
+
+	return cmd
+}
+
+func isSupportedImportFormat(format string) bool {
+	for _, f := range generate.SupportedImportFormats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
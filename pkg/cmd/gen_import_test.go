@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/test"
+)
+
+func TestGenImportCmd(t *testing.T) {
+	cases := []struct {
+		name     string
+		args     []string
+		expected string
+		err      string
+	}{
+		{
+			name:     "test terraform import commands",
+			args:     []string{"-i", "testdata/gen_import/input.json"},
+			expected: "testdata/gen_import/output_command.txt",
+		},
+		{
+			name:     "test import blocks",
+			args:     []string{"-i", "testdata/gen_import/input.json", "--format", "block"},
+			expected: "testdata/gen_import/output_block.tf",
+		},
+		{
+			name: "test missing input",
+			args: []string{},
+			err:  "Error: you must specify an input to parse JSON from. Use driftctl gen-import -i <drifts.json>\nGenerate a JSON file using the output flag: driftctl scan -o json://path/to/drifts.json",
+		},
+		{
+			name: "test unsupported format",
+			args: []string{"-i", "testdata/gen_import/input.json", "--format", "json"},
+			err:  "Unsupported format json, accepted formats are: command,block",
+		},
+		{
+			name: "test input does not exist",
+			args: []string{"-i", "doesnotexist"},
+			err:  "open doesnotexist: no such file or directory",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rootCmd := &cobra.Command{Use: "root"}
+			rootCmd.AddCommand(NewGenImportCmd())
+
+			output, err := test.Execute(rootCmd, append([]string{"gen-import"}, c.args...)...)
+			if c.err != "" {
+				assert.EqualError(t, err, c.err)
+				return
+			}
+			assert.Nil(t, err)
+
+			expected, err := os.ReadFile(c.expected)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, string(expected), output)
+		})
+	}
+}
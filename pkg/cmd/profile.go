@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const defaultProfileFile = ".driftctl.yml"
+
+// Profile holds scan settings of a project, keys are named after scan flags
+type Profile struct {
+	From            []string         `mapstructure:"from"`
+	To              []string         `mapstructure:"to"`
+	Filter          string           `mapstructure:"filter"`
+	FilterFile      string           `mapstructure:"filter-file"`
+	Output          string           `mapstructure:"output"`
+	ProviderVersion string           `mapstructure:"tf-provider-version"`
+	Strict          *bool            `mapstructure:"strict"`
+	Concurrency     map[string]int64 `mapstructure:"concurrency"`
+	DriftIgnore     []string         `mapstructure:"driftignore"`
+}
+
+// LoadProfile reads a named profile from a project configuration file like:
+//
+//	profiles:
+//	  production:
+//	    from:
+//	      - tfstate+s3://my-bucket/production.tfstate
+//	    to: [aws+tf]
+//	    strict: true
+func LoadProfile(path, name string) (*Profile, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, errors.Errorf("Unable to load profile %s, %s cannot be read", name, path)
+	}
+
+	config := viper.New()
+	config.SetConfigFile(path)
+	config.SetConfigType("yaml")
+	if err := config.ReadInConfig(); err != nil {
+		return nil, errors.Wrapf(err, "Unable to parse %s", path)
+	}
+
+	profiles := config.GetStringMap("profiles")
+	if _, exist := profiles[strings.ToLower(name)]; !exist {
+		names := make([]string, 0, len(profiles))
+		for n := range profiles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return nil, errors.Errorf("Profile %s not found in %s, available profiles are: %s", name, path, strings.Join(names, ","))
+	}
+
+	profile := &Profile{}
+	if err := config.UnmarshalKey("profiles."+strings.ToLower(name), profile); err != nil {
+		return nil, errors.Wrapf(err, "Invalid profile %s in %s", name, path)
+	}
+	return profile, nil
+}
+
+// applyProfile sets flags from the profile, flags set on the command line or through
+// environment variables are left untouched
+func applyProfile(cmd *cobra.Command, profile *Profile) error {
+	values := map[string]string{
+		"from":                strings.Join(profile.From, ","),
+		"to":                  strings.Join(profile.To, ","),
+		"filter":              profile.Filter,
+		"filter-file":         profile.FilterFile,
+		"output":              profile.Output,
+		"tf-provider-version": profile.ProviderVersion,
+	}
+	if profile.Strict != nil {
+		values["strict"] = fmt.Sprintf("%t", *profile.Strict)
+	}
+	concurrency := make([]string, 0, len(profile.Concurrency))
+	for remote, c := range profile.Concurrency {
+		concurrency = append(concurrency, fmt.Sprintf("%s=%d", remote, c))
+	}
+	sort.Strings(concurrency)
+	values["concurrency"] = strings.Join(concurrency, ",")
+
+	for name, value := range values {
+		flag := cmd.Flags().Lookup(name)
+		if value == "" || flag == nil || flag.Changed {
+			continue
+		}
+		if err := cmd.Flags().Set(name, value); err != nil {
+			return errors.Wrapf(err, "Invalid %s in profile", name)
+		}
+		logrus.WithFields(logrus.Fields{
+			"flag":  name,
+			"value": value,
+		}).Debug("Applied profile value to flag")
+	}
+	return nil
+}
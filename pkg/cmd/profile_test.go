@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/test"
+)
+
+func TestLoadProfile(t *testing.T) {
+	strict := true
+	tests := []struct {
+		name    string
+		path    string
+		profile string
+		want    *Profile
+		wantErr string
+	}{
+		{
+			name:    "full profile",
+			path:    "testdata/profile/.driftctl.yml",
+			profile: "production",
+			want: &Profile{
+				From:            []string{"tfstate+s3://my-bucket/production.tfstate", "tfstate://terraform.tfstate"},
+				To:              []string{"aws+tf", "github+tf"},
+				Filter:          "Type=='aws_s3_bucket'",
+				Output:          "json://result.json",
+				ProviderVersion: "3.19.0",
+				Strict:          &strict,
+				Concurrency:     map[string]int64{"aws+tf": 20},
+				DriftIgnore:     []string{".driftignore", "teams/.driftignore"},
+			},
+		},
+		{
+			name:    "partial profile",
+			path:    "testdata/profile/.driftctl.yml",
+			profile: "staging",
+			want: &Profile{
+				From: []string{"tfstate://staging.tfstate"},
+			},
+		},
+		{
+			name:    "unknown profile",
+			path:    "testdata/profile/.driftctl.yml",
+			profile: "foo",
+			wantErr: "Profile foo not found in testdata/profile/.driftctl.yml, available profiles are: production,staging",
+		},
+		{
+			name:    "missing file",
+			path:    "testdata/profile/missing.yml",
+			profile: "production",
+			wantErr: "Unable to load profile production, testdata/profile/missing.yml cannot be read",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadProfile(tt.path, tt.profile)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestScanCmd_Profile(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "root"}
+	scanCmd := NewScanCmd()
+	scanCmd.RunE = func(_ *cobra.Command, args []string) error { return nil }
+	rootCmd.AddCommand(scanCmd)
+
+	_, err := test.Execute(rootCmd, "scan", "--profile-file", "testdata/profile/.driftctl.yml", "--profile", "production", "--to", "aws+tf")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from, _ := scanCmd.Flags().GetStringSlice("from")
+	assert.Equal(t, []string{"tfstate+s3://my-bucket/production.tfstate", "tfstate://terraform.tfstate"}, from)
+	// Flags set on the command line win over the profile
+	to, _ := scanCmd.Flags().GetStringSlice("to")
+	assert.Equal(t, []string{"aws+tf"}, to)
+	strict, _ := scanCmd.Flags().GetBool("strict")
+	assert.True(t, strict)
+	concurrency, _ := scanCmd.Flags().GetStringToInt64("concurrency")
+	assert.Equal(t, map[string]int64{"aws+tf": 20}, concurrency)
+	output, _ := scanCmd.Flags().GetString("output")
+	assert.Equal(t, "json://result.json", output)
+}
@@ -1,22 +1,24 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
 	"regexp"
+	"sort"
 	"strings"
 	"syscall"
 
 	"github.com/cloudskiff/driftctl/pkg/telemetry"
 	"github.com/fatih/color"
+	"github.com/jmespath/go-jmespath"
 	"github.com/mitchellh/go-homedir"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
 	"github.com/cloudskiff/driftctl/pkg"
-	"github.com/cloudskiff/driftctl/pkg/alerter"
 	cmderrors "github.com/cloudskiff/driftctl/pkg/cmd/errors"
 	"github.com/cloudskiff/driftctl/pkg/cmd/scan/output"
 	"github.com/cloudskiff/driftctl/pkg/filter"
@@ -25,8 +27,12 @@ import (
 	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
 	globaloutput "github.com/cloudskiff/driftctl/pkg/output"
 	"github.com/cloudskiff/driftctl/pkg/remote"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws"
+	"github.com/cloudskiff/driftctl/pkg/remote/cache"
+	"github.com/cloudskiff/driftctl/pkg/remote/github"
+	"github.com/cloudskiff/driftctl/pkg/remote/retry"
 	"github.com/cloudskiff/driftctl/pkg/resource"
-	"github.com/cloudskiff/driftctl/pkg/terraform"
+	"github.com/cloudskiff/driftctl/pkg/scan"
 )
 
 func NewScanCmd() *cobra.Command {
@@ -39,64 +45,32 @@ func NewScanCmd() *cobra.Command {
 		Long:  "Scan",
 		Args:  cobra.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			from, _ := cmd.Flags().GetStringSlice("from")
-
-			iacSource, err := parseFromFlag(from)
-			if err != nil {
-				return err
-			}
-
-			opts.From = iacSource
-
-			to, _ := cmd.Flags().GetString("to")
-			if !remote.IsSupported(to) {
-				return errors.Errorf(
-					"unsupported cloud provider '%s'\nValid values are: %s",
-					to,
-					strings.Join(remote.GetSupportedRemotes(), ","),
-				)
-			}
-
-			outputFlag, _ := cmd.Flags().GetString("output")
-			out, err := parseOutputFlag(outputFlag)
-			if err != nil {
-				return err
-			}
-			opts.Output = *out
-
-			filterFlag, _ := cmd.Flags().GetStringArray("filter")
-
-			if len(filterFlag) > 1 {
-				return errors.New("Filter flag should be specified only once")
-			}
-
-			if len(filterFlag) == 1 && filterFlag[0] != "" {
-				expr, err := filter.BuildExpression(filterFlag[0])
-				if err != nil {
-					return errors.Wrap(err, "unable to parse filter expression")
-				}
-				opts.Filter = expr
-			}
-
-			providerVersion, _ := cmd.Flags().GetString("tf-provider-version")
-			if err := validateTfProviderVersionString(providerVersion); err != nil {
-				return err
-			}
-			opts.ProviderVersion = providerVersion
-
-			opts.Quiet, _ = cmd.Flags().GetBool("quiet")
-			opts.DisableTelemetry, _ = cmd.Flags().GetBool("disable-telemetry")
-
-			opts.ConfigDir, _ = cmd.Flags().GetString("config-dir")
-
-			return nil
+			return parseScanFlags(cmd, opts)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return scanRun(opts)
 		},
 	}
 
+	addScanFlags(cmd, opts)
+
+	return cmd
+}
+
+// addScanFlags adds flags configuring scans, they are shared by commands running scans
+func addScanFlags(cmd *cobra.Command, opts *pkg.ScanOptions) {
 	fl := cmd.Flags()
+	fl.String(
+		"profile",
+		"",
+		"Name of a profile of the project configuration file to read settings from\n"+
+			"Flags set on the command line take precedence over the profile\n",
+	)
+	fl.String(
+		"profile-file",
+		defaultProfileFile,
+		"Project configuration file holding profiles\n",
+	)
 	fl.Bool(
 		"quiet",
 		false,
@@ -111,6 +85,20 @@ func NewScanCmd() *cobra.Command {
 			"  - Type =='aws_s3_bucket && Id != 'my_bucket' (excludes s3 bucket 'my_bucket')\n"+
 			"  - Attr.Tags.Terraform == 'true' (include only resources that have Tag Terraform equal to 'true')\n",
 	)
+	fl.String(
+		"filter-file",
+		"",
+		"YAML file of named JMESPath expressions the filter can reference as ${name}, expressions can reference each other\n"+
+			"Example: filters: {buckets: \"Type=='aws_s3_bucket'\", sandbox: \"${buckets} && starts_with(Id, 'sandbox-')\"}\n",
+	)
+	fl.StringArray(
+		"driftignore",
+		[]string{},
+		"Ignore file to read rules from, can be repeated to merge rules of several files (.driftignore by default)\n"+
+			"A line like '@include path/to/file' reads rules of another file\n"+
+			"Rules may end with a comment like '# expires=2021-12-31 owner=team-data', expired rules are not applied\n"+
+			"A line starting with '?' ignores resources matching a filter expression (e.g. ?Type=='aws_instance' && Attr.tags.Env == 'dev')\n",
+	)
 	fl.StringP(
 		"output",
 		"o",
@@ -126,12 +114,11 @@ func NewScanCmd() *cobra.Command {
 			"Accepted schemes are: "+strings.Join(supplier.GetSupportedSchemes(), ",")+"\n",
 	)
 	supportedRemotes := remote.GetSupportedRemotes()
-	fl.StringVarP(
-		&opts.To,
+	fl.StringSliceP(
 		"to",
 		"t",
-		supportedRemotes[0],
-		"Cloud provider source\n"+
+		[]string{supportedRemotes[0]},
+		"Cloud provider sources, several ones can be scanned at once (e.g. aws+tf,github+tf)\n"+
 			"Accepted values are: "+strings.Join(supportedRemotes, ",")+"\n",
 	)
 	fl.StringToStringVarP(&opts.BackendOptions.Headers,
@@ -152,6 +139,105 @@ func NewScanCmd() *cobra.Command {
 		"",
 		"Terraform provider version to use.\n",
 	)
+	fl.StringSlice(
+		"regions",
+		[]string{},
+		"AWS regions to scan, by default only the region of your AWS configuration is scanned\n"+
+			"Use \""+aws.AllRegions+"\" to scan every region enabled for your account\n",
+	)
+	fl.StringSlice(
+		"aws-assume-role",
+		[]string{},
+		"ARNs of AWS roles to assume, one account is scanned per role\n",
+	)
+	fl.String(
+		"aws-assume-role-external-id",
+		"",
+		"External ID used when assuming AWS roles\n",
+	)
+	fl.String(
+		"aws-organization-role",
+		"",
+		"Name of the AWS role to assume in every active account of your organization\n"+
+			"The account of your AWS configuration is scanned without assuming any role\n",
+	)
+	defaultRetry := retry.DefaultOptions()
+	fl.Int(
+		"max-retries",
+		defaultRetry.MaxRetries,
+		"Maximum number of retries of a cloud provider API call failing because of throttling\n",
+	)
+	fl.Duration(
+		"retry-min-backoff",
+		defaultRetry.MinBackoff,
+		"Delay before retrying a failed API call, it doubles on each retry\n",
+	)
+	fl.Duration(
+		"retry-max-backoff",
+		defaultRetry.MaxBackoff,
+		"Maximum delay between two retries of a failed API call\n",
+	)
+	fl.Float64(
+		"rate-limit",
+		0,
+		"Maximum number of API calls per second to each cloud provider service, 0 means unlimited\n",
+	)
+	fl.StringToInt64(
+		"concurrency",
+		map[string]int64{},
+		fmt.Sprintf("Number of resources read at the same time from each cloud provider (e.g. %s=20), %d by default\n", aws.RemoteAWSTerraform, remote.DefaultConcurrency),
+	)
+	fl.String(
+		"baseline",
+		"",
+		"JSON output of a previous scan, drift is then reported as new, still present or resolved\n"+
+			"Only new drift makes the scan fail\n",
+	)
+	fl.StringVar(&opts.HCLPath,
+		"gen-hcl",
+		"",
+		"Write resource blocks of resources not covered by IaC to the given file, along with the selected output\n"+
+			"Zero values like false or \"\" are written too, as provider defaults are unknown, review them before applying\n",
+	)
+	fl.BoolVar(&opts.HCLSplit,
+		"gen-hcl-split",
+		false,
+		"Write a file per resource type to the directory given to --gen-hcl\n",
+	)
+	fl.Duration(
+		"cache-ttl",
+		0,
+		"Save resources listed and read from cloud providers under the config directory and reuse them for the given duration (e.g. 1h)\n"+
+			"Resources are cached per account, region and provider version, 0 disables the cache\n",
+	)
+	fl.Bool(
+		"refresh",
+		false,
+		"Read every resource from cloud providers even when cached, the cache is then updated\n",
+	)
+	fl.DurationVar(&opts.Timeout,
+		"timeout",
+		0,
+		"Abort the scan when it takes longer than the given duration (e.g. 30m), 0 means no timeout\n",
+	)
+	fl.DurationVar(&opts.SupplierTimeout,
+		"supplier-timeout",
+		0,
+		"Maximum time spent listing resources of a type (e.g. 5m), 0 means no timeout\n"+
+			"The scan fails when it is reached unless --continue-on-error is used\n",
+	)
+	fl.Bool(
+		"stats",
+		false,
+		"Print time spent and API calls made by each supplier and repository\n"+
+			"Those statistics are always part of the json output\n",
+	)
+	fl.BoolVar(&opts.ContinueOnError,
+		"continue-on-error",
+		false,
+		"Keep scanning when resources of a type cannot be listed\n"+
+			"Those resources are then ignored and reported as incomplete in the analysis\n",
+	)
 	fl.BoolVar(&opts.StrictMode,
 		"strict",
 		false,
@@ -167,60 +253,185 @@ func NewScanCmd() *cobra.Command {
 		configDir,
 		"Directory path that driftctl uses for configuration.\n",
 	)
-
-	return cmd
 }
 
-func scanRun(opts *pkg.ScanOptions) error {
-	selectedOutput := output.GetOutput(opts.Output, opts.Quiet)
-
-	c := make(chan os.Signal)
-	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+// parseScanFlags fills opts from flags added by addScanFlags
+func parseScanFlags(cmd *cobra.Command, opts *pkg.ScanOptions) error {
+	if profileName, _ := cmd.Flags().GetString("profile"); profileName != "" {
+		profileFile, _ := cmd.Flags().GetString("profile-file")
+		profile, err := LoadProfile(profileFile, profileName)
+		if err != nil {
+			return err
+		}
+		if err := applyProfile(cmd, profile); err != nil {
+			return err
+		}
+		if !cmd.Flags().Changed("driftignore") {
+			opts.DriftIgnorePaths = profile.DriftIgnore
+		}
+	}
 
-	alerter := alerter.NewAlerter()
-	providerLibrary := terraform.NewProviderLibrary()
-	supplierLibrary := resource.NewSupplierLibrary()
+	from, _ := cmd.Flags().GetStringSlice("from")
 
-	iacProgress := globaloutput.NewProgress("Scanning states", "Scanned states", true)
-	scanProgress := globaloutput.NewProgress("Scanning resources", "Scanned resources", false)
+	iacSource, err := parseFromFlag(from)
+	if err != nil {
+		return err
+	}
 
-	resourceSchemaRepository := resource.NewSchemaRepository()
+	opts.From = iacSource
 
-	resFactory := terraform.NewTerraformResourceFactory(resourceSchemaRepository)
+	to, _ := cmd.Flags().GetStringSlice("to")
+	if err := validateToFlag(to); err != nil {
+		return err
+	}
+	opts.To = to
 
-	err := remote.Activate(opts.To, opts.ProviderVersion, alerter, providerLibrary, supplierLibrary, scanProgress, resourceSchemaRepository, resFactory, opts.ConfigDir)
+	outputFlag, _ := cmd.Flags().GetString("output")
+	out, err := parseOutputFlag(outputFlag)
 	if err != nil {
 		return err
 	}
+	opts.Output = *out
+	if showStats, _ := cmd.Flags().GetBool("stats"); showStats {
+		opts.Output.Options["stats"] = "true"
+	}
 
-	// Teardown
-	defer func() {
-		logrus.Trace("Exiting scan cmd")
-		providerLibrary.Cleanup()
-		logrus.Trace("Exited")
-	}()
+	filterFlag, _ := cmd.Flags().GetStringArray("filter")
+
+	if len(filterFlag) > 1 {
+		return errors.New("Filter flag should be specified only once")
+	}
+
+	if filterFile, _ := cmd.Flags().GetString("filter-file"); filterFile != "" {
+		named, err := LoadFilterFile(filterFile)
+		if err != nil {
+			return err
+		}
+		if len(filterFlag) == 0 || filterFlag[0] == "" {
+			return errors.New("Filter file can only be used along with a filter referencing its expressions (e.g. --filter '${name}')")
+		}
+		opts.NamedFilters = named
+	}
+
+	if len(filterFlag) == 1 && filterFlag[0] != "" {
+		expression, expr, err := parseFilter(filterFlag[0], opts.NamedFilters)
+		if err != nil {
+			return err
+		}
+		opts.Filter = expr
+		opts.FilterExpression = expression
+	}
+
+	if cmd.Flags().Changed("driftignore") {
+		driftIgnorePaths, _ := cmd.Flags().GetStringArray("driftignore")
+		for _, path := range driftIgnorePaths {
+			if _, err := os.Stat(path); err != nil {
+				return errors.Errorf("Unable to read driftignore file %s", path)
+			}
+		}
+		opts.DriftIgnorePaths = driftIgnorePaths
+	}
+
+	providerVersion, _ := cmd.Flags().GetString("tf-provider-version")
+	if err := validateTfProviderVersionString(providerVersion); err != nil {
+		return err
+	}
+	if providerVersion != "" && len(opts.To) > 1 {
+		return errors.New("Provider version can only be set when scanning a single cloud provider")
+	}
+	opts.ProviderVersion = providerVersion
 
-	scanner := pkg.NewScanner(supplierLibrary.Suppliers(), alerter)
+	opts.Quiet, _ = cmd.Flags().GetBool("quiet")
+	opts.DisableTelemetry, _ = cmd.Flags().GetBool("disable-telemetry")
+
+	opts.ConfigDir, _ = cmd.Flags().GetString("config-dir")
+
+	regions, _ := cmd.Flags().GetStringSlice("regions")
+	if err := validateRegionsFlag(regions); err != nil {
+		return err
+	}
+	opts.RemoteOptions.AWS.Regions = regions
+
+	assumeRoles, _ := cmd.Flags().GetStringSlice("aws-assume-role")
+	if err := validateAssumeRolesFlag(assumeRoles); err != nil {
+		return err
+	}
+	opts.RemoteOptions.AWS.AssumeRoles = assumeRoles
+	opts.RemoteOptions.AWS.AssumeRoleExternalId, _ = cmd.Flags().GetString("aws-assume-role-external-id")
+	opts.RemoteOptions.AWS.OrganizationRole, _ = cmd.Flags().GetString("aws-organization-role")
 
-	iacSupplier, err := supplier.GetIACSupplier(opts.From, providerLibrary, opts.BackendOptions, iacProgress, resFactory)
+	retryOptions, err := parseRetryFlags(cmd)
 	if err != nil {
 		return err
 	}
+	opts.RemoteOptions.AWS.Retry = retryOptions
+	opts.RemoteOptions.Github.Retry = retryOptions
 
-	ctl := pkg.NewDriftCTL(scanner, iacSupplier, alerter, resFactory, opts, scanProgress, iacProgress, resourceSchemaRepository)
+	concurrency, _ := cmd.Flags().GetStringToInt64("concurrency")
+	if err := validateConcurrencyFlag(concurrency); err != nil {
+		return err
+	}
+	opts.RemoteOptions.AWS.Concurrency = concurrency[aws.RemoteAWSTerraform]
+	opts.RemoteOptions.Github.Concurrency = concurrency[github.RemoteGithubTerraform]
 
-	go func() {
-		<-c
-		logrus.Warn("Detected interrupt, cleanup ...")
-		ctl.Stop()
-	}()
+	if baselinePath, _ := cmd.Flags().GetString("baseline"); baselinePath != "" {
+		baseline, err := readAnalysis(baselinePath)
+		if err != nil {
+			return errors.Wrapf(err, "Unable to read baseline %s", baselinePath)
+		}
+		opts.Baseline = baseline
+	}
+
+	if opts.HCLSplit && opts.HCLPath == "" {
+		return errors.New("HCL split can only be used along with --gen-hcl")
+	}
 
-	analysis, err := ctl.Run()
+	cacheOptions, err := parseCacheFlags(cmd)
 	if err != nil {
 		return err
 	}
+	opts.RemoteOptions.AWS.Cache = cacheOptions
+	opts.RemoteOptions.Github.Cache = cacheOptions
+
+	if opts.Timeout < 0 || opts.SupplierTimeout < 0 {
+		return errors.New("Timeout cannot be negative")
+	}
+
+	return nil
+}
+
+func scanRun(opts *pkg.ScanOptions) error {
+	selectedOutput := output.GetOutput(opts.Output, opts.Quiet)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if opts.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(c)
+	go func() {
+		select {
+		case <-c:
+			logrus.Warn("Detected interrupt, cleanup ...")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
 
-	err = selectedOutput.Write(analysis)
+	resourceSchemaRepository := resource.NewSchemaRepository()
+
+	scanOpts := newScanOptions(opts, resourceSchemaRepository)
+	scanOpts.Outputs = scanOutputs(opts, selectedOutput)
+	scanOpts.IaCProgress = globaloutput.NewProgress("Scanning states", "Scanned states", true)
+	scanOpts.ScanProgress = globaloutput.NewProgress("Scanning resources", "Scanned resources", false)
+	analysis, err := scan.Scan(ctx, scanOpts)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return errors.Errorf("Scan timed out after %s", opts.Timeout)
+	}
 	if err != nil {
 		return err
 	}
@@ -229,9 +440,13 @@ func scanRun(opts *pkg.ScanOptions) error {
 		telemetry.SendTelemetry(analysis)
 	}
 
-	globaloutput.Printf(color.WhiteString("Provider version used to scan: %s. Use --tf-provider-version to use another version.\n"), resourceSchemaRepository.ProviderVersion.String())
+	printProviderVersions(resourceSchemaRepository)
 
-	if !analysis.IsSync() {
+	inSync := analysis.IsSync()
+	if baseline := analysis.Baseline(); baseline != nil {
+		inSync = !baseline.HasNewDrift()
+	}
+	if !inSync {
 		globaloutput.Printf("\nHint: use gen-driftignore command to generate a .driftignore file based on your drifts\n")
 
 		return cmderrors.InfrastructureNotInSync{}
@@ -240,6 +455,50 @@ func scanRun(opts *pkg.ScanOptions) error {
 	return nil
 }
 
+// scanOutputs returns outputs analyses are written to
+func scanOutputs(opts *pkg.ScanOptions, selectedOutput output.Output) []output.Output {
+	outputs := []output.Output{selectedOutput}
+	if opts.HCLPath != "" {
+		outputs = append(outputs, output.NewHCL(opts.HCLPath, opts.HCLSplit))
+	}
+	return outputs
+}
+
+// newScanOptions returns options of scans run from the command line, without any output nor progress
+func newScanOptions(opts *pkg.ScanOptions, resourceSchemaRepository *resource.SchemaRepository) scan.Options {
+	return scan.Options{
+		From:             opts.From,
+		BackendOptions:   opts.BackendOptions,
+		To:               opts.To,
+		ProviderVersion:  opts.ProviderVersion,
+		ConfigDir:        opts.ConfigDir,
+		RemoteOptions:    opts.RemoteOptions,
+		Filter:           opts.FilterExpression,
+		StrictMode:       opts.StrictMode,
+		ContinueOnError:  opts.ContinueOnError,
+		SupplierTimeout:  opts.SupplierTimeout,
+		DriftIgnorePaths: opts.DriftIgnorePaths,
+		Baseline:         opts.Baseline,
+		SchemaRepository: resourceSchemaRepository,
+	}
+}
+
+func printProviderVersions(resourceSchemaRepository *resource.SchemaRepository) {
+	if len(resourceSchemaRepository.ProviderVersions) == 1 {
+		for _, v := range resourceSchemaRepository.ProviderVersions {
+			globaloutput.Printf(color.WhiteString("Provider version used to scan: %s. Use --tf-provider-version to use another version.\n"), v.String())
+		}
+		return
+	}
+
+	versions := make([]string, 0, len(resourceSchemaRepository.ProviderVersions))
+	for name, v := range resourceSchemaRepository.ProviderVersions {
+		versions = append(versions, fmt.Sprintf("%s %s", name, v.String()))
+	}
+	sort.Strings(versions)
+	globaloutput.Printf(color.WhiteString("Provider versions used to scan: %s.\n"), strings.Join(versions, ", "))
+}
+
 func parseFromFlag(from []string) ([]config.SupplierConfig, error) {
 
 	configs := make([]config.SupplierConfig, 0, len(from))
@@ -315,6 +574,40 @@ func parseFromFlag(from []string) ([]config.SupplierConfig, error) {
 	return configs, nil
 }
 
+// parseFilter expands references to named expressions of a filter and compiles it, the expanded expression is returned.
+// References are only expanded, and fields only validated, when named expressions were read from a filter file.
+func parseFilter(expression string, named filter.NamedExpressions) (string, *jmespath.JMESPath, error) {
+	expanded := expression
+	var err error
+	if named != nil {
+		expanded, err = named.Expand(expression)
+		if err == nil {
+			err = filter.ValidateExpression(expanded)
+		}
+	}
+	if err != nil {
+		return "", nil, errors.Wrap(err, "unable to parse filter expression")
+	}
+	expr, err := filter.BuildExpression(expanded)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "unable to parse filter expression")
+	}
+	return expanded, expr, nil
+}
+
+func validateToFlag(to []string) error {
+	for _, r := range to {
+		if !remote.IsSupported(r) {
+			return errors.Errorf(
+				"unsupported cloud provider '%s'\nValid values are: %s",
+				r,
+				strings.Join(remote.GetSupportedRemotes(), ","),
+			)
+		}
+	}
+	return nil
+}
+
 func parseOutputFlag(out string) (*output.OutputConfig, error) {
 	schemeOpts := strings.Split(out, "://")
 	if len(schemeOpts) < 2 || schemeOpts[0] == "" {
@@ -370,6 +663,79 @@ func parseOutputFlag(out string) (*output.OutputConfig, error) {
 	}, nil
 }
 
+func validateRegionsFlag(regions []string) error {
+	for _, region := range regions {
+		if region == aws.AllRegions {
+			continue
+		}
+		if match, _ := regexp.MatchString("^[a-z]{2}(-[a-z]+)+-\\d$", region); !match {
+			return errors.Errorf("Invalid region %s, expected a valid AWS region (e.g. eu-west-3) or \"%s\"", region, aws.AllRegions)
+		}
+	}
+	return nil
+}
+
+func parseRetryFlags(cmd *cobra.Command) (retry.Options, error) {
+	opts := retry.Options{}
+	opts.MaxRetries, _ = cmd.Flags().GetInt("max-retries")
+	opts.MinBackoff, _ = cmd.Flags().GetDuration("retry-min-backoff")
+	opts.MaxBackoff, _ = cmd.Flags().GetDuration("retry-max-backoff")
+	opts.RateLimit, _ = cmd.Flags().GetFloat64("rate-limit")
+
+	if opts.MaxRetries < 0 {
+		return opts, errors.New("Max retries cannot be negative")
+	}
+	if opts.MinBackoff < 0 || opts.MaxBackoff < 0 {
+		return opts, errors.New("Retry backoff cannot be negative")
+	}
+	if opts.MinBackoff > opts.MaxBackoff {
+		return opts, errors.Errorf("Retry min backoff (%s) cannot be greater than max backoff (%s)", opts.MinBackoff, opts.MaxBackoff)
+	}
+	if opts.RateLimit < 0 {
+		return opts, errors.New("Rate limit cannot be negative")
+	}
+	return opts, nil
+}
+
+func parseCacheFlags(cmd *cobra.Command) (cache.DiskOptions, error) {
+	opts := cache.DiskOptions{}
+	opts.TTL, _ = cmd.Flags().GetDuration("cache-ttl")
+	opts.Refresh, _ = cmd.Flags().GetBool("refresh")
+
+	if opts.TTL < 0 {
+		return opts, errors.New("Cache TTL cannot be negative")
+	}
+	if opts.Refresh && !opts.Enabled() {
+		return opts, errors.New("Refresh can only be used along with a cache TTL")
+	}
+	return opts, nil
+}
+
+func validateConcurrencyFlag(concurrency map[string]int64) error {
+	for r, c := range concurrency {
+		if !remote.IsSupported(r) {
+			return errors.Errorf(
+				"unsupported cloud provider '%s' in concurrency\nValid values are: %s",
+				r,
+				strings.Join(remote.GetSupportedRemotes(), ","),
+			)
+		}
+		if c <= 0 {
+			return errors.Errorf("Concurrency of %s should be a positive number", r)
+		}
+	}
+	return nil
+}
+
+func validateAssumeRolesFlag(roles []string) error {
+	for _, role := range roles {
+		if match, _ := regexp.MatchString("^arn:aws[a-z-]*:iam::\\d{12}:role/.+$", role); !match {
+			return errors.Errorf("Invalid role ARN %s, expected a valid AWS role ARN (e.g. arn:aws:iam::123456789012:role/driftctl)", role)
+		}
+	}
+	return nil
+}
+
 func validateTfProviderVersionString(version string) error {
 	if version == "" {
 		return nil
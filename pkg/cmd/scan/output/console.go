@@ -7,6 +7,8 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/fatih/color"
@@ -18,6 +20,7 @@ import (
 	"github.com/cloudskiff/driftctl/pkg/analyser"
 	"github.com/cloudskiff/driftctl/pkg/remote"
 	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/stats"
 )
 
 const ConsoleOutputType = "console"
@@ -25,54 +28,56 @@ const ConsoleOutputExample = "console://"
 
 type Console struct {
 	summary string
+	// Print timings and API calls of each supplier and repository
+	showStats bool
 }
 
 func NewConsole() *Console {
 	return &Console{
-		`Total coverage is {{ analysis.Coverage }}`,
+		summary: `Total coverage is {{ analysis.Coverage }}`,
 	}
 }
 
 func (c *Console) Write(analysis *analyser.Analysis) error {
+	// Resources are grouped by region only when the analysis spans several ones
+	groupRegions := len(analysisRegions(analysis)) > 1
+
 	if analysis.Summary().TotalDeleted > 0 {
 		fmt.Println("Found missing resources:")
-		deletedByType, keys := groupByType(analysis.Deleted())
-		for _, ty := range keys {
-			fmt.Printf("  %s:\n", ty)
-			for _, res := range deletedByType[ty] {
-				humanString := fmt.Sprintf("    - %s", res.TerraformId())
-				if humanAttrs := formatResourceAttributes(res); humanAttrs != "" {
-					humanString += fmt.Sprintf("\n        %s", humanAttrs)
-				}
-				fmt.Println(humanString)
-			}
-		}
+		c.writeResources(analysis.Deleted(), groupRegions)
 	}
 
 	if analysis.Summary().TotalUnmanaged > 0 {
 		fmt.Println("Found resources not covered by IaC:")
-		unmanagedByType, keys := groupByType(analysis.Unmanaged())
-		for _, ty := range keys {
-			fmt.Printf("  %s:\n", ty)
-			for _, res := range unmanagedByType[ty] {
-				humanString := fmt.Sprintf("    - %s", res.TerraformId())
-				if humanAttrs := formatResourceAttributes(res); humanAttrs != "" {
-					humanString += fmt.Sprintf("\n        %s", humanAttrs)
-				}
-				fmt.Println(humanString)
-			}
-		}
+		c.writeResources(analysis.Unmanaged(), groupRegions)
 	}
 
 	if analysis.Summary().TotalDrifted > 0 {
 		fmt.Println("Found changed resources:")
-		for _, difference := range analysis.Differences() {
-			humanString := fmt.Sprintf("    - %s (%s):", difference.Res.TerraformId(), difference.Res.TerraformType())
+		differences := analysis.Differences()
+		if groupRegions {
+			differences = make([]analyser.Difference, len(analysis.Differences()))
+			copy(differences, analysis.Differences())
+			sort.SliceStable(differences, func(i, j int) bool {
+				return regionName(differences[i].Res) < regionName(differences[j].Res)
+			})
+		}
+		currentRegion := ""
+		for i, difference := range differences {
+			if region := regionName(difference.Res); groupRegions && (i == 0 || region != currentRegion) {
+				currentRegion = region
+				fmt.Printf("  %s:\n", region)
+			}
+			humanString := fmt.Sprintf("    - %s%s (%s):", difference.Res.TerraformId(), accountSuffix(difference.Res), difference.Res.TerraformType())
 			whiteSpace := "        "
 			if humanAttrs := formatResourceAttributes(difference.Res); humanAttrs != "" {
 				humanString += fmt.Sprintf("\n        %s", humanAttrs)
 				whiteSpace = "            "
 			}
+			if location := formatStateLocation(difference.Res); location != "" {
+				humanString += fmt.Sprintf("\n        %s", location)
+				whiteSpace = "            "
+			}
 			fmt.Println(humanString)
 			for _, change := range difference.Changelog {
 				path := strings.Join(change.Path, ".")
@@ -100,6 +105,18 @@ func (c *Console) Write(analysis *analyser.Analysis) error {
 
 	c.writeSummary(analysis)
 
+	if analysis.Baseline() != nil {
+		c.writeBaseline(analysis.Baseline())
+	}
+
+	if c.showStats && analysis.Stats() != nil {
+		c.writeStats(analysis.Stats())
+	}
+
+	if len(analysis.UnusedIgnoreRules()) > 0 {
+		c.writeUnusedIgnoreRules(analysis.UnusedIgnoreRules())
+	}
+
 	enumerationErrorMessage := ""
 	for _, alerts := range analysis.Alerts() {
 		for _, alert := range alerts {
@@ -117,6 +134,31 @@ func (c *Console) Write(analysis *analyser.Analysis) error {
 	return nil
 }
 
+func (c *Console) writeResources(resources []resource.Resource, groupRegions bool) {
+	indent := "  "
+	byRegion, regions := groupByRegion(resources)
+	for _, region := range regions {
+		if groupRegions {
+			fmt.Printf("  %s:\n", region)
+			indent = "    "
+		}
+		byType, keys := groupByType(byRegion[region])
+		for _, ty := range keys {
+			fmt.Printf("%s%s:\n", indent, ty)
+			for _, res := range byType[ty] {
+				humanString := fmt.Sprintf("%s  - %s%s", indent, res.TerraformId(), accountSuffix(res))
+				if humanAttrs := formatResourceAttributes(res); humanAttrs != "" {
+					humanString += fmt.Sprintf("\n%s      %s", indent, humanAttrs)
+				}
+				if location := formatStateLocation(res); location != "" {
+					humanString += fmt.Sprintf("\n%s      %s", indent, location)
+				}
+				fmt.Println(humanString)
+			}
+		}
+	}
+}
+
 func (c Console) writeSummary(analysis *analyser.Analysis) {
 	boldWriter := color.New(color.Bold)
 	successWriter := color.New(color.Bold, color.FgGreen)
@@ -160,11 +202,143 @@ func (c Console) writeSummary(analysis *analyser.Analysis) {
 		}
 		fmt.Printf(" - %s changed outside of IaC\n", boldWriter.Sprintf("%s/%d", drifted, analysis.Summary().TotalManaged))
 	}
-	if analysis.IsSync() {
+	if !analysis.IsComplete() {
+		fmt.Printf(" - %s resource type(s) could not be scanned\n", warningWriter.Sprintf("%d", len(analysis.IncompleteScans())))
+	}
+	c.writeProvidersSummary(analysis)
+	c.writeAccountsSummary(analysis)
+	if analysis.IsSync() && analysis.IsComplete() {
 		fmt.Println(color.GreenString("Congrats! Your infrastructure is fully in sync."))
 	}
 }
 
+func (c Console) writeUnusedIgnoreRules(rules []analyser.IgnoreRule) {
+	fmt.Println("Ignore rules matching nothing:")
+	for _, rule := range rules {
+		location := fmt.Sprintf("%s:%d", rule.File, rule.Line)
+		if rule.Owner != "" {
+			location += fmt.Sprintf(", owner: %s", rule.Owner)
+		}
+		fmt.Printf("  - %s (%s)\n", rule.Rule, location)
+	}
+}
+
+func (c Console) writeBaseline(baseline *analyser.Baseline) {
+	boldWriter := color.New(color.Bold)
+	fmt.Println("Compared to baseline:")
+	fmt.Printf(" - %s new drift(s)\n", boldWriter.Sprintf("%d", baseline.New.Len()))
+	fmt.Printf(" - %s still present\n", boldWriter.Sprintf("%d", baseline.StillPresent.Len()))
+	fmt.Printf(" - %s resolved\n", boldWriter.Sprintf("%d", baseline.Resolved.Len()))
+
+	if baseline.HasNewDrift() {
+		fmt.Println("New drift:")
+		c.writeDrift(baseline.New)
+	}
+	if baseline.Resolved.Len() > 0 {
+		fmt.Println("Resolved drift:")
+		c.writeDrift(baseline.Resolved)
+	}
+	if !baseline.HasNewDrift() {
+		fmt.Println(color.GreenString("No new drift since baseline."))
+	}
+}
+
+func (c Console) writeDrift(drift analyser.Drift) {
+	for _, res := range drift.Unmanaged {
+		fmt.Printf("  - %s%s (%s): not covered by IaC\n", res.TerraformId(), accountSuffix(res), res.TerraformType())
+	}
+	for _, res := range drift.Deleted {
+		fmt.Printf("  - %s%s (%s): missing on cloud provider\n", res.TerraformId(), accountSuffix(res), res.TerraformType())
+	}
+	for _, d := range drift.Differences {
+		fmt.Printf("  - %s%s (%s): changed outside of IaC\n", d.Res.TerraformId(), accountSuffix(d.Res), d.Res.TerraformType())
+	}
+}
+
+func (c Console) writeStats(report *stats.Report) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Println("Suppliers:")
+	fmt.Fprintln(w, "  NAME\tACCOUNT\tREGION\tDURATION\tRESOURCES\tREAD CALLS\tRETRIES")
+	for _, s := range report.Suppliers {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%d\t%d\t%d\n",
+			s.Name,
+			valueOrDash(s.Account),
+			valueOrDash(s.Region),
+			time.Duration(s.DurationMs)*time.Millisecond,
+			s.Resources,
+			s.ReadResourceCalls,
+			s.Retries,
+		)
+	}
+	_ = w.Flush()
+
+	fmt.Println("Repositories:")
+	fmt.Fprintln(w, "  NAME\tDURATION\tAPI CALLS\tRETRIES\tCACHE HIT RATE")
+	for _, r := range report.Repositories {
+		fmt.Fprintf(w, "  %s\t%s\t%d\t%d\t%.0f%%\n",
+			r.Name,
+			time.Duration(r.DurationMs)*time.Millisecond,
+			r.APICalls,
+			r.Retries,
+			r.CacheHit*100,
+		)
+	}
+	_ = w.Flush()
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+func (c Console) writeAccountsSummary(analysis *analyser.Analysis) {
+	c.writeSplitSummary("Per account:", analysis.SummaryByAccount())
+}
+
+func (c Console) writeProvidersSummary(analysis *analyser.Analysis) {
+	summaries := analysis.SummaryByProvider()
+	// Summary is only split when several providers were scanned
+	if len(summaries) < 2 {
+		return
+	}
+	c.writeSplitSummary("Per provider:", summaries)
+}
+
+func (c Console) writeSplitSummary(title string, summaries map[string]analyser.Summary) {
+	if len(summaries) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(summaries))
+	for key := range summaries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	boldWriter := color.New(color.Bold)
+	fmt.Println(title)
+	for _, key := range keys {
+		summary := summaries[key]
+		coverage := 0
+		if summary.TotalResources > 0 {
+			coverage = int((float32(summary.TotalManaged) / float32(summary.TotalResources)) * 100.0)
+		}
+		fmt.Printf(
+			" - %s: %s resource(s), %s%% coverage, %d not covered by IaC, %d missing on cloud provider, %d/%d changed outside of IaC\n",
+			key,
+			boldWriter.Sprintf("%d", summary.TotalResources),
+			boldWriter.Sprintf("%d", coverage),
+			summary.TotalUnmanaged,
+			summary.TotalDeleted,
+			summary.TotalDrifted,
+			summary.TotalManaged,
+		)
+	}
+}
+
 func prettify(resource interface{}) string {
 	res := reflect.ValueOf(resource)
 	if resource == nil || res.Kind() == reflect.Ptr && res.IsNil() {
@@ -193,6 +367,61 @@ func groupByType(resources []resource.Resource) (map[string][]resource.Resource,
 	return result, keys
 }
 
+// Global resources and resources from IaC that were not found on the cloud provider have no region
+const noRegion = "global"
+
+func regionName(res resource.Resource) string {
+	if region := resource.MetadataOf(res).Region; region != "" {
+		return region
+	}
+	return noRegion
+}
+
+func groupByRegion(resources []resource.Resource) (map[string][]resource.Resource, []string) {
+	result := map[string][]resource.Resource{}
+	for _, res := range resources {
+		region := regionName(res)
+		result[region] = append(result[region], res)
+	}
+
+	keys := make([]string, 0, len(result))
+	for k := range result {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return result, keys
+}
+
+// accountSuffix tells which account a resource belongs to, as the same resource may exist in several accounts
+func accountSuffix(res resource.Resource) string {
+	if account := resource.MetadataOf(res).Account; account != "" {
+		return fmt.Sprintf(" [%s]", account)
+	}
+	return ""
+}
+
+// formatStateLocation tells where a resource read from IaC is defined, so it can be found among states
+func formatStateLocation(res resource.Resource) string {
+	meta := resource.MetadataOf(res)
+	if meta.Address == "" {
+		return ""
+	}
+	if meta.Source == "" {
+		return fmt.Sprintf("Address: %s", meta.Address)
+	}
+	return fmt.Sprintf("Address: %s, State: %s", meta.Address, meta.Source)
+}
+
+func analysisRegions(analysis *analyser.Analysis) []string {
+	resources := make([]resource.Resource, 0, analysis.Summary().TotalResources)
+	resources = append(resources, analysis.Managed()...)
+	resources = append(resources, analysis.Unmanaged()...)
+	resources = append(resources, analysis.Deleted()...)
+	_, regions := groupByRegion(resources)
+	return regions
+}
+
 func jsonDiff(a, b interface{}, prefix string) string {
 	aStr := fmt.Sprintf("%s", a)
 	bStr := fmt.Sprintf("%s", b)
@@ -214,10 +443,7 @@ func jsonDiff(a, b interface{}, prefix string) string {
 }
 
 func formatResourceAttributes(res resource.Resource) string {
-	if res.Schema() == nil || res.Schema().HumanReadableAttributesFunc == nil {
-		return ""
-	}
-	attributes := res.Schema().HumanReadableAttributesFunc(res.(*resource.AbstractResource))
+	attributes := resource.HumanReadableAttributes(res)
 	if len(attributes) <= 0 {
 		return ""
 	}
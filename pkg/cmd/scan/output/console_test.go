@@ -24,6 +24,7 @@ func TestConsole_Write(t *testing.T) {
 		name       string
 		goldenfile string
 		args       args
+		showStats  bool
 		wantErr    bool
 	}{
 		{
@@ -85,6 +86,61 @@ func TestConsole_Write(t *testing.T) {
 			args:       args{analysis: fakeAnalysisWithComputedFields()},
 			wantErr:    false,
 		},
+		{
+			name:       "test console output with several regions",
+			goldenfile: "output_regions.txt",
+			args:       args{analysis: fakeAnalysisWithRegions()},
+			wantErr:    false,
+		},
+		{
+			name:       "test console output with several accounts",
+			goldenfile: "output_accounts.txt",
+			args:       args{analysis: fakeAnalysisWithAccounts()},
+			wantErr:    false,
+		},
+		{
+			name:       "test console output with terraform addresses",
+			goldenfile: "output_addresses.txt",
+			args:       args{analysis: fakeAnalysisWithAddresses()},
+			wantErr:    false,
+		},
+		{
+			name:       "test console output with several providers",
+			goldenfile: "output_providers.txt",
+			args:       args{analysis: fakeAnalysisWithProviders()},
+			wantErr:    false,
+		},
+		{
+			name:       "test console output with incomplete scan",
+			goldenfile: "output_incomplete_scan.txt",
+			args:       args{analysis: fakeAnalysisWithIncompleteScan()},
+			wantErr:    false,
+		},
+		{
+			name:       "test console output with unused and expired ignore rules",
+			goldenfile: "output_ignore_rules.txt",
+			args:       args{analysis: fakeAnalysisWithIgnoreRules()},
+			wantErr:    false,
+		},
+		{
+			name:       "test console output with stats",
+			goldenfile: "output_stats.txt",
+			args:       args{analysis: fakeAnalysisWithStats()},
+			showStats:  true,
+			wantErr:    false,
+		},
+		{
+			name:       "test console output with baseline",
+			goldenfile: "output_baseline.txt",
+			args:       args{analysis: fakeAnalysisWithBaseline()},
+			wantErr:    false,
+		},
+		{
+			name:       "test console output with stats not shown",
+			goldenfile: "output_stats_hidden.txt",
+			args:       args{analysis: fakeAnalysisWithStats()},
+			wantErr:    false,
+		},
 		{
 			name:       "test console output with AWS enumeration alerts",
 			goldenfile: "output_access_denied_alert_aws.txt",
@@ -104,6 +160,7 @@ func TestConsole_Write(t *testing.T) {
 			aws.InitResourcesMetadata(repo)
 
 			c := NewConsole()
+			c.showStats = tt.showStats
 
 			stdout := os.Stdout // keep backup of the real stdout
 			stderr := os.Stderr // keep backup of the real stderr
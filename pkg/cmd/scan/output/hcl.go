@@ -0,0 +1,20 @@
+package output
+
+import (
+	"github.com/cloudskiff/driftctl/pkg/analyser"
+	"github.com/cloudskiff/driftctl/pkg/generate"
+)
+
+// HCL writes resource blocks for resources not covered by IaC, it comes along with the selected output
+type HCL struct {
+	path  string
+	split bool
+}
+
+func NewHCL(path string, split bool) *HCL {
+	return &HCL{path, split}
+}
+
+func (h *HCL) Write(analysis *analyser.Analysis) error {
+	return generate.WriteHCLFiles(h.path, h.split, analysis.Unmanaged())
+}
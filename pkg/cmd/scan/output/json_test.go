@@ -40,6 +40,54 @@ func TestJSON_Write(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:       "test json output with terraform addresses",
+			goldenfile: "output_addresses.json",
+			args: args{
+				analysis: fakeAnalysisWithAddresses(),
+			},
+			wantErr: false,
+		},
+		{
+			name:       "test json output with several providers",
+			goldenfile: "output_providers.json",
+			args: args{
+				analysis: fakeAnalysisWithProviders(),
+			},
+			wantErr: false,
+		},
+		{
+			name:       "test json output with unused and expired ignore rules",
+			goldenfile: "output_ignore_rules.json",
+			args: args{
+				analysis: fakeAnalysisWithIgnoreRules(),
+			},
+			wantErr: false,
+		},
+		{
+			name:       "test json output with incomplete scan",
+			goldenfile: "output_incomplete_scan.json",
+			args: args{
+				analysis: fakeAnalysisWithIncompleteScan(),
+			},
+			wantErr: false,
+		},
+		{
+			name:       "test json output with stats",
+			goldenfile: "output_stats.json",
+			args: args{
+				analysis: fakeAnalysisWithStats(),
+			},
+			wantErr: false,
+		},
+		{
+			name:       "test json output with baseline",
+			goldenfile: "output_baseline.json",
+			args: args{
+				analysis: fakeAnalysisWithBaseline(),
+			},
+			wantErr: false,
+		},
 		{
 			name:       "test json output with AWS enumeration alerts",
 			goldenfile: "output_access_denied_alert_aws.json",
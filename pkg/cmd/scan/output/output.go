@@ -56,7 +56,9 @@ func GetOutput(config OutputConfig, quiet bool) Output {
 	case ConsoleOutputType:
 		fallthrough
 	default:
-		return NewConsole()
+		console := NewConsole()
+		console.showStats = config.Options["stats"] == "true"
+		return console
 	}
 }
 
@@ -1,6 +1,7 @@
 package output
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -12,6 +13,7 @@ import (
 	"github.com/cloudskiff/driftctl/pkg/remote/aws"
 	"github.com/cloudskiff/driftctl/pkg/remote/github"
 	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/stats"
 	testresource "github.com/cloudskiff/driftctl/test/resource"
 	"github.com/r3labs/diff/v2"
 )
@@ -289,6 +291,318 @@ func fakeAnalysisWithComputedFields() *analyser.Analysis {
 	return &a
 }
 
+func fakeAnalysisWithRegions() *analyser.Analysis {
+	a := analyser.Analysis{}
+	a.AddUnmanaged(
+		&resource.AbstractResource{
+			Id:   "i-0123",
+			Type: "aws_instance",
+			Meta: resource.Meta{Region: "us-east-1"},
+		},
+		&resource.AbstractResource{
+			Id:   "i-4567",
+			Type: "aws_instance",
+			Meta: resource.Meta{Region: "eu-west-3"},
+		},
+		&resource.AbstractResource{
+			Id:   "my-role",
+			Type: "aws_iam_role",
+		},
+	)
+	a.AddDeleted(
+		&resource.AbstractResource{
+			Id:   "i-89ab",
+			Type: "aws_instance",
+		},
+	)
+	a.AddManaged(
+		&resource.AbstractResource{
+			Id:   "bucket-eu",
+			Type: "aws_s3_bucket",
+			Meta: resource.Meta{Region: "eu-west-3"},
+		},
+		&resource.AbstractResource{
+			Id:   "bucket-us",
+			Type: "aws_s3_bucket",
+			Meta: resource.Meta{Region: "us-east-1"},
+		},
+	)
+	a.AddDifference(
+		analyser.Difference{Res: &resource.AbstractResource{
+			Id:   "bucket-us",
+			Type: "aws_s3_bucket",
+			Meta: resource.Meta{Region: "us-east-1"},
+		}, Changelog: []analyser.Change{
+			{
+				Change: diff.Change{
+					Type: diff.UPDATE,
+					Path: []string{"acl"},
+					From: "private",
+					To:   "public-read",
+				},
+			},
+		}},
+		analyser.Difference{Res: &resource.AbstractResource{
+			Id:   "bucket-eu",
+			Type: "aws_s3_bucket",
+			Meta: resource.Meta{Region: "eu-west-3"},
+		}, Changelog: []analyser.Change{
+			{
+				Change: diff.Change{
+					Type: diff.DELETE,
+					Path: []string{"policy"},
+					From: "{}",
+					To:   nil,
+				},
+			},
+		}},
+	)
+	return &a
+}
+
+func fakeAnalysisWithAccounts() *analyser.Analysis {
+	a := analyser.Analysis{}
+	a.AddUnmanaged(
+		&resource.AbstractResource{
+			Id:   "admin",
+			Type: "aws_iam_role",
+			Meta: resource.Meta{Account: "111111111111"},
+		},
+		&resource.AbstractResource{
+			Id:   "admin",
+			Type: "aws_iam_role",
+			Meta: resource.Meta{Account: "222222222222"},
+		},
+	)
+	a.AddDeleted(
+		&resource.AbstractResource{
+			Id:   "i-89ab",
+			Type: "aws_instance",
+		},
+	)
+	a.AddManaged(
+		&resource.AbstractResource{
+			Id:   "bucket",
+			Type: "aws_s3_bucket",
+			Meta: resource.Meta{Account: "111111111111", Region: "eu-west-3"},
+		},
+	)
+	a.AddDifference(
+		analyser.Difference{Res: &resource.AbstractResource{
+			Id:   "bucket",
+			Type: "aws_s3_bucket",
+			Meta: resource.Meta{Account: "111111111111", Region: "eu-west-3"},
+		}, Changelog: []analyser.Change{
+			{
+				Change: diff.Change{
+					Type: diff.UPDATE,
+					Path: []string{"acl"},
+					From: "private",
+					To:   "public-read",
+				},
+			},
+		}},
+	)
+	return &a
+}
+
+func fakeAnalysisWithAddresses() *analyser.Analysis {
+	a := analyser.Analysis{}
+	a.AddUnmanaged(
+		&resource.AbstractResource{
+			Id:   "unmanaged-bucket",
+			Type: "aws_s3_bucket",
+		},
+	)
+	a.AddDeleted(
+		&resource.AbstractResource{
+			Id:   "i-89ab",
+			Type: "aws_instance",
+			Meta: resource.Meta{Address: "module.web.aws_instance.web[0]", Source: "tfstate+s3://states/web.tfstate"},
+		},
+	)
+	a.AddManaged(
+		&resource.AbstractResource{
+			Id:   "logs-eu",
+			Type: "aws_s3_bucket",
+			Meta: resource.Meta{Address: `module.logs.aws_s3_bucket.logs["eu"]`, Source: "tfstate://terraform.tfstate"},
+		},
+	)
+	a.AddDifference(
+		analyser.Difference{Res: &resource.AbstractResource{
+			Id:   "logs-eu",
+			Type: "aws_s3_bucket",
+			Meta: resource.Meta{Address: `module.logs.aws_s3_bucket.logs["eu"]`, Source: "tfstate://terraform.tfstate"},
+		}, Changelog: []analyser.Change{
+			{
+				Change: diff.Change{
+					Type: diff.UPDATE,
+					Path: []string{"acl"},
+					From: "private",
+					To:   "public-read",
+				},
+			},
+		}},
+	)
+	return &a
+}
+
+func fakeAnalysisWithProviders() *analyser.Analysis {
+	a := analyser.Analysis{}
+	a.AddUnmanaged(
+		&resource.AbstractResource{
+			Id:   "my-bucket",
+			Type: "aws_s3_bucket",
+		},
+		&resource.AbstractResource{
+			Id:   "my-repository",
+			Type: "github_repository",
+		},
+	)
+	a.AddDeleted(
+		&resource.AbstractResource{
+			Id:   "my-team",
+			Type: "github_team",
+		},
+	)
+	a.AddManaged(
+		&resource.AbstractResource{
+			Id:   "my-role",
+			Type: "aws_iam_role",
+		},
+		&resource.AbstractResource{
+			Id:   "my-other-repository",
+			Type: "github_repository",
+		},
+	)
+	a.AddDifference(
+		analyser.Difference{Res: &resource.AbstractResource{
+			Id:   "my-other-repository",
+			Type: "github_repository",
+		}, Changelog: []analyser.Change{
+			{
+				Change: diff.Change{
+					Type: diff.UPDATE,
+					Path: []string{"description"},
+					From: "foo",
+					To:   "bar",
+				},
+			},
+		}},
+	)
+	return &a
+}
+
+func fakeAnalysisWithIncompleteScan() *analyser.Analysis {
+	a := analyser.Analysis{}
+	a.AddManaged(
+		&resource.AbstractResource{
+			Id:   "my-bucket",
+			Type: "aws_s3_bucket",
+		},
+	)
+	a.AddIncompleteScan(analyser.IncompleteScan{
+		Type:  "aws_iam_role",
+		Error: "Throttling: Rate exceeded",
+	})
+	a.SetAlerts(alerter.Alerts{
+		"aws_iam_role": []alerter.Alert{
+			analyser.NewScanIncompleteAlert("aws_iam_role", resource.Meta{}, errors.New("Throttling: Rate exceeded")),
+		},
+	})
+	return &a
+}
+
+func fakeAnalysisWithIgnoreRules() *analyser.Analysis {
+	a := analyser.Analysis{}
+	a.AddManaged(
+		&resource.AbstractResource{
+			Id:   "my-bucket",
+			Type: "aws_s3_bucket",
+		},
+	)
+	a.AddUnusedIgnoreRules(
+		analyser.IgnoreRule{Rule: "aws_s3_bucket.old-logs", File: ".driftignore", Line: 2},
+		analyser.IgnoreRule{Rule: "aws_iam_user.*.tags", File: "teams/.driftignore", Line: 5, Owner: "team-iam", Expires: "2999-01-01"},
+	)
+	expired := analyser.IgnoreRule{Rule: "aws_instance.tmp-*", File: ".driftignore", Line: 4, Owner: "team-compute", Expires: "2021-06-30"}
+	a.AddExpiredIgnoreRules(expired)
+	a.SetAlerts(alerter.Alerts{
+		"": []alerter.Alert{
+			analyser.NewExpiredIgnoreRuleAlert(expired),
+		},
+	})
+	return &a
+}
+
+func fakeAnalysisWithStats() *analyser.Analysis {
+	a := analyser.Analysis{}
+	a.AddManaged(
+		&resource.AbstractResource{
+			Id:   "my-bucket",
+			Type: "aws_s3_bucket",
+		},
+	)
+	a.SetStats(&stats.Report{
+		Suppliers: []stats.SupplierStats{
+			{
+				Name:              "aws_s3_bucket",
+				Region:            "us-east-1",
+				DurationMs:        12500,
+				Resources:         1,
+				ReadResourceCalls: 1,
+				Retries:           2,
+			},
+			{
+				Name:       "aws_iam_role",
+				DurationMs: 800,
+			},
+		},
+		Repositories: []stats.RepositoryStats{
+			{
+				Name:        "s3",
+				DurationMs:  1200,
+				APICalls:    4,
+				Retries:     2,
+				CacheHits:   3,
+				CacheMisses: 1,
+				CacheHit:    0.75,
+			},
+			{
+				Name:        "iam",
+				DurationMs:  300,
+				APICalls:    1,
+				CacheMisses: 1,
+			},
+		},
+	})
+	return &a
+}
+
+func fakeAnalysisWithBaseline() *analyser.Analysis {
+	baseline := analyser.Analysis{}
+	baseline.AddUnmanaged(
+		&testresource.FakeResource{
+			Id:   "unmanaged-id-1",
+			Type: "aws_unmanaged_resource",
+		},
+		&testresource.FakeResource{
+			Id:   "unmanaged-id-3",
+			Type: "aws_unmanaged_resource",
+		},
+	)
+	baseline.AddDeleted(
+		&testresource.FakeResource{
+			Id:   "deleted-id-1",
+			Type: "aws_deleted_resource",
+		},
+	)
+
+	a := fakeAnalysis()
+	a.CompareToBaseline(&baseline)
+	return a
+}
+
 func fakeAnalysisWithAWSEnumerationError() *analyser.Analysis {
 	a := analyser.Analysis{}
 	a.SetAlerts(alerter.Alerts{
@@ -22,11 +22,6 @@ func TestScanCmd(t *testing.T) {
 }
 
 func TestScanCmd_Valid(t *testing.T) {
-	rootCmd := &cobra.Command{Use: "root"}
-	scanCmd := NewScanCmd()
-	scanCmd.RunE = func(_ *cobra.Command, args []string) error { return nil }
-	rootCmd.AddCommand(scanCmd)
-
 	cases := []struct {
 		args []string
 	}{
@@ -42,12 +37,39 @@ func TestScanCmd_Valid(t *testing.T) {
 		{args: []string{"scan", "--to", "aws+tf", "--from", "tfstate+tfcloud://workspace_id"}},
 		{args: []string{"scan", "--tfc-token", "token"}},
 		{args: []string{"scan", "--filter", "Type=='aws_s3_bucket'"}},
+		{args: []string{"scan", "--filter", "Attr.description=='${foo}'"}},
+		// Fields of plain filters are not validated, only expressions of filter files are
+		{args: []string{"scan", "--filter", "type=='aws_s3_bucket'"}},
+		{args: []string{"scan", "--filter", "true"}},
+		{args: []string{"scan", "--filter-file", "testdata/filters/filters.yml", "--filter", "Attr.description=='${foo}' && ${buckets}"}},
+		{args: []string{"scan", "--filter-file", "testdata/filters/filters.yml", "--filter", "${unmanaged_buckets} || Type=='aws_instance'"}},
 		{args: []string{"scan", "--strict"}},
+		{args: []string{"scan", "--continue-on-error"}},
+		{args: []string{"scan", "--timeout", "30m", "--supplier-timeout", "5m"}},
+		{args: []string{"scan", "--cache-ttl", "1h"}},
+		{args: []string{"scan", "--cache-ttl", "1h", "--refresh"}},
+		{args: []string{"scan", "--gen-hcl", "unmanaged.tf"}},
+		{args: []string{"scan", "--gen-hcl", "generated", "--gen-hcl-split"}},
+		{args: []string{"scan", "--driftignore", "testdata/driftignore/shared.driftignore", "--driftignore", "testdata/driftignore/team.driftignore"}},
+		{args: []string{"scan", "--max-retries", "5", "--retry-min-backoff", "1s", "--retry-max-backoff", "1m", "--rate-limit", "2.5"}},
+		{args: []string{"scan", "--to", "aws+tf,github+tf", "--concurrency", "aws+tf=20,github+tf=5"}},
 		{args: []string{"scan", "--tf-provider-version", "1.2.3"}},
 		{args: []string{"scan", "--tf-provider-version", "3.30.2"}},
+		{args: []string{"scan", "--to", "aws+tf,github+tf"}},
+		{args: []string{"scan", "-t", "aws+tf", "-t", "github+tf"}},
+		{args: []string{"scan", "--regions", "eu-west-3,us-east-1"}},
+		{args: []string{"scan", "--regions", "all"}},
+		{args: []string{"scan", "--aws-assume-role", "arn:aws:iam::123456789012:role/driftctl", "--aws-assume-role-external-id", "foo"}},
+		{args: []string{"scan", "--aws-organization-role", "OrganizationAccountAccessRole"}},
 	}
 
 	for _, tt := range cases {
+		// Flag values are kept between executions, so use a new command for each case
+		rootCmd := &cobra.Command{Use: "root"}
+		scanCmd := NewScanCmd()
+		scanCmd.RunE = func(_ *cobra.Command, args []string) error { return nil }
+		rootCmd.AddCommand(scanCmd)
+
 		output, err := test.Execute(rootCmd, tt.args...)
 		if output != "" {
 			t.Errorf("Unexpected output: %v", output)
@@ -83,8 +105,28 @@ func TestScanCmd_Invalid(t *testing.T) {
 		{args: []string{"scan", "--from", "tfstate:///tmp/test", "--from", "tfstate+toto://test"}, expected: "Unsupported IaC backend 'toto': \nAccepted values are: s3,http,https,tfcloud"},
 		{args: []string{"scan", "--filter", "Type='test'"}, expected: "unable to parse filter expression: SyntaxError: Expected tRbracket, received: tUnknown"},
 		{args: []string{"scan", "--filter", "Type='test'", "--filter", "Type='test2'"}, expected: "Filter flag should be specified only once"},
+		{args: []string{"scan", "--filter-file", "testdata/filters/filters.yml", "--filter", "${buckets} && type=='aws_s3_bucket'"}, expected: "unable to parse filter expression: unknown field type, resources only have fields Attr, Id, Res, Type"},
+		{args: []string{"scan", "--filter", "${buckets}"}, expected: "unable to parse filter expression: SyntaxError: Unknown char: '$'"},
+		{args: []string{"scan", "--filter-file", "testdata/filters/filters.yml"}, expected: "Filter file can only be used along with a filter referencing its expressions (e.g. --filter '${name}')"},
+		{args: []string{"scan", "--filter-file", "testdata/filters/filters.yml", "--filter", "${tagged}"}, expected: "unable to parse filter expression: unknown filter tagged"},
+		{args: []string{"scan", "--filter-file", "testdata/filters/invalid.yml", "--filter", "${sandbox}"}, expected: "Invalid filter file testdata/filters/invalid.yml: filter sandbox is invalid: unknown field id, resources only have fields Attr, Id, Res, Type"},
 		{args: []string{"scan", "--tf-provider-version", ".30.2"}, expected: "Invalid version argument .30.2, expected a valid semver string (e.g. 2.13.4)"},
 		{args: []string{"scan", "--tf-provider-version", "foo"}, expected: "Invalid version argument foo, expected a valid semver string (e.g. 2.13.4)"},
+		{args: []string{"scan", "--to", "aws+tf,glou"}, expected: "unsupported cloud provider 'glou'\nValid values are: aws+tf,github+tf"},
+		{args: []string{"scan", "--to", "aws+tf,github+tf", "--tf-provider-version", "3.30.2"}, expected: "Provider version can only be set when scanning a single cloud provider"},
+		{args: []string{"scan", "--regions", "eu-west"}, expected: "Invalid region eu-west, expected a valid AWS region (e.g. eu-west-3) or \"all\""},
+		{args: []string{"scan", "--aws-assume-role", "driftctl"}, expected: "Invalid role ARN driftctl, expected a valid AWS role ARN (e.g. arn:aws:iam::123456789012:role/driftctl)"},
+		{args: []string{"scan", "--max-retries", "-1"}, expected: "Max retries cannot be negative"},
+		{args: []string{"scan", "--retry-min-backoff", "1m", "--retry-max-backoff", "1s"}, expected: "Retry min backoff (1m0s) cannot be greater than max backoff (1s)"},
+		{args: []string{"scan", "--rate-limit", "-2"}, expected: "Rate limit cannot be negative"},
+		{args: []string{"scan", "--concurrency", "glou=2"}, expected: "unsupported cloud provider 'glou' in concurrency\nValid values are: aws+tf,github+tf"},
+		{args: []string{"scan", "--concurrency", "aws+tf=0"}, expected: "Concurrency of aws+tf should be a positive number"},
+		{args: []string{"scan", "--supplier-timeout", "-1s"}, expected: "Timeout cannot be negative"},
+		{args: []string{"scan", "--cache-ttl", "-1h"}, expected: "Cache TTL cannot be negative"},
+		{args: []string{"scan", "--refresh"}, expected: "Refresh can only be used along with a cache TTL"},
+		{args: []string{"scan", "--gen-hcl-split"}, expected: "HCL split can only be used along with --gen-hcl"},
+		{args: []string{"scan", "--driftignore", "testdata/driftignore/missing.driftignore"}, expected: "Unable to read driftignore file testdata/driftignore/missing.driftignore"},
+		{args: []string{"scan", "--baseline", "testdata/missing.json"}, expected: "Unable to read baseline testdata/missing.json: open testdata/missing.json: no such file or directory"},
 	}
 
 	for _, tt := range cases {
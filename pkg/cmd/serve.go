@@ -0,0 +1,213 @@
+package cmd
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/cloudskiff/driftctl/pkg"
+	"github.com/cloudskiff/driftctl/pkg/analyser"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/scan"
+	"github.com/cloudskiff/driftctl/pkg/serve"
+)
+
+type serveOptions struct {
+	Address      string
+	Token        string
+	QueueSize    int
+	Workers      int
+	RetainedJobs int
+}
+
+func NewServeCmd() *cobra.Command {
+	opts := &pkg.ScanOptions{}
+	opts.BackendOptions = &backend.Options{}
+	serveOpts := &serveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Run scans on demand through an HTTP API",
+		Long: "This command serves an HTTP API queuing scan jobs:\n" +
+			"  - POST /jobs queues a scan, e.g. {\"from\": [\"tfstate+s3://bucket/terraform.tfstate\"], \"to\": [\"aws+tf\"], \"filter\": \"Type=='aws_s3_bucket'\"}\n" +
+			"  - GET /jobs lists jobs\n" +
+			"  - GET /jobs/{id} returns the status of a job\n" +
+			"  - GET /jobs/{id}/analysis returns the analysis of a done job in the JSON output format\n" +
+			"  - DELETE /jobs/{id} cancels a job\n\n" +
+			"Sources, cloud providers and filter missing from a job default to the ones given on the command line.\n" +
+			"Scans run with the credentials of this host, so the API only listens on localhost by default.\n" +
+			"Set a token with DCTL_API_TOKEN before listening on other interfaces, clients then send it as \"Authorization: Bearer <token>\".\n\n" +
+			"Example: DCTL_API_TOKEN=s3cr3t driftctl serve --address :8080 --workers 2",
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := parseScanFlags(cmd, opts); err != nil {
+				return err
+			}
+			if serveOpts.QueueSize < 1 {
+				return errors.New("Queue size must be greater than zero")
+			}
+			if serveOpts.Workers < 1 {
+				return errors.New("Workers must be greater than zero")
+			}
+			if serveOpts.RetainedJobs < 1 {
+				return errors.New("Retained jobs must be greater than zero")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return serveRun(opts, serveOpts)
+		},
+	}
+
+	addScanFlags(cmd, opts)
+	fl := cmd.Flags()
+	// Results are only returned through the API
+	for _, name := range []string{"output", "quiet", "baseline", "gen-hcl", "gen-hcl-split"} {
+		_ = fl.MarkHidden(name)
+	}
+	fl.StringVar(&serveOpts.Address,
+		"address",
+		"127.0.0.1:8080",
+		"Address to serve the API on\n",
+	)
+	fl.StringVar(&serveOpts.Token,
+		"api-token",
+		"",
+		"Token clients must send as a bearer token, prefer setting it with the DCTL_API_TOKEN environment variable\n",
+	)
+	fl.IntVar(&serveOpts.QueueSize,
+		"queue-size",
+		10,
+		"Maximum number of jobs waiting to be run, further jobs are rejected\n",
+	)
+	fl.IntVar(&serveOpts.Workers,
+		"workers",
+		1,
+		"Number of jobs run at the same time\n",
+	)
+	fl.IntVar(&serveOpts.RetainedJobs,
+		"retained-jobs",
+		100,
+		"Number of finished jobs kept along with their analysis, older ones are forgotten\n",
+	)
+
+	return cmd
+}
+
+func serveRun(opts *pkg.ScanOptions, serveOpts *serveOptions) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(c)
+	go func() {
+		select {
+		case <-c:
+			logrus.Warn("Detected interrupt, cleanup ...")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	queue := serve.NewQueue(ctx, func(ctx context.Context, req serve.JobRequest) (*analyser.Analysis, error) {
+		scanOpts, err := jobScanOptions(opts, req)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+			defer cancel()
+		}
+		return scan.Scan(ctx, scanOpts)
+	}, serveOpts.QueueSize, serveOpts.RetainedJobs)
+
+	queueDone := make(chan struct{})
+	go func() {
+		queue.Run(serveOpts.Workers)
+		close(queueDone)
+	}()
+
+	handler := serve.NewHandler(queue, func(req serve.JobRequest) error {
+		_, err := jobScanOptions(opts, req)
+		return err
+	})
+	if serveOpts.Token != "" {
+		handler = serve.RequireToken(serveOpts.Token, handler)
+	} else if !isLoopbackAddress(serveOpts.Address) {
+		logrus.Warnf("Serving on %s without token, anyone reaching this address can run scans with the credentials of this host", serveOpts.Address)
+	}
+	server := &http.Server{
+		Addr:    serveOpts.Address,
+		Handler: handler,
+	}
+	serverErr := make(chan error, 1)
+	go func() {
+		logrus.WithField("address", serveOpts.Address).Info("Serving scan API")
+		serverErr <- server.ListenAndServe()
+	}()
+
+	var err error
+	select {
+	case err = <-serverErr:
+		err = errors.Wrapf(err, "Unable to serve on %s", serveOpts.Address)
+	case <-ctx.Done():
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+		err = server.Shutdown(shutdownCtx)
+	}
+
+	// Running jobs are cancelled along with the queue
+	cancel()
+	<-queueDone
+	return err
+}
+
+// isLoopbackAddress returns true when the API is only reachable from this host
+func isLoopbackAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// jobScanOptions returns options to scan what a job requested, falling back to command line flags
+func jobScanOptions(opts *pkg.ScanOptions, req serve.JobRequest) (scan.Options, error) {
+	scanOpts := newScanOptions(opts, resource.NewSchemaRepository())
+	if len(req.From) > 0 {
+		from, err := parseFromFlag(req.From)
+		if err != nil {
+			return scanOpts, err
+		}
+		scanOpts.From = from
+	}
+	if len(req.To) > 0 {
+		if err := validateToFlag(req.To); err != nil {
+			return scanOpts, err
+		}
+		scanOpts.To = req.To
+	}
+	if req.Filter != "" {
+		expression, _, err := parseFilter(req.Filter, opts.NamedFilters)
+		if err != nil {
+			return scanOpts, err
+		}
+		scanOpts.Filter = expression
+	}
+	return scanOpts, nil
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/pkg"
+	"github.com/cloudskiff/driftctl/pkg/filter"
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/pkg/serve"
+	"github.com/cloudskiff/driftctl/test"
+)
+
+func TestServeCmd_Invalid(t *testing.T) {
+	cases := []struct {
+		args     []string
+		expected string
+	}{
+		{args: []string{"serve", "--queue-size", "0"}, expected: "Queue size must be greater than zero"},
+		{args: []string{"serve", "--workers", "0"}, expected: "Workers must be greater than zero"},
+		{args: []string{"serve", "--retained-jobs", "0"}, expected: "Retained jobs must be greater than zero"},
+		{args: []string{"serve", "--to", "glou"}, expected: "unsupported cloud provider 'glou'\nValid values are: aws+tf,github+tf"},
+	}
+
+	for _, tt := range cases {
+		rootCmd := &cobra.Command{Use: "root"}
+		rootCmd.AddCommand(NewServeCmd())
+		_, err := test.Execute(rootCmd, tt.args...)
+		if err == nil {
+			t.Errorf("Invalid arg should generate error")
+			continue
+		}
+		if err.Error() != tt.expected {
+			t.Errorf("Expected '%v', got '%v'", tt.expected, err)
+		}
+	}
+}
+
+func Test_jobScanOptions(t *testing.T) {
+	opts := &pkg.ScanOptions{
+		From:             []config.SupplierConfig{{Key: "tfstate", Path: "terraform.tfstate"}},
+		To:               []string{"aws+tf"},
+		FilterExpression: "Type=='aws_s3_bucket'",
+		NamedFilters:     filter.NamedExpressions{"repositories": "Type=='github_repository'"},
+	}
+
+	got, err := jobScanOptions(opts, serve.JobRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, opts.From, got.From)
+	assert.Equal(t, opts.To, got.To)
+	assert.Equal(t, opts.FilterExpression, got.Filter)
+
+	got, err = jobScanOptions(opts, serve.JobRequest{
+		From:   []string{"tfstate+s3://bucket/terraform.tfstate"},
+		To:     []string{"github+tf"},
+		Filter: "Type=='github_repository'",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []config.SupplierConfig{{Key: "tfstate", Backend: "s3", Path: "bucket/terraform.tfstate"}}, got.From)
+	assert.Equal(t, []string{"github+tf"}, got.To)
+	assert.Equal(t, "Type=='github_repository'", got.Filter)
+
+	got, err = jobScanOptions(opts, serve.JobRequest{Filter: "${repositories} && Id!='driftctl'"})
+	assert.Nil(t, err)
+	assert.Equal(t, "(Type=='github_repository') && Id!='driftctl'", got.Filter)
+	_, err = jobScanOptions(opts, serve.JobRequest{Filter: "${unknown}"})
+	assert.EqualError(t, err, "unable to parse filter expression: unknown filter unknown")
+
+	_, err = jobScanOptions(opts, serve.JobRequest{To: []string{"glou"}})
+	assert.EqualError(t, err, "unsupported cloud provider 'glou'\nValid values are: aws+tf,github+tf")
+	_, err = jobScanOptions(opts, serve.JobRequest{Filter: "Type =="})
+	assert.Error(t, err)
+	_, err = jobScanOptions(opts, serve.JobRequest{From: []string{"terraform.tfstate"}})
+	assert.Error(t, err)
+}
+
+func Test_isLoopbackAddress(t *testing.T) {
+	assert.True(t, isLoopbackAddress("127.0.0.1:8080"))
+	assert.True(t, isLoopbackAddress("localhost:8080"))
+	assert.True(t, isLoopbackAddress("[::1]:8080"))
+	assert.False(t, isLoopbackAddress(":8080"))
+	assert.False(t, isLoopbackAddress("0.0.0.0:8080"))
+	assert.False(t, isLoopbackAddress("10.0.0.12:8080"))
+}
@@ -0,0 +1,24 @@
+import {
+  to = aws_instance.web_server
+  id = "i-0123456789abcdef0"
+}
+
+import {
+  to = aws_instance.web_server_2
+  id = "i-0fedcba9876543210"
+}
+
+import {
+  to = aws_s3_bucket.my-logs
+  id = "my-logs"
+}
+
+import {
+  to = aws_route53_record.example_com
+  id = "Z1D633PJN98FT9_example.com_A"
+}
+
+import {
+  to = github_team.my_team
+  id = "my team"
+}
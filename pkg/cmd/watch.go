@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/cloudskiff/driftctl/pkg"
+	"github.com/cloudskiff/driftctl/pkg/cmd/scan/output"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
+	globaloutput "github.com/cloudskiff/driftctl/pkg/output"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/scan"
+	"github.com/cloudskiff/driftctl/pkg/serve"
+	"github.com/cloudskiff/driftctl/pkg/watch"
+)
+
+type watchOptions struct {
+	Interval time.Duration
+	Address  string
+	Token    string
+}
+
+func NewWatchCmd() *cobra.Command {
+	opts := &pkg.ScanOptions{}
+	opts.BackendOptions = &backend.Options{}
+	watchOpts := &watchOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Scan on an interval and serve results over HTTP",
+		Long: "This command scans again on every interval, reusing cloud providers between scans.\n" +
+			"Results are served over HTTP:\n" +
+			"  - /metrics: Prometheus metrics of the last analysis, labelled by resource type\n" +
+			"  - /healthz: fails when the last scan failed\n" +
+			"  - /last-analysis: last analysis in the JSON output format\n\n" +
+			"Results are only served on localhost by default.\n" +
+			"Set a token with DCTL_API_TOKEN before listening on other interfaces, clients then send it as \"Authorization: Bearer <token>\".\n\n" +
+			"Example: DCTL_API_TOKEN=s3cr3t driftctl watch --interval 15m --address :8080",
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := parseScanFlags(cmd, opts); err != nil {
+				return err
+			}
+			if watchOpts.Interval <= 0 {
+				return errors.New("Interval must be greater than zero")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return watchRun(opts, watchOpts)
+		},
+	}
+
+	addScanFlags(cmd, opts)
+	fl := cmd.Flags()
+	fl.DurationVar(&watchOpts.Interval,
+		"interval",
+		15*time.Minute,
+		"Time between the start of two scans (e.g. 30m)\n",
+	)
+	fl.StringVar(&watchOpts.Address,
+		"address",
+		"127.0.0.1:8080",
+		"Address to serve metrics and results on\n",
+	)
+	fl.StringVar(&watchOpts.Token,
+		"api-token",
+		"",
+		"Token clients must send as a bearer token, prefer setting it with the DCTL_API_TOKEN environment variable\n",
+	)
+
+	return cmd
+}
+
+func watchRun(opts *pkg.ScanOptions, watchOpts *watchOptions) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(c)
+	go func() {
+		select {
+		case <-c:
+			logrus.Warn("Detected interrupt, cleanup ...")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	scanOpts := newScanOptions(opts, resource.NewSchemaRepository())
+	scanOpts.Outputs = scanOutputs(opts, output.GetOutput(opts.Output, opts.Quiet))
+	scanOpts.IaCProgress = globaloutput.NewProgress("Scanning states", "Scanned states", true)
+	scanOpts.ScanProgress = globaloutput.NewProgress("Scanning resources", "Scanned resources", false)
+
+	// Providers are started once and reused by every scan
+	session, err := scan.NewSession(ctx, scanOpts)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	watcher := watch.NewWatcher(session, watchOpts.Interval, opts.Timeout)
+
+	server := &http.Server{Addr: watchOpts.Address, Handler: watchHandler(watcher.Handler(), watchOpts)}
+	serverErr := make(chan error, 1)
+	go func() {
+		logrus.WithField("address", watchOpts.Address).Info("Serving scan results")
+		serverErr <- server.ListenAndServe()
+	}()
+
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- watcher.Run(ctx)
+	}()
+
+	select {
+	case err = <-serverErr:
+		cancel()
+		<-watchErr
+		return errors.Wrapf(err, "Unable to serve on %s", watchOpts.Address)
+	case <-ctx.Done():
+		<-watchErr
+	}
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+	return server.Shutdown(shutdownCtx)
+}
+
+// watchHandler requires the token of the command line when one is given
+func watchHandler(handler http.Handler, watchOpts *watchOptions) http.Handler {
+	if watchOpts.Token != "" {
+		return serve.RequireToken(watchOpts.Token, handler)
+	}
+	if !isLoopbackAddress(watchOpts.Address) {
+		logrus.Warnf("Serving on %s without token, anyone reaching this address can read the resources of the last analysis", watchOpts.Address)
+	}
+	return handler
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/test"
+)
+
+func TestWatchCmd_Invalid(t *testing.T) {
+	cases := []struct {
+		args     []string
+		expected string
+	}{
+		{args: []string{"watch", "--interval", "0s"}, expected: "Interval must be greater than zero"},
+		{args: []string{"watch", "--interval", "-1m"}, expected: "Interval must be greater than zero"},
+		{args: []string{"watch", "--max-retries", "-1"}, expected: "Max retries cannot be negative"},
+	}
+
+	for _, tt := range cases {
+		rootCmd := &cobra.Command{Use: "root"}
+		rootCmd.AddCommand(NewWatchCmd())
+		_, err := test.Execute(rootCmd, tt.args...)
+		if err == nil {
+			t.Errorf("Invalid arg should generate error")
+			continue
+		}
+		if err.Error() != tt.expected {
+			t.Errorf("Expected '%v', got '%v'", tt.expected, err)
+		}
+	}
+}
+
+func TestWatchCmd_ServesOnLocalhostByDefault(t *testing.T) {
+	cmd := NewWatchCmd()
+	assert.Equal(t, "127.0.0.1:8080", cmd.Flags().Lookup("address").DefValue)
+}
+
+func Test_watchHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	cases := []struct {
+		name          string
+		opts          *watchOptions
+		authorization string
+		expected      int
+	}{
+		{name: "without token", opts: &watchOptions{Address: "127.0.0.1:8080"}, expected: http.StatusOK},
+		{name: "missing token", opts: &watchOptions{Address: ":8080", Token: "s3cr3t"}, expected: http.StatusUnauthorized},
+		{name: "invalid token", opts: &watchOptions{Address: ":8080", Token: "s3cr3t"}, authorization: "Bearer glou", expected: http.StatusUnauthorized},
+		{name: "valid token", opts: &watchOptions{Address: ":8080", Token: "s3cr3t"}, authorization: "Bearer s3cr3t", expected: http.StatusOK},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/last-analysis", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			watchHandler(next, tt.opts).ServeHTTP(rec, req)
+			assert.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
@@ -1,7 +1,7 @@
 package pkg
 
 import (
-	"fmt"
+	"context"
 	"time"
 
 	globaloutput "github.com/cloudskiff/driftctl/pkg/output"
@@ -15,6 +15,7 @@ import (
 	"github.com/cloudskiff/driftctl/pkg/iac/config"
 	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
 	"github.com/cloudskiff/driftctl/pkg/middlewares"
+	"github.com/cloudskiff/driftctl/pkg/remote"
 	"github.com/cloudskiff/driftctl/pkg/resource"
 )
 
@@ -22,15 +23,32 @@ type ScanOptions struct {
 	Coverage         bool
 	Detect           bool
 	From             []config.SupplierConfig
-	To               string
+	To               []string
 	Output           output.OutputConfig
 	Filter           *jmespath.JMESPath
+	FilterExpression string
+	// NamedFilters are expressions of the filter file, filter expressions reference them as ${name}
+	NamedFilters     filter.NamedExpressions
 	Quiet            bool
 	BackendOptions   *backend.Options
 	StrictMode       bool
 	DisableTelemetry bool
 	ProviderVersion  string
 	ConfigDir        string
+	RemoteOptions    remote.Options
+	ContinueOnError  bool
+	Timeout          time.Duration
+	SupplierTimeout  time.Duration
+	// DriftIgnorePaths are ignore files to read, .driftignore is read when empty
+	DriftIgnorePaths []string
+	// DriftIgnore holds rules already read from DriftIgnorePaths, it is shared with the filter of scanned
+	// resource types so that rules skipping whole types are not reported as unused
+	DriftIgnore *filter.DriftIgnore
+	// Baseline is a previous analysis, only drift that is new since then makes the scan fail
+	Baseline *analyser.Analysis
+	// HCLPath is where to write HCL of unmanaged resources to, a file or a directory when HCLSplit is set
+	HCLPath  string
+	HCLSplit bool
 }
 
 type DriftCTL struct {
@@ -39,11 +57,13 @@ type DriftCTL struct {
 	alerter                  alerter.AlerterInterface
 	analyzer                 analyser.Analyzer
 	filter                   *jmespath.JMESPath
+	filterExpression         string
 	resourceFactory          resource.ResourceFactory
 	strictMode               bool
 	scanProgress             globaloutput.Progress
 	iacProgress              globaloutput.Progress
 	resourceSchemaRepository resource.SchemaRepositoryInterface
+	driftIgnore              *filter.DriftIgnore
 }
 
 func NewDriftCTL(remoteSupplier resource.Supplier,
@@ -54,23 +74,30 @@ func NewDriftCTL(remoteSupplier resource.Supplier,
 	scanProgress globaloutput.Progress,
 	iacProgress globaloutput.Progress,
 	resourceSchemaRepository resource.SchemaRepositoryInterface) *DriftCTL {
+	driftIgnore := opts.DriftIgnore
+	if driftIgnore == nil {
+		driftIgnore = filter.NewDriftIgnore(opts.DriftIgnorePaths...)
+	}
 	return &DriftCTL{
 		remoteSupplier,
 		iacSupplier,
 		alerter,
 		analyser.NewAnalyzer(alerter),
 		opts.Filter,
+		opts.FilterExpression,
 		resFactory,
 		opts.StrictMode,
 		scanProgress,
 		iacProgress,
 		resourceSchemaRepository,
+		driftIgnore,
 	}
 }
 
-func (d DriftCTL) Run() (*analyser.Analysis, error) {
+// Run scans IaC sources and remotes, cancelling ctx interrupts the scan
+func (d DriftCTL) Run(ctx context.Context) (*analyser.Analysis, error) {
 	start := time.Now()
-	remoteResources, resourcesFromState, err := d.scan()
+	remoteResources, resourcesFromState, err := d.scan(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -113,20 +140,29 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 
 	if d.filter != nil {
 		engine := filter.NewFilterEngine(d.filter)
-		remoteResources, err = engine.Run(remoteResources)
+		filteredRemoteResources, err := engine.Run(remoteResources)
 		if err != nil {
 			return nil, err
 		}
-		resourcesFromState, err = engine.Run(resourcesFromState)
+		filteredResourcesFromState, err := engine.Run(resourcesFromState)
 		if err != nil {
 			return nil, err
 		}
+		// Rules may match resources dropped by the filter, or not even enumerated as their type is filtered out
+		d.markFilteredTypes(remoteResources, filteredRemoteResources)
+		d.markFilteredTypes(resourcesFromState, filteredResourcesFromState)
+		if d.filterExpression != "" {
+			typeFilter := filter.NewExpressionTypeFilter(d.filterExpression)
+			for _, ty := range resource.GetSupportedTypes() {
+				if typeFilter.IsTypeIgnored(ty) {
+					d.driftIgnore.MarkTypeUnchecked(ty)
+				}
+			}
+		}
+		remoteResources, resourcesFromState = filteredRemoteResources, filteredResourcesFromState
 	}
 
-	logrus.Debug("Checking for driftignore")
-	driftIgnore := filter.NewDriftIgnore()
-
-	analysis, err := d.analyzer.Analyze(remoteResources, resourcesFromState, driftIgnore)
+	analysis, err := d.analyzer.Analyze(remoteResources, resourcesFromState, d.driftIgnore)
 	analysis.Duration = time.Since(start)
 
 	if err != nil {
@@ -136,25 +172,26 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 	return &analysis, nil
 }
 
-func (d DriftCTL) Stop() {
-	stoppableSupplier, ok := d.remoteSupplier.(resource.StoppableSupplier)
-	if ok {
-		logrus.WithFields(logrus.Fields{
-			"supplier": fmt.Sprintf("%T", d.remoteSupplier),
-		}).Debug("Stopping remote supplier")
-		stoppableSupplier.Stop()
+// markFilteredTypes marks types of resources dropped by the filter as unchecked by ignore rules
+func (d DriftCTL) markFilteredTypes(resources, filtered []resource.Resource) {
+	dropped := make(map[string]int)
+	for _, res := range resources {
+		dropped[res.TerraformType()]++
 	}
-
-	stoppableSupplier, ok = d.iacSupplier.(resource.StoppableSupplier)
-	if ok {
-		stoppableSupplier.Stop()
+	for _, res := range filtered {
+		dropped[res.TerraformType()]--
+	}
+	for ty, count := range dropped {
+		if count > 0 {
+			d.driftIgnore.MarkTypeUnchecked(resource.ResourceType(ty))
+		}
 	}
 }
 
-func (d DriftCTL) scan() (remoteResources []resource.Resource, resourcesFromState []resource.Resource, err error) {
+func (d DriftCTL) scan(ctx context.Context) (remoteResources []resource.Resource, resourcesFromState []resource.Resource, err error) {
 	logrus.Info("Start reading IaC")
 	d.iacProgress.Start()
-	resourcesFromState, err = d.iacSupplier.Resources()
+	resourcesFromState, err = d.iacSupplier.Resources(ctx)
 	d.iacProgress.Stop()
 	if err != nil {
 		return nil, nil, err
@@ -163,7 +200,7 @@ func (d DriftCTL) scan() (remoteResources []resource.Resource, resourcesFromStat
 	logrus.Info("Start scanning cloud provider")
 	d.scanProgress.Start()
 	defer d.scanProgress.Stop()
-	remoteResources, err = d.remoteSupplier.Resources()
+	remoteResources, err = d.remoteSupplier.Resources(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
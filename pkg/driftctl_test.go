@@ -1,7 +1,11 @@
 package pkg_test
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/cloudskiff/driftctl/pkg"
@@ -63,7 +67,7 @@ func runTest(t *testing.T, cases TestCases) {
 			}
 
 			stateSupplier := &resource.MockSupplier{}
-			stateSupplier.On("Resources").Return(c.stateResources, nil)
+			stateSupplier.On("Resources", mock.Anything).Return(c.stateResources, nil)
 
 			if c.remoteResources == nil {
 				c.remoteResources = []resource.Resource{}
@@ -77,7 +81,7 @@ func runTest(t *testing.T, cases TestCases) {
 				}
 			}
 			remoteSupplier := &resource.MockSupplier{}
-			remoteSupplier.On("Resources").Return(c.remoteResources, nil)
+			remoteSupplier.On("Resources", mock.Anything).Return(c.remoteResources, nil)
 
 			resourceFactory := &terraform.MockResourceFactory{}
 
@@ -99,7 +103,7 @@ func runTest(t *testing.T, cases TestCases) {
 
 			driftctl := pkg.NewDriftCTL(remoteSupplier, stateSupplier, testAlerter, resourceFactory, c.options, scanProgress, iacProgress, repo)
 
-			analysis, err := driftctl.Run()
+			analysis, err := driftctl.Run(context.Background())
 
 			c.assert(test.NewScanResult(t, analysis), err)
 			scanProgress.AssertExpectations(t)
@@ -684,6 +688,65 @@ func TestDriftctlRun_BasicFilter(t *testing.T) {
 	runTest(t, cases)
 }
 
+func TestDriftctlRun_FilteredIgnoreRules(t *testing.T) {
+	optionsOf := func(t *testing.T, filterStr string, rules ...string) *pkg.ScanOptions {
+		f, err := filter.BuildExpression(filterStr)
+		if err != nil {
+			t.Fatalf("Unable to build filter expression: %s\n%s", filterStr, err)
+		}
+		driftignorePath := filepath.Join(t.TempDir(), ".driftignore")
+		if err := os.WriteFile(driftignorePath, []byte(strings.Join(rules, "\n")), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return &pkg.ScanOptions{Filter: f, FilterExpression: filterStr, DriftIgnorePaths: []string{driftignorePath}}
+	}
+
+	cases := TestCases{
+		{
+			name: "rules of types filtered out are not reported as unused",
+			remoteResources: []resource.Resource{
+				&testresource.FakeResource{
+					Id:    "assets",
+					Type:  "aws_s3_bucket",
+					Attrs: &resource.Attributes{},
+				},
+			},
+			assert: func(result *test.ScanResult, err error) {
+				result.Nil(err)
+				result.AssertUnmanagedCount(1)
+				result.Len(result.UnusedIgnoreRules(), 1)
+				result.Equal("aws_s3_bucket.old-logs", result.UnusedIgnoreRules()[0].Rule)
+			},
+			options: optionsOf(t, "Type=='aws_s3_bucket'", "aws_iam_user.legacy", "aws_s3_bucket.old-logs"),
+		},
+		{
+			name: "rules of resources dropped by the filter are not reported as unused",
+			remoteResources: []resource.Resource{
+				&testresource.FakeResource{
+					Id:    "prod-logs",
+					Type:  "aws_s3_bucket",
+					Attrs: &resource.Attributes{"env": "prod"},
+				},
+				&testresource.FakeResource{
+					Id:    "dev-logs",
+					Type:  "aws_s3_bucket",
+					Attrs: &resource.Attributes{"env": "dev"},
+				},
+			},
+			assert: func(result *test.ScanResult, err error) {
+				result.Nil(err)
+				result.AssertUnmanagedCount(1)
+				result.AssertResourceUnmanaged("prod-logs", "aws_s3_bucket")
+				result.Len(result.UnusedIgnoreRules(), 1)
+				result.Equal("aws_instance.web", result.UnusedIgnoreRules()[0].Rule)
+			},
+			options: optionsOf(t, "Attr.env=='prod'", "aws_s3_bucket.dev-logs", "aws_instance.web"),
+		},
+	}
+
+	runTest(t, cases)
+}
+
 func TestDriftctlRun_Middlewares(t *testing.T) {
 	cases := TestCases{
 		{
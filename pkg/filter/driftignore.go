@@ -4,34 +4,88 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
+	"github.com/cloudskiff/driftctl/pkg/analyser"
 	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/jmespath/go-jmespath"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
+// includeDirective reads rules of another ignore file at this place, relative paths are resolved from the including file
+const includeDirective = "@include "
+
+// expiryLayout is the layout of expiry dates of rules, e.g. "aws_s3_bucket.tmp-* # expires=2021-12-31 owner=team-data"
+const expiryLayout = "2006-01-02"
+
+// attributeRulePrefix starts rules holding a JMESPath predicate on the same fields as the filter flag,
+// e.g. "?Type=='aws_lambda_function' && starts_with(Attr.function_name, 'sandbox-')"
+const attributeRulePrefix = "?"
+
+// metadataField is a key=value field of a trailing comment, e.g. "owner=team-data"
+var metadataField = regexp.MustCompile(`[#\s][\w-]+=\S`)
+
+// ignoreRule is a line of an ignore file, rules are evaluated in order and the last matching one wins
+type ignoreRule struct {
+	resType    string                // type part of the rule, may contain wildcards
+	resource   string                // type.id, may contain wildcards
+	path       []string              // path of the field to ignore, empty for resource rules
+	expr       *jmespath.JMESPath    // predicate of attribute rules, resType and resource are then empty
+	typeFilter *ExpressionTypeFilter // types the predicate of attribute rules may match
+	negate     bool                  // set by a leading "!", the rule re-includes what an earlier rule ignored
+	file       string                // ignore file the rule was read from
+	line       int                   // line of the rule in its ignore file
+	text       string                // rule as written, without its trailing comment
+	owner      string                // owner= value of the trailing comment
+	expires    string                // expires= value of the trailing comment, the rule is not applied from this date
+	used       bool                  // set once the rule matched a resource, a field or a whole resource type
+	unchecked  bool                  // set when resources the rule may match were not all checked, it cannot be told unused
+}
+
 type DriftIgnore struct {
-	resExclusionList         map[string]struct{} // map[type.id] exists to ignore
-	resExclusionWildcardList map[string]struct{} // map[type.id] exists with wildcard to ignore
-	driftExclusionList       map[string][]string // map[type.id] contains path for drift to ignore
+	resourceRules []ignoreRule // type.id rules, in file order
+	driftRules    []ignoreRule // type.id.path.to.field rules, in file order
+	expiredRules  []ignoreRule // rules not applied as they expired
 }
 
-func NewDriftIgnore() *DriftIgnore {
-	d := DriftIgnore{
-		resExclusionList:         map[string]struct{}{},
-		resExclusionWildcardList: map[string]struct{}{},
-		driftExclusionList:       map[string][]string{},
+// NewDriftIgnore reads rules of the given ignore files, .driftignore is read when none is given.
+// Rules of every file are merged in the given order.
+func NewDriftIgnore(paths ...string) *DriftIgnore {
+	d := DriftIgnore{}
+	if len(paths) == 0 {
+		paths = []string{".driftignore"}
 	}
-	err := d.readIgnoreFile()
-	if err != nil {
-		logrus.Debug(err)
+	for _, path := range paths {
+		err := d.readIgnoreFile(path, nil)
+		if err != nil {
+			logrus.Debug(err)
+		}
 	}
 	return &d
 }
 
-func (r *DriftIgnore) readIgnoreFile() error {
-	file, err := os.Open(".driftignore")
+// readIgnoreFile reads rules of an ignore file, including is the list of files being read
+// to detect files including each other
+func (r *DriftIgnore) readIgnoreFile(path string, including []string) error {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	for _, included := range including {
+		if included == absPath {
+			return errors.Errorf("%s is already being read, it cannot be included again", path)
+		}
+	}
+	including = append(including, absPath)
+
+	file, err := os.Open(path)
 	if err != nil {
 		return err
 	}
@@ -46,43 +100,101 @@ func (r *DriftIgnore) readIgnoreFile() error {
 			}).Debug("Skipped comment or empty line")
 			continue
 		}
-		typeVal := readDriftIgnoreLine(line)
-		nbArgs := len(typeVal)
-		if nbArgs < 2 {
+		if strings.HasPrefix(line, includeDirective) {
+			includedPath := strings.TrimSpace(strings.TrimPrefix(line, includeDirective))
+			if !filepath.IsAbs(includedPath) {
+				includedPath = filepath.Join(filepath.Dir(path), includedPath)
+			}
 			logrus.WithFields(logrus.Fields{
-				"line":    strconv.Itoa(lineNumber),
-				"content": line,
-			}).Warnf("unable to parse line, invalid length, got %d expected >= 2", nbArgs)
+				"file":     path,
+				"line":     lineNumber,
+				"included": includedPath,
+			}).Debug("Including ignore file")
+			if err := r.readIgnoreFile(includedPath, including); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"file": path,
+					"line": strconv.Itoa(lineNumber),
+				}).Warnf("unable to include ignore file: %s", err)
+			}
 			continue
 		}
-		res := strings.Join(typeVal[0:2], ".")
-		if nbArgs == 2 { // We want to ignore a resource (type.id)
-			logrus.WithFields(logrus.Fields{
-				"type": typeVal[0],
-				"id":   typeVal[1],
-			}).Debug("Found ignore resource rule in .driftignore")
-			resExclusionTypeList := r.resExclusionList
-			if strings.Contains(res, "*") {
-				resExclusionTypeList = r.resExclusionWildcardList
+		line, comment := splitTrailingComment(line)
+		// Like in .gitignore, a leading "!" negates the rule and "\!" matches a literal "!"
+		negate := strings.HasPrefix(line, "!")
+		rule := ignoreRule{
+			negate: negate,
+			file:   path,
+			line:   lineNumber,
+			text:   line,
+		}
+		var typeVal []string
+		if content := strings.TrimPrefix(line, "!"); strings.HasPrefix(content, attributeRulePrefix) {
+			expression := strings.TrimPrefix(content, attributeRulePrefix)
+			expr, err := BuildExpression(expression)
+			if err != nil {
+				logrus.WithFields(logrus.Fields{
+					"file":    path,
+					"line":    strconv.Itoa(lineNumber),
+					"content": line,
+				}).Warnf("unable to parse attribute rule: %s", err)
+				continue
 			}
-			resExclusionTypeList[res] = struct{}{}
+			rule.expr = expr
+			rule.typeFilter = NewExpressionTypeFilter(expression)
+		} else {
+			typeVal = readDriftIgnoreLine(content)
+			nbArgs := len(typeVal)
+			if nbArgs < 2 {
+				logrus.WithFields(logrus.Fields{
+					"file":    path,
+					"line":    strconv.Itoa(lineNumber),
+					"content": line,
+				}).Warnf("unable to parse line, invalid length, got %d expected >= 2", nbArgs)
+				continue
+			}
+			rule.resType = typeVal[0]
+			rule.resource = strings.Join(typeVal[0:2], ".")
+		}
+		if expired := rule.readMetadata(comment); expired {
+			logrus.WithFields(logrus.Fields{
+				"file":    path,
+				"line":    lineNumber,
+				"expires": rule.expires,
+			}).Debug("Skipped expired ignore rule")
+			r.expiredRules = append(r.expiredRules, rule)
 			continue
 		}
-		// Here we want to ignore a drift (type.id.path.to.field)
-		ignoreSublist, exists := r.driftExclusionList[res]
-		if !exists {
-			ignoreSublist = make([]string, 0, 1)
+		if rule.expr != nil { // We want to ignore resources matching a predicate on their attributes
+			logrus.WithFields(logrus.Fields{
+				"expression": strings.TrimPrefix(strings.TrimPrefix(line, "!"), attributeRulePrefix),
+				"negate":     negate,
+				"file":       path,
+			}).Debug("Found ignore attribute rule")
+			r.resourceRules = append(r.resourceRules, rule)
+			continue
+		}
+		if len(typeVal) == 2 { // We want to ignore a resource (type.id)
+			logrus.WithFields(logrus.Fields{
+				"type":   typeVal[0],
+				"id":     typeVal[1],
+				"negate": negate,
+				"file":   path,
+			}).Debug("Found ignore resource rule")
+			r.resourceRules = append(r.resourceRules, rule)
+			continue
 		}
-		path := strings.Join(typeVal[2:], ".")
+		// Here we want to ignore a drift (type.id.path.to.field)
+		rule.path = typeVal[2:]
 
 		logrus.WithFields(logrus.Fields{
-			"type": typeVal[0],
-			"id":   typeVal[1],
-			"path": path,
-		}).Debug("Found ignore resource field rule in .driftignore")
+			"type":   typeVal[0],
+			"id":     typeVal[1],
+			"path":   strings.Join(rule.path, "."),
+			"negate": negate,
+			"file":   path,
+		}).Debug("Found ignore resource field rule")
 
-		ignoreSublist = append(ignoreSublist, path)
-		r.driftExclusionList[res] = ignoreSublist
+		r.driftRules = append(r.driftRules, rule)
 	}
 
 	if err := scanner.Err(); err != nil {
@@ -95,52 +207,275 @@ func (r *DriftIgnore) readIgnoreFile() error {
 func (r *DriftIgnore) IsResourceIgnored(res resource.Resource) bool {
 	strRes := fmt.Sprintf("%s.%s", res.TerraformType(), res.TerraformId())
 
-	if _, isExclusionRule := r.resExclusionList[strRes]; isExclusionRule {
-		return true
+	var matched *ignoreRule
+	for i, rule := range r.resourceRules {
+		if rule.matchesResource(strRes, res) {
+			matched = &r.resourceRules[i]
+			matched.used = true
+		}
 	}
-	for resExclusion := range r.resExclusionWildcardList {
-		if wildcardMatchChecker(strRes, resExclusion) {
-			return true
+	if matched == nil || matched.negate {
+		return false
+	}
+	logrus.WithFields(logrus.Fields{
+		"resource": strRes,
+		"file":     matched.file,
+		"line":     matched.line,
+	}).Debug("Resource ignored by rule")
+	return true
+}
+
+// matchesResource tells whether the resource rule applies to the given resource, strRes being its type.id
+func (rule *ignoreRule) matchesResource(strRes string, res resource.Resource) bool {
+	if rule.expr == nil {
+		return wildcardMatchChecker(strRes, rule.resource)
+	}
+	result, err := rule.expr.Search([]filtrableResource{newFiltrableResource(res)})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"resource": strRes,
+			"file":     rule.file,
+			"line":     rule.line,
+		}).Debugf("Unable to evaluate attribute rule: %s", err)
+		return false
+	}
+	matched, ok := result.([]interface{})
+	return ok && len(matched) > 0
+}
+
+// IsTypeIgnored returns true when a wildcard rule ignores every resource of the given type
+// and no later negated rule may re-include some of them
+func (r *DriftIgnore) IsTypeIgnored(ty resource.ResourceType) bool {
+	var ignoredBy *ignoreRule
+	for i, rule := range r.resourceRules {
+		if rule.negate {
+			if rule.expr != nil && !rule.typeFilter.IsTypeIgnored(ty) {
+				ignoredBy = nil
+			}
+			if rule.expr == nil && wildcardMatchChecker(string(ty), rule.resType) {
+				ignoredBy = nil
+			}
+			continue
+		}
+		if rule.expr != nil {
+			continue // A predicate on attributes cannot tell that every resource of a type is ignored
+		}
+		// A trailing wildcard that matches "type." will match any id of this type
+		if strings.HasSuffix(rule.resource, "*") && wildcardMatchChecker(fmt.Sprintf("%s.", ty), rule.resource) {
+			ignoredBy = &r.resourceRules[i]
 		}
 	}
-	return false
+	if ignoredBy == nil {
+		return false
+	}
+	// Resources of this type are not even listed, so the rule is used as soon as it skips the type
+	ignoredBy.used = true
+	return true
 }
 
 func (r *DriftIgnore) IsFieldIgnored(res resource.Resource, path []string) bool {
-	exclusionRules, isExclusionRule := r.driftExclusionList[fmt.Sprintf("%s.%s", res.TerraformType(), res.TerraformId())]
-	exclusionWildcardRules, isExclusionWildcardRule := r.driftExclusionList[fmt.Sprintf("%s.*", res.TerraformType())]
+	strRes := fmt.Sprintf("%s.%s", res.TerraformType(), res.TerraformId())
 
-	if !isExclusionRule && !isExclusionWildcardRule {
+	var matched *ignoreRule
+	for i, rule := range r.driftRules {
+		if wildcardMatchChecker(strRes, rule.resource) && isPathMatching(rule.path, path) {
+			matched = &r.driftRules[i]
+			matched.used = true
+		}
+	}
+	if matched == nil || matched.negate {
 		return false
 	}
+	logrus.WithFields(logrus.Fields{
+		"resource": strRes,
+		"path":     strings.Join(path, "."),
+		"file":     matched.file,
+		"line":     matched.line,
+	}).Debug("Resource field ignored by rule")
+	return true
+}
 
-	if !isExclusionRule {
-		exclusionRules = exclusionWildcardRules
+// Decides returns true when rules already decide on what the given rule would ignore: they either ignore it already,
+// or re-include some of it with a negated rule that the given rule would override if added after them.
+// Wildcards of the given rule are compared literally, rules are not marked as used.
+func (r *DriftIgnore) Decides(line string) bool {
+	typeVal := readDriftIgnoreLine(line)
+	if len(typeVal) < 2 {
+		return false
 	}
+	strRes := strings.Join(typeVal[0:2], ".")
+	res := &resource.AbstractResource{Type: typeVal[0], Id: typeVal[1]}
+	path := typeVal[2:]
 
-	if r.isExcluded(exclusionRules, path) {
-		return true
+	if len(path) == 0 {
+		for _, rule := range r.resourceRules {
+			if rule.matchesResource(strRes, res) || rule.reIncludes(strRes, path) {
+				return true
+			}
+		}
+		return false
 	}
 
+	// A field of an ignored resource does not need to be ignored
+	ignored := false
+	for _, rule := range r.resourceRules {
+		if rule.matchesResource(strRes, res) {
+			ignored = !rule.negate
+		}
+	}
+	if ignored {
+		return true
+	}
+	for _, rule := range r.driftRules {
+		if (wildcardMatchChecker(strRes, rule.resource) && isPathMatching(rule.path, path)) || rule.reIncludes(strRes, path) {
+			return true
+		}
+	}
 	return false
 }
 
-func (r *DriftIgnore) isExcluded(rules []string, changePath []string) bool {
-RuleCheck:
+// reIncludes returns true when the rule is a negated one re-including part of what the given
+// type.id and path pattern matches
+func (rule *ignoreRule) reIncludes(strRes string, path []string) bool {
+	if !rule.negate || rule.expr != nil || !wildcardMatchChecker(rule.resource, strRes) {
+		return false
+	}
+	return isPathMatching(path, rule.path) || isPathMatching(rule.path, path)
+}
+
+// MarkTypeUnchecked tells that resources of the given type were not all checked against rules,
+// e.g. as they were filtered out or could not be enumerated
+func (r *DriftIgnore) MarkTypeUnchecked(ty resource.ResourceType) {
+	for _, rules := range [][]ignoreRule{r.resourceRules, r.driftRules} {
+		for i := range rules {
+			if rules[i].mayMatchType(ty) {
+				rules[i].unchecked = true
+			}
+		}
+	}
+}
+
+// mayMatchType returns true when the rule may apply to resources of the given type
+func (rule *ignoreRule) mayMatchType(ty resource.ResourceType) bool {
+	if rule.expr != nil {
+		return !rule.typeFilter.IsTypeIgnored(ty)
+	}
+	return wildcardMatchChecker(ty.String(), rule.resType)
+}
+
+// UnusedRules returns rules that matched no resource nor field so far.
+// Rules that may match resources of an unchecked type are left out.
+func (r *DriftIgnore) UnusedRules() []analyser.IgnoreRule {
+	var unused []ignoreRule
+	for _, rules := range [][]ignoreRule{r.resourceRules, r.driftRules} {
+		for _, rule := range rules {
+			if !rule.used && !rule.unchecked {
+				unused = append(unused, rule)
+			}
+		}
+	}
+	return reportRules(unused)
+}
+
+// ExpiredRules returns rules that are not applied as their expiry date passed
+func (r *DriftIgnore) ExpiredRules() []analyser.IgnoreRule {
+	return reportRules(r.expiredRules)
+}
+
+func reportRules(rules []ignoreRule) []analyser.IgnoreRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	report := make([]analyser.IgnoreRule, 0, len(rules))
 	for _, rule := range rules {
-		path := readDriftIgnoreLine(rule)
-		if len(path) > len(changePath) {
-			continue // path size does not match
+		report = append(report, analyser.IgnoreRule{
+			Rule:    rule.text,
+			File:    rule.file,
+			Line:    rule.line,
+			Owner:   rule.owner,
+			Expires: rule.expires,
+		})
+	}
+	sort.SliceStable(report, func(i, j int) bool {
+		if report[i].File != report[j].File {
+			return report[i].File < report[j].File
 		}
+		return report[i].Line < report[j].Line
+	})
+	return report
+}
 
-		for i := range path {
-			if !strings.EqualFold(path[i], changePath[i]) && path[i] != "*" {
-				continue RuleCheck // found a diff in path that was not a wildcard
+// splitTrailingComment splits a rule from its trailing comment, e.g. "aws_s3_bucket.logs # owner=team-data".
+// Only comments holding key=value fields are split so that ids containing " #" are kept,
+// and quoted strings of attribute rules are skipped.
+func splitTrailingComment(line string) (string, string) {
+	attributeRule := strings.HasPrefix(strings.TrimPrefix(line, "!"), attributeRulePrefix)
+	var quote rune
+	for i, c := range line {
+		if attributeRule {
+			if quote != 0 {
+				if c == quote && line[i-1] != '\\' {
+					quote = 0
+				}
+				continue
+			}
+			if c == '\'' || c == '"' || c == '`' {
+				quote = c
+				continue
 			}
 		}
-		return true
+		if c != '#' || i == 0 || !unicode.IsSpace(rune(line[i-1])) {
+			continue
+		}
+		if comment := line[i:]; metadataField.MatchString(comment) {
+			return strings.TrimRightFunc(line[:i], unicode.IsSpace), comment
+		}
 	}
-	return false
+	return line, ""
+}
+
+// readMetadata reads owner= and expires= values of the trailing comment of the rule
+// and returns true when the rule expired
+func (rule *ignoreRule) readMetadata(comment string) bool {
+	for _, field := range strings.Fields(strings.TrimPrefix(strings.TrimSpace(comment), "#")) {
+		parts := strings.SplitN(field, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		switch parts[0] {
+		case "owner":
+			rule.owner = parts[1]
+		case "expires":
+			rule.expires = parts[1]
+		}
+	}
+	if rule.expires == "" {
+		return false
+	}
+	expires, err := time.ParseInLocation(expiryLayout, rule.expires, time.Local)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"file":    rule.file,
+			"line":    strconv.Itoa(rule.line),
+			"expires": rule.expires,
+		}).Warnf("unable to parse expiry date of rule, expected a date like %s", expiryLayout)
+		rule.expires = ""
+		return false
+	}
+	return !time.Now().Before(expires)
+}
+
+// isPathMatching returns true when the rule path is the change path or one of its parents
+func isPathMatching(rulePath []string, changePath []string) bool {
+	if len(rulePath) > len(changePath) {
+		return false // path size does not match
+	}
+	for i := range rulePath {
+		if !strings.EqualFold(rulePath[i], changePath[i]) && rulePath[i] != "*" {
+			return false // found a diff in path that was not a wildcard
+		}
+	}
+	return true
 }
 
 //Check two strings recursively, pattern can contain wildcard
@@ -11,6 +11,7 @@ import (
 
 	resource2 "github.com/cloudskiff/driftctl/test/resource"
 
+	"github.com/cloudskiff/driftctl/pkg/analyser"
 	"github.com/cloudskiff/driftctl/pkg/resource"
 )
 
@@ -151,6 +152,27 @@ func TestDriftIgnore_IsResourceIgnored(t *testing.T) {
 				true,
 			},
 		},
+		{
+			name: "drift_ignore_negation",
+			resources: []resource.Resource{
+				&resource2.FakeResource{Type: "aws_s3_bucket", Id: "dev-assets"},
+				&resource2.FakeResource{Type: "aws_s3_bucket", Id: "prod-assets"},
+				&resource2.FakeResource{Type: "aws_s3_bucket", Id: "prod-legacy"},
+				&resource2.FakeResource{Type: "aws_iam_user", Id: "admin"},
+				&resource2.FakeResource{Type: "aws_iam_role", Id: "admin"},
+				&resource2.FakeResource{Type: "aws_iam_role", Id: "readonly"},
+				&resource2.FakeResource{Type: "!type", Id: "id"},
+			},
+			want: []bool{
+				true,
+				false,
+				true,
+				true,
+				false,
+				true,
+				true,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -282,6 +304,31 @@ func TestDriftIgnore_IsFieldIgnored(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "drift_ignore_negation",
+			args: []Args{
+				{
+					Res:  &resource2.FakeResource{Type: "aws_instance", Id: "db"},
+					Path: []string{"tags", "Name"},
+					Want: true,
+				},
+				{
+					Res:  &resource2.FakeResource{Type: "aws_instance", Id: "db"},
+					Path: []string{"tags", "Env"},
+					Want: false,
+				},
+				{
+					Res:  &resource2.FakeResource{Type: "aws_instance", Id: "web"},
+					Path: []string{"tags", "Env"},
+					Want: true,
+				},
+				{
+					Res:  &resource2.FakeResource{Type: "aws_instance", Id: "web"},
+					Path: []string{"ami"},
+					Want: false,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -301,6 +348,202 @@ func TestDriftIgnore_IsFieldIgnored(t *testing.T) {
 	}
 }
 
+func TestDriftIgnore_MergedFiles(t *testing.T) {
+	cwd, _ := os.Getwd()
+	defer func() { _ = os.Chdir(cwd) }()
+	if err := os.Chdir(path.Join("testdata", "drift_ignore_include")); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewDriftIgnore(".driftignore", "team.driftignore")
+	assert.True(t, r.IsResourceIgnored(&resource2.FakeResource{Type: "aws_s3_bucket", Id: "assets"}))
+	assert.False(t, r.IsResourceIgnored(&resource2.FakeResource{Type: "aws_s3_bucket", Id: "team-assets"}))
+	assert.True(t, r.IsResourceIgnored(&resource2.FakeResource{Type: "aws_iam_user", Id: "deploy"}))
+	assert.True(t, r.IsFieldIgnored(&resource2.FakeResource{Type: "aws_instance", Id: "db"}, []string{"tags", "Env"}))
+	assert.False(t, r.IsFieldIgnored(&resource2.FakeResource{Type: "aws_instance", Id: "web"}, []string{"tags", "Env"}))
+
+	// Rules read from files given first are overridden by later files
+	r = NewDriftIgnore("team.driftignore", ".driftignore")
+	assert.True(t, r.IsFieldIgnored(&resource2.FakeResource{Type: "aws_instance", Id: "web"}, []string{"tags", "Env"}))
+}
+
+func TestDriftIgnore_RulesMetadata(t *testing.T) {
+	cwd, _ := os.Getwd()
+	defer func() { _ = os.Chdir(cwd) }()
+	if err := os.Chdir(path.Join("testdata", "drift_ignore_metadata")); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewDriftIgnore()
+	assert.True(t, r.IsResourceIgnored(&resource2.FakeResource{Type: "aws_s3_bucket", Id: "assets"}))
+	// Expired rules are not applied anymore
+	assert.False(t, r.IsResourceIgnored(&resource2.FakeResource{Type: "aws_instance", Id: "tmp-1"}))
+	assert.True(t, r.IsTypeIgnored("aws_iam_role"))
+
+	assert.Equal(t, []analyser.IgnoreRule{
+		{Rule: "aws_s3_bucket.old-logs", File: ".driftignore", Line: 2, Owner: "team-data"},
+		{Rule: "aws_instance.*.tags", File: ".driftignore", Line: 4, Owner: "team-compute"},
+		{Rule: "aws_iam_user.legacy", File: ".driftignore", Line: 5},
+	}, r.UnusedRules())
+	assert.Equal(t, []analyser.IgnoreRule{
+		{Rule: "aws_instance.tmp-*", File: ".driftignore", Line: 3, Owner: "team-compute", Expires: "2021-06-30"},
+	}, r.ExpiredRules())
+
+	assert.True(t, r.IsFieldIgnored(&resource2.FakeResource{Type: "aws_instance", Id: "web"}, []string{"tags", "Env"}))
+	assert.Equal(t, []analyser.IgnoreRule{
+		{Rule: "aws_s3_bucket.old-logs", File: ".driftignore", Line: 2, Owner: "team-data"},
+		{Rule: "aws_iam_user.legacy", File: ".driftignore", Line: 5},
+	}, r.UnusedRules())
+}
+
+func TestDriftIgnore_MarkTypeUnchecked(t *testing.T) {
+	driftignorePath := path.Join(t.TempDir(), ".driftignore")
+	content := strings.Join([]string{
+		"aws_s3_bucket.old-logs",
+		"aws_instance.*.tags",
+		"aws_iam_*.legacy",
+		"?Type=='aws_lambda_function' && Attr.runtime=='go1.x'",
+		"aws_sqs_queue.unused",
+	}, "\n")
+	if err := os.WriteFile(driftignorePath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewDriftIgnore(driftignorePath)
+	assert.Len(t, r.UnusedRules(), 5)
+
+	// Rules that may match resources which were not checked cannot be told unused
+	r.MarkTypeUnchecked("aws_s3_bucket")
+	r.MarkTypeUnchecked("aws_iam_user")
+	r.MarkTypeUnchecked("aws_lambda_function")
+	assert.Equal(t, []analyser.IgnoreRule{
+		{Rule: "aws_instance.*.tags", File: driftignorePath, Line: 2},
+		{Rule: "aws_sqs_queue.unused", File: driftignorePath, Line: 5},
+	}, r.UnusedRules())
+}
+
+func TestDriftIgnore_AttributeRules(t *testing.T) {
+	cwd, _ := os.Getwd()
+	defer func() { _ = os.Chdir(cwd) }()
+	if err := os.Chdir(path.Join("testdata", "drift_ignore_attributes")); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewDriftIgnore()
+	tests := []struct {
+		res  resource.Resource
+		want bool
+	}{
+		{
+			res: &resource.AbstractResource{Type: "aws_instance", Id: "i-node", Attrs: &resource.Attributes{
+				"tags": map[string]interface{}{"kubernetes.io/cluster/prod": "owned"},
+			}},
+			want: true,
+		},
+		{
+			res: &resource.AbstractResource{Type: "aws_instance", Id: "i-web", Attrs: &resource.Attributes{
+				"tags": map[string]interface{}{"Name": "web"},
+			}},
+			want: false,
+		},
+		{
+			res:  &resource.AbstractResource{Type: "aws_instance", Id: "i-untagged", Attrs: &resource.Attributes{}},
+			want: false,
+		},
+		{
+			res:  &resource.AbstractResource{Type: "aws_instance", Id: "i-no-attributes"},
+			want: false,
+		},
+		{
+			res:  &resource.AbstractResource{Type: "aws_lambda_function", Id: "sandbox-test", Attrs: &resource.Attributes{"function_name": "sandbox-test"}},
+			want: true,
+		},
+		{
+			res:  &resource.AbstractResource{Type: "aws_lambda_function", Id: "sandbox-keep", Attrs: &resource.Attributes{"function_name": "sandbox-keep"}},
+			want: false,
+		},
+		{
+			res:  &resource.AbstractResource{Type: "aws_lambda_function", Id: "api", Attrs: &resource.Attributes{"function_name": "api"}},
+			want: false,
+		},
+		{
+			res:  &resource.AbstractResource{Type: "aws_s3_bucket", Id: "logs", Attrs: &resource.Attributes{}},
+			want: true,
+		},
+		{
+			res: &resource.AbstractResource{Type: "aws_s3_bucket", Id: "assets", Attrs: &resource.Attributes{
+				"tags": map[string]interface{}{"Terraform": "true"},
+			}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, r.IsResourceIgnored(tt.res), "%s.%s", tt.res.TerraformType(), tt.res.TerraformId())
+	}
+
+	// A negated attribute rule may re-include buckets, so they still need to be listed
+	assert.False(t, r.IsTypeIgnored("aws_s3_bucket"))
+	assert.False(t, r.IsTypeIgnored("aws_instance"))
+	// Negated attribute rules only re-include types their predicate may match
+	assert.True(t, r.IsTypeIgnored("aws_iam_role"))
+}
+
+func Test_splitTrailingComment(t *testing.T) {
+	tests := []struct {
+		name        string
+		line        string
+		wantRule    string
+		wantComment string
+	}{
+		{
+			name:        "metadata",
+			line:        "aws_s3_bucket.* # expires=2999-01-01 owner=team-data",
+			wantRule:    "aws_s3_bucket.*",
+			wantComment: "# expires=2999-01-01 owner=team-data",
+		},
+		{
+			name:        "metadata after text",
+			line:        "aws_s3_bucket.old-logs  # temporary bucket owner=team-data",
+			wantRule:    "aws_s3_bucket.old-logs",
+			wantComment: "# temporary bucket owner=team-data",
+		},
+		{
+			name:     "id containing a hash",
+			line:     "aws_s3_bucket.my #bucket",
+			wantRule: "aws_s3_bucket.my #bucket",
+		},
+		{
+			name:     "field path containing a hash",
+			line:     "aws_instance.web.tags.build #1",
+			wantRule: "aws_instance.web.tags.build #1",
+		},
+		{
+			name:     "hash in a quoted string of an attribute rule",
+			line:     "?Attr.description == 'build #1 owner=ci'",
+			wantRule: "?Attr.description == 'build #1 owner=ci'",
+		},
+		{
+			name:        "metadata of an attribute rule",
+			line:        "!?Attr.description == 'build #1' # owner=team-ci",
+			wantRule:    "!?Attr.description == 'build #1'",
+			wantComment: "# owner=team-ci",
+		},
+		{
+			name:        "escaped quote in an attribute rule",
+			line:        "?Attr.description == 'it\\'s #1' # owner=team-ci",
+			wantRule:    "?Attr.description == 'it\\'s #1'",
+			wantComment: "# owner=team-ci",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, comment := splitTrailingComment(tt.line)
+			assert.Equal(t, tt.wantRule, rule)
+			assert.Equal(t, tt.wantComment, comment)
+		})
+	}
+}
+
 func Test_escapableSplit(t *testing.T) {
 	tests := []struct {
 		name string
@@ -356,3 +599,34 @@ func Test_escapableSplit(t *testing.T) {
 		})
 	}
 }
+
+func TestDriftIgnore_Decides(t *testing.T) {
+	cwd, _ := os.Getwd()
+	defer func() { _ = os.Chdir(cwd) }()
+	if err := os.Chdir(path.Join("testdata", "drift_ignore_negation")); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewDriftIgnore()
+	tests := []struct {
+		rule string
+		want bool
+	}{
+		{rule: "aws_s3_bucket.assets", want: true},
+		{rule: "aws_s3_bucket.prod-api", want: true},
+		{rule: "aws_iam_role.admin", want: true},
+		{rule: "aws_s3_bucket.assets.tags", want: true},
+		{rule: "aws_instance.db.tags.Name", want: true},
+		{rule: "aws_instance.db.tags.Env", want: true},
+		{rule: "aws_instance.db.ami", want: false},
+		{rule: "aws_lambda_function.api", want: false},
+		{rule: "aws_lambda_function.*", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			assert.Equal(t, tt.want, r.Decides(tt.rule))
+		})
+	}
+	// Deciding on a rule does not mark existing rules as used
+	assert.Len(t, r.UnusedRules(), 11)
+}
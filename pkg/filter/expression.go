@@ -2,8 +2,12 @@ package filter
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/jmespath/go-jmespath"
+	"github.com/pkg/errors"
 )
 
 func BuildExpression(expressionStr string) (*jmespath.JMESPath, error) {
@@ -13,3 +17,100 @@ func BuildExpression(expressionStr string) (*jmespath.JMESPath, error) {
 	}
 	return expr, nil
 }
+
+// ValidateExpression compiles a filter expression and checks that it only reads fields filtered resources have
+func ValidateExpression(expressionStr string) error {
+	expr, err := BuildExpression(expressionStr)
+	if err != nil {
+		return err
+	}
+
+	fields := filtrableFields()
+	for _, field := range rootFields(expressionStr) {
+		if _, exists := fields[field]; !exists {
+			names := make([]string, 0, len(fields))
+			for name := range fields {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			return errors.Errorf("unknown field %s, resources only have fields %s", field, strings.Join(names, ", "))
+		}
+	}
+
+	// Unknown functions and wrong numbers of arguments are only reported on evaluation,
+	// other errors may come from attributes missing on the empty probe and are not reported
+	if _, err := expr.Search([]filtrableResource{{}}); err != nil {
+		if strings.HasPrefix(err.Error(), "unknown function") || strings.HasPrefix(err.Error(), "incorrect number of args") {
+			return err
+		}
+	}
+	return nil
+}
+
+func filtrableFields() map[string]struct{} {
+	fields := make(map[string]struct{})
+	ty := reflect.TypeOf(filtrableResource{})
+	for i := 0; i < ty.NumField(); i++ {
+		fields[ty.Field(i).Name] = struct{}{}
+	}
+	return fields
+}
+
+// rootFields returns fields the expression reads from the filtered resource itself, fields read in
+// brackets, after a pipe or through an expression reference are read from other values and are left out
+func rootFields(expressionStr string) []string {
+	var fields []string
+	depth := 0
+	for i := 0; i < len(expressionStr); i++ {
+		c := expressionStr[i]
+		switch {
+		case c == '\'' || c == '`' || c == '"':
+			end := closingQuote(expressionStr, i)
+			if end < 0 {
+				return fields
+			}
+			if c == '"' && depth == 0 && !isSubField(expressionStr, i) {
+				// Quoted identifier
+				fields = append(fields, expressionStr[i+1:end])
+			}
+			i = end
+		case c == '[' || c == '{':
+			depth++
+		case c == ']' || c == '}':
+			depth--
+		case c == '|':
+			if i+1 < len(expressionStr) && expressionStr[i+1] == '|' {
+				i++
+				continue
+			}
+			if depth == 0 {
+				return fields
+			}
+		default:
+			loc := identifierRegex.FindStringIndex(expressionStr[i:])
+			if loc == nil || loc[0] != 0 {
+				continue
+			}
+			end := i + loc[1]
+			if depth == 0 && !isSubField(expressionStr, i) && !isFunctionName(expressionStr, end) && !isExpressionReference(expressionStr, i) {
+				fields = append(fields, expressionStr[i:end])
+			}
+			i = end - 1
+		}
+	}
+	return fields
+}
+
+func isSubField(expressionStr string, start int) bool {
+	before := strings.TrimSpace(expressionStr[:start])
+	return strings.HasSuffix(before, ".")
+}
+
+func isFunctionName(expressionStr string, end int) bool {
+	return strings.HasPrefix(strings.TrimSpace(expressionStr[end:]), "(")
+}
+
+func isExpressionReference(expressionStr string, start int) bool {
+	before := strings.TrimSpace(expressionStr[:start])
+	return strings.HasSuffix(before, "&") && !strings.HasSuffix(before, "&&")
+}
@@ -21,6 +21,22 @@ type filtrableResource struct {
 	Type, Id string
 }
 
+func newFiltrableResource(res resource.Resource) filtrableResource {
+	f := filtrableResource{
+		Res:  res,
+		Id:   res.TerraformId(),
+		Type: res.TerraformType(),
+	}
+	if res.Attributes() != nil {
+		// We need to serialize all attributes to untyped interface from JMESPath to work
+		// map[string]string and map[string]SomeThing will not work without it
+		// https://github.com/jmespath/go-jmespath/issues/22
+		var attrs map[string]interface{} = *res.Attributes()
+		f.Attr = attrs
+	}
+	return f
+}
+
 func (e *FilterEngine) Run(resources []resource.Resource) ([]resource.Resource, error) {
 
 	if e.expr == nil {
@@ -30,20 +46,9 @@ func (e *FilterEngine) Run(resources []resource.Resource) ([]resource.Resource,
 	// We convert a list of resource in a list of DTO to run JMESPath on
 	filtrableResources := make([]filtrableResource, 0, len(resources))
 	for _, res := range resources {
-		// We need to serialize all attributes to untyped interface from JMESPath to work
-		// map[string]string and map[string]SomeThing will not work without it
-		// https://github.com/jmespath/go-jmespath/issues/22
-		var attrs map[string]interface{} = *res.Attributes()
-
-		f := filtrableResource{
-			Attr: attrs,
-			Res:  res,
-			Id:   res.TerraformId(),
-			Type: res.TerraformType(),
-		}
 		filtrableResources = append(
 			filtrableResources,
-			f,
+			newFiltrableResource(res),
 		)
 	}
 
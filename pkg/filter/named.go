@@ -0,0 +1,81 @@
+package filter
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var filterReference = regexp.MustCompile(`\$\{([A-Za-z0-9_-]+)\}`)
+
+// NamedExpressions are filter expressions referenced from other expressions as ${name}, names are case insensitive
+type NamedExpressions map[string]string
+
+// Expand replaces references to named expressions, each of them is wrapped in parentheses so that it can be combined
+func (n NamedExpressions) Expand(expressionStr string) (string, error) {
+	return n.expand(expressionStr, nil)
+}
+
+// expand replaces references, referencing are the names of the expressions being expanded to detect cycles.
+// Like in rootFields, literals and quoted identifiers are skipped so that "${...}" can still be matched literally.
+func (n NamedExpressions) expand(expressionStr string, referencing []string) (string, error) {
+	b := &strings.Builder{}
+	for i := 0; i < len(expressionStr); i++ {
+		c := expressionStr[i]
+		if c == '\'' || c == '`' || c == '"' {
+			end := closingQuote(expressionStr, i)
+			if end < 0 {
+				end = len(expressionStr) - 1
+			}
+			b.WriteString(expressionStr[i : end+1])
+			i = end
+			continue
+		}
+		loc := filterReference.FindStringSubmatchIndex(expressionStr[i:])
+		if c != '$' || loc == nil || loc[0] != 0 {
+			b.WriteByte(c)
+			continue
+		}
+		name := strings.ToLower(expressionStr[i+loc[2] : i+loc[3]])
+		for _, r := range referencing {
+			if r == name {
+				return "", errors.Errorf("filter %s references itself through %s", name, strings.Join(append(referencing, name), " -> "))
+			}
+		}
+		named, exists := n[name]
+		if !exists {
+			return "", errors.Errorf("unknown filter %s", name)
+		}
+		chain := make([]string, 0, len(referencing)+1)
+		chain = append(chain, referencing...)
+		sub, err := n.expand(named, append(chain, name))
+		if err != nil {
+			return "", err
+		}
+		b.WriteString("(" + sub + ")")
+		i += loc[1] - 1
+	}
+	return b.String(), nil
+}
+
+// Validate expands every named expression and validates it, the error names the first invalid one
+func (n NamedExpressions) Validate() error {
+	names := make([]string, 0, len(n))
+	for name := range n {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		expanded, err := n.expand(n[name], []string{name})
+		if err == nil {
+			err = ValidateExpression(expanded)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "filter %s is invalid", name)
+		}
+	}
+	return nil
+}
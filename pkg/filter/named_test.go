@@ -0,0 +1,85 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamedExpressions_Expand(t *testing.T) {
+	named := NamedExpressions{
+		"buckets":   "Type=='aws_s3_bucket'",
+		"terraform": "Attr.tags.Terraform=='true'",
+		"unmanaged": "${buckets} && !${Terraform}",
+		"loop":      "${cycle} || Type=='aws_instance'",
+		"cycle":     "${loop}",
+	}
+
+	tests := []struct {
+		expression string
+		want       string
+		err        string
+	}{
+		{expression: "Type=='aws_instance'", want: "Type=='aws_instance'"},
+		{expression: "${buckets}", want: "(Type=='aws_s3_bucket')"},
+		{expression: "${unmanaged} || Id=='logs'", want: "((Type=='aws_s3_bucket') && !(Attr.tags.Terraform=='true')) || Id=='logs'"},
+		{expression: "${unknown}", err: "unknown filter unknown"},
+		{expression: "Attr.description=='${foo}' && ${buckets}", want: "Attr.description=='${foo}' && (Type=='aws_s3_bucket')"},
+		{expression: "Attr.tags.\"${foo}\"==`\"${foo}\"`", want: "Attr.tags.\"${foo}\"==`\"${foo}\"`"},
+		{expression: "${loop}", err: "filter loop references itself through loop -> cycle -> loop"},
+	}
+	for _, tt := range tests {
+		got, err := named.Expand(tt.expression)
+		if tt.err != "" {
+			assert.EqualError(t, err, tt.err, tt.expression)
+			continue
+		}
+		assert.Nil(t, err, tt.expression)
+		assert.Equal(t, tt.want, got)
+	}
+}
+
+func TestNamedExpressions_Validate(t *testing.T) {
+	assert.Nil(t, NamedExpressions{
+		"buckets":   "Type=='aws_s3_bucket'",
+		"unmanaged": "${buckets} && Attr.tags.Terraform!='true'",
+	}.Validate())
+
+	assert.EqualError(t, NamedExpressions{
+		"buckets":   "Type=='aws_s3_bucket'",
+		"unmanaged": "${buckets} && tags.Terraform!='true'",
+	}.Validate(), "filter unmanaged is invalid: unknown field tags, resources only have fields Attr, Id, Res, Type")
+
+	assert.EqualError(t, NamedExpressions{
+		"broken":  "Type=='aws_s3_bucket'",
+		"sandbox": "${broken} && ${missing}",
+	}.Validate(), "filter sandbox is invalid: unknown filter missing")
+}
+
+func TestValidateExpression(t *testing.T) {
+	tests := []struct {
+		expression string
+		err        string
+	}{
+		{expression: "Type=='aws_s3_bucket' && Id!='logs'"},
+		{expression: "starts_with(Attr.function_name, 'sandbox-')"},
+		{expression: "Attr.tags.\"kubernetes.io/cluster\" == 'owned'"},
+		{expression: "length(Attr.ingress[?cidr_blocks && contains(cidr_blocks, '0.0.0.0/0')]) > `0`"},
+		{expression: "Attr.tags | keys(@) | contains(@, 'Env')"},
+		{expression: "length(sort_by(Attr.rules, &priority)) > `0`"},
+		{expression: "\"Type\" == 'aws_instance'"},
+		{expression: "type=='aws_s3_bucket'", err: "unknown field type, resources only have fields Attr, Id, Res, Type"},
+		{expression: "Type=='aws_s3_bucket' || \"Attributes\".tags", err: "unknown field Attributes, resources only have fields Attr, Id, Res, Type"},
+		{expression: "starts_with(Id)", err: "incorrect number of args"},
+		{expression: "begins_with(Id, 'sandbox-')", err: "unknown function: begins_with"},
+		{expression: "Type='aws_s3_bucket'", err: "SyntaxError: Expected tRbracket, received: tUnknown"},
+	}
+	for _, tt := range tests {
+		err := ValidateExpression(tt.expression)
+		if tt.err == "" {
+			assert.Nil(t, err, tt.expression)
+			continue
+		}
+		assert.EqualError(t, err, tt.err, tt.expression)
+	}
+}
@@ -0,0 +1,207 @@
+package filter
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/jmespath/go-jmespath"
+	"github.com/sirupsen/logrus"
+)
+
+// TypeFilter tells whether every resource of a given type will be discarded,
+// it is used to avoid enumerating resources that will never be analyzed
+type TypeFilter interface {
+	IsTypeIgnored(ty resource.ResourceType) bool
+}
+
+// ChainTypeFilter ignores a type as soon as one of its filters ignores it
+type ChainTypeFilter []TypeFilter
+
+func (c ChainTypeFilter) IsTypeIgnored(ty resource.ResourceType) bool {
+	for _, f := range c {
+		if f.IsTypeIgnored(ty) {
+			return true
+		}
+	}
+	return false
+}
+
+var identifierRegex = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+
+// ExpressionTypeFilter decides from a filter expression which resource types can be kept.
+// The expression is split into disjunctions of conjunctions, a conjunction that only
+// reads the Type field can be evaluated without knowing the resource. A type is ignored when
+// every disjunction has at least one of those conjunctions evaluating to false for this type.
+// Any expression we are not able to split safely is considered as keeping every type.
+type ExpressionTypeFilter struct {
+	disjunctions [][]*jmespath.JMESPath
+}
+
+func NewExpressionTypeFilter(expressionStr string) *ExpressionTypeFilter {
+	disjunctions := make([][]*jmespath.JMESPath, 0)
+	for _, disjunction := range splitTopLevel(expressionStr, "||") {
+		conjunctions := make([]*jmespath.JMESPath, 0)
+		for _, conjunction := range splitTopLevel(disjunction, "&&") {
+			if !isTypeOnlyExpression(conjunction) {
+				continue
+			}
+			expr, err := BuildExpression(conjunction)
+			if err != nil {
+				logrus.WithFields(logrus.Fields{
+					"expression": conjunction,
+				}).Debug("Unable to compile filter sub expression, all types will be scanned")
+				return &ExpressionTypeFilter{}
+			}
+			conjunctions = append(conjunctions, expr)
+		}
+		if len(conjunctions) == 0 {
+			// This disjunction can match any type
+			return &ExpressionTypeFilter{}
+		}
+		disjunctions = append(disjunctions, conjunctions)
+	}
+	return &ExpressionTypeFilter{disjunctions}
+}
+
+func (e *ExpressionTypeFilter) IsTypeIgnored(ty resource.ResourceType) bool {
+	if len(e.disjunctions) == 0 {
+		return false
+	}
+
+	probe := []filtrableResource{{Type: ty.String()}}
+	for _, conjunctions := range e.disjunctions {
+		matched := true
+		for _, expr := range conjunctions {
+			out, err := expr.Search(probe)
+			if err != nil {
+				return false
+			}
+			if list, ok := out.([]interface{}); !ok || len(list) == 0 {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return false
+		}
+	}
+	return true
+}
+
+// splitTopLevel splits an expression on an operator that is not nested
+// in brackets or in a literal, the whole expression is returned if it contains
+// a pipe as it would have a lower precedence than the operator
+func splitTopLevel(expressionStr, operator string) []string {
+	parts := make([]string, 0, 1)
+	depth := 0
+	last := 0
+	for i := 0; i < len(expressionStr); i++ {
+		c := expressionStr[i]
+		switch c {
+		case '\'', '"', '`':
+			end := closingQuote(expressionStr, i)
+			if end < 0 {
+				return []string{expressionStr}
+			}
+			i = end
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case '|', '&':
+			if depth != 0 {
+				continue
+			}
+			if strings.HasPrefix(expressionStr[i:], operator) {
+				parts = append(parts, strings.TrimSpace(expressionStr[last:i]))
+				i += len(operator) - 1
+				last = i + 1
+				continue
+			}
+			if i+1 < len(expressionStr) && expressionStr[i+1] == c {
+				// The other logical operator, skip both chars
+				i++
+				continue
+			}
+			if c == '|' {
+				return []string{expressionStr}
+			}
+		}
+	}
+	return append(parts, strings.TrimSpace(expressionStr[last:]))
+}
+
+func closingQuote(expressionStr string, start int) int {
+	quote := expressionStr[start]
+	for i := start + 1; i < len(expressionStr); i++ {
+		if expressionStr[i] == '\\' {
+			i++
+			continue
+		}
+		if expressionStr[i] == quote {
+			return i
+		}
+	}
+	return -1
+}
+
+// isTypeOnlyExpression returns true when the only field read by the expression is Type
+func isTypeOnlyExpression(expressionStr string) bool {
+	unquoted := ""
+	for i := 0; i < len(expressionStr); i++ {
+		c := expressionStr[i]
+		if c == '"' {
+			// Quoted identifier
+			return false
+		}
+		if c == '\'' || c == '`' {
+			end := closingQuote(expressionStr, i)
+			if end < 0 {
+				return false
+			}
+			unquoted += " "
+			i = end
+			continue
+		}
+		unquoted += string(c)
+	}
+
+	// Current node, wildcards, projections, expression references and pipes are not handled
+	logicalOperators := strings.NewReplacer("&&", " ", "||", " ")
+	if strings.ContainsAny(logicalOperators.Replace(unquoted), "@&|*[{") {
+		return false
+	}
+
+	readsType := false
+	for _, loc := range identifierRegex.FindAllStringIndex(unquoted, -1) {
+		identifier := unquoted[loc[0]:loc[1]]
+		if loc[0] > 0 && unquoted[loc[0]-1] == '.' {
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(unquoted[loc[1]:]), "(") {
+			// Function name
+			continue
+		}
+		if identifier != "Type" {
+			return false
+		}
+		readsType = true
+	}
+	return readsType
+}
+
+// KeptTypes returns types that are not ignored by the filter, along with the types they depend on
+func KeptTypes(typeFilter TypeFilter, types []resource.ResourceType) []resource.ResourceType {
+	kept := make([]resource.ResourceType, 0, len(types))
+	for _, ty := range types {
+		if typeFilter.IsTypeIgnored(ty) {
+			logrus.WithFields(logrus.Fields{
+				"type": ty,
+			}).Debug("Resource type is filtered out, it will not be enumerated")
+			continue
+		}
+		kept = append(kept, ty)
+	}
+	return resource.WithDependencies(kept)
+}
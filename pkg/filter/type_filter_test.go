@@ -0,0 +1,134 @@
+package filter
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+func TestExpressionTypeFilter_IsTypeIgnored(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		ignored map[resource.ResourceType]bool
+	}{
+		{
+			name: "filter on type",
+			expr: "Type=='aws_s3_bucket'",
+			ignored: map[resource.ResourceType]bool{
+				"aws_s3_bucket": false,
+				"aws_iam_role":  true,
+			},
+		},
+		{
+			name: "exclude a type",
+			expr: "Type!='aws_s3_bucket'",
+			ignored: map[resource.ResourceType]bool{
+				"aws_s3_bucket": true,
+				"aws_iam_role":  false,
+			},
+		},
+		{
+			name: "filter on type and id",
+			expr: "Type=='aws_s3_bucket' && Id!='foobar'",
+			ignored: map[resource.ResourceType]bool{
+				"aws_s3_bucket": false,
+				"aws_iam_role":  true,
+			},
+		},
+		{
+			name: "filter on several types",
+			expr: "(Type=='aws_s3_bucket' || Type=='aws_iam_role') && Attr.Tags.Terraform=='true'",
+			ignored: map[resource.ResourceType]bool{
+				"aws_s3_bucket": false,
+				"aws_iam_role":  false,
+				"aws_instance":  true,
+			},
+		},
+		{
+			name: "filter with a disjunction on id",
+			expr: "Type=='aws_s3_bucket' || Id=='foobar'",
+			ignored: map[resource.ResourceType]bool{
+				"aws_s3_bucket": false,
+				"aws_iam_role":  false,
+			},
+		},
+		{
+			name: "filter with a function",
+			expr: "starts_with(Type, 'aws_iam_') && Id!='foo||bar'",
+			ignored: map[resource.ResourceType]bool{
+				"aws_iam_role":  false,
+				"aws_s3_bucket": true,
+			},
+		},
+		{
+			name: "filter on attributes only",
+			expr: "Attr.Type=='aws_s3_bucket'",
+			ignored: map[resource.ResourceType]bool{
+				"aws_s3_bucket": false,
+				"aws_iam_role":  false,
+			},
+		},
+		{
+			name: "filter with a pipe",
+			expr: "Type=='aws_s3_bucket' | @",
+			ignored: map[resource.ResourceType]bool{
+				"aws_s3_bucket": false,
+				"aws_iam_role":  false,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewExpressionTypeFilter(tt.expr)
+			for ty, ignored := range tt.ignored {
+				assert.Equal(t, ignored, f.IsTypeIgnored(ty), ty)
+			}
+		})
+	}
+}
+
+func TestDriftIgnore_IsTypeIgnored(t *testing.T) {
+	cwd, _ := os.Getwd()
+	defer func() { _ = os.Chdir(cwd) }()
+	if err := os.Chdir(path.Join("testdata", "drift_ignore_type")); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewDriftIgnore()
+	assert.True(t, r.IsTypeIgnored("aws_s3_bucket"))
+	assert.True(t, r.IsTypeIgnored("aws_iam_role"))
+	assert.True(t, r.IsTypeIgnored("aws_iam_user"))
+	assert.False(t, r.IsTypeIgnored("aws_iam_policy"))
+	assert.False(t, r.IsTypeIgnored("aws_instance"))
+}
+
+func TestDriftIgnore_IsTypeIgnored_Negation(t *testing.T) {
+	cwd, _ := os.Getwd()
+	defer func() { _ = os.Chdir(cwd) }()
+	if err := os.Chdir(path.Join("testdata", "drift_ignore_negation")); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewDriftIgnore()
+	// Some buckets and roles are re-included by a negated rule, so those types still need to be scanned
+	assert.False(t, r.IsTypeIgnored("aws_s3_bucket"))
+	assert.False(t, r.IsTypeIgnored("aws_iam_role"))
+	assert.True(t, r.IsTypeIgnored("aws_iam_user"))
+	assert.False(t, r.IsTypeIgnored("aws_instance"))
+}
+
+func TestKeptTypes(t *testing.T) {
+	f := NewExpressionTypeFilter("Type=='aws_iam_policy_attachment' || Type=='aws_s3_bucket'")
+	got := KeptTypes(f, []resource.ResourceType{"aws_iam_policy_attachment", "aws_instance", "aws_s3_bucket"})
+	assert.Equal(t, []resource.ResourceType{
+		"aws_iam_policy_attachment",
+		"aws_s3_bucket",
+		"aws_iam_role_policy_attachment",
+		"aws_iam_user_policy_attachment",
+	}, got)
+}
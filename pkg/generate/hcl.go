@@ -0,0 +1,240 @@
+package generate
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/pkg/errors"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+const indentUnit = "  "
+
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// WriteHCL writes a resource block for each resource, resources are expected to hold their attributes and schema
+func WriteHCL(w io.Writer, namer *Namer, resources []resource.Resource) error {
+	b := &strings.Builder{}
+	for i, res := range resources {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		writeResource(b, namer.Name(res), res)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteHCLFiles writes resource blocks to path, or to a file per resource type in the path directory when split is set
+func WriteHCLFiles(path string, split bool, resources []resource.Resource) error {
+	namer := NewNamer()
+	if !split {
+		return writeHCLFile(path, namer, resources)
+	}
+
+	if err := os.MkdirAll(path, 0700); err != nil {
+		return err
+	}
+	byType := make(map[string][]resource.Resource)
+	for _, res := range resources {
+		byType[res.TerraformType()] = append(byType[res.TerraformType()], res)
+	}
+	for ty, res := range byType {
+		if err := writeHCLFile(filepath.Join(path, fmt.Sprintf("%s.tf", ty)), namer, res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeHCLFile(path string, namer *Namer, resources []resource.Resource) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "unable to write HCL to %s", path)
+	}
+	defer file.Close()
+	return WriteHCL(file, namer, resources)
+}
+
+func writeResource(b *strings.Builder, name string, res resource.Resource) {
+	fmt.Fprintf(b, "resource %q %q {\n", res.TerraformType(), name)
+	schema := res.Schema()
+	if schema == nil || schema.Block == nil || res.Attributes() == nil {
+		fmt.Fprintf(b, "%s# Attributes of %s could not be generated as its schema is unknown\n", indentUnit, res.TerraformId())
+		b.WriteString("}\n")
+		return
+	}
+	writeBody(b, indentUnit, schema.Block, *res.Attributes(), true)
+	b.WriteString("}\n")
+}
+
+// writeBody writes attributes and nested blocks of a block and returns true if anything was written.
+// Computed-only, deprecated, null and empty collection attributes are left out as they cannot or do not need to be configured.
+// Zero values like false are kept, as the schema does not tell whether they are the default of the provider.
+func writeBody(b *strings.Builder, indent string, block *configschema.Block, values map[string]interface{}, isResource bool) bool {
+	attributes := make([]string, 0, len(block.Attributes))
+	for name, attr := range block.Attributes {
+		if isResource && name == "id" {
+			continue
+		}
+		if attr.Deprecated || (attr.Computed && !attr.Optional) {
+			continue
+		}
+		if isEmptyValue(values[name]) {
+			continue
+		}
+		attributes = append(attributes, name)
+	}
+	sort.Strings(attributes)
+
+	// Equal signs are aligned like terraform fmt does
+	width := 0
+	for _, name := range attributes {
+		if len(name) > width {
+			width = len(name)
+		}
+	}
+	for _, name := range attributes {
+		fmt.Fprintf(b, "%s%-*s = %s\n", indent, width, name, formatValue(values[name], indent))
+	}
+
+	blockNames := make([]string, 0, len(block.BlockTypes))
+	for name := range block.BlockTypes {
+		blockNames = append(blockNames, name)
+	}
+	sort.Strings(blockNames)
+
+	written := len(attributes) > 0
+	for _, name := range blockNames {
+		nested := block.BlockTypes[name]
+		if isResource && name == "timeouts" {
+			continue
+		}
+		for _, element := range nestedBlockValues(nested, values[name]) {
+			nestedBody := &strings.Builder{}
+			if !writeBody(nestedBody, indent+indentUnit, &nested.Block, element.values, false) && nested.MinItems == 0 {
+				continue
+			}
+			if written {
+				b.WriteString("\n")
+			}
+			label := ""
+			if element.key != "" {
+				label = fmt.Sprintf(" %q", element.key)
+			}
+			fmt.Fprintf(b, "%s%s%s {\n%s%s}\n", indent, name, label, nestedBody.String(), indent)
+			written = true
+		}
+	}
+	return written
+}
+
+type blockValue struct {
+	// Label of the block, only set for blocks nested as a map
+	key    string
+	values map[string]interface{}
+}
+
+// nestedBlockValues returns every instance of a nested block from the value read for it
+func nestedBlockValues(nested *configschema.NestedBlock, value interface{}) []blockValue {
+	var result []blockValue
+	switch nested.Nesting {
+	case configschema.NestingSingle, configschema.NestingGroup:
+		if values, ok := value.(map[string]interface{}); ok {
+			result = append(result, blockValue{values: values})
+		}
+	case configschema.NestingMap:
+		if values, ok := value.(map[string]interface{}); ok {
+			keys := make([]string, 0, len(values))
+			for key := range values {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				if v, ok := values[key].(map[string]interface{}); ok {
+					result = append(result, blockValue{key: key, values: v})
+				}
+			}
+		}
+	default:
+		if list, ok := value.([]interface{}); ok {
+			for _, element := range list {
+				if v, ok := element.(map[string]interface{}); ok {
+					result = append(result, blockValue{values: v})
+				}
+			}
+		}
+	}
+	return result
+}
+
+// isEmptyValue tells whether a value is null or an empty collection, such values are left out
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func formatValue(value interface{}, indent string) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return quoteHCLString(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	case []interface{}:
+		elements := make([]string, 0, len(v))
+		for _, element := range v {
+			elements = append(elements, formatValue(element, indent))
+		}
+		return "[" + strings.Join(elements, ", ") + "]"
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return "{}"
+		}
+		keys := make([]string, 0, len(v))
+		width := 0
+		for key := range v {
+			keys = append(keys, key)
+			if len(formatKey(key)) > width {
+				width = len(formatKey(key))
+			}
+		}
+		sort.Strings(keys)
+		b := &strings.Builder{}
+		b.WriteString("{\n")
+		for _, key := range keys {
+			fmt.Fprintf(b, "%s%s%-*s = %s\n", indent, indentUnit, width, formatKey(key), formatValue(v[key], indent+indentUnit))
+		}
+		b.WriteString(indent + "}")
+		return b.String()
+	}
+	return quoteHCLString(fmt.Sprintf("%v", value))
+}
+
+// formatKey quotes map keys that are not valid identifiers
+func formatKey(key string) string {
+	if identifier.MatchString(key) {
+		return key
+	}
+	return quoteHCLString(key)
+}
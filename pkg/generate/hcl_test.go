@@ -0,0 +1,161 @@
+package generate
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+var bucketSchema = &resource.Schema{
+	Block: &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"id":            {Type: cty.String, Optional: true, Computed: true},
+			"arn":           {Type: cty.String, Computed: true},
+			"bucket":        {Type: cty.String, Optional: true, Computed: true},
+			"acl":           {Type: cty.String, Optional: true},
+			"force_destroy": {Type: cty.Bool, Optional: true},
+			"policy":        {Type: cty.String, Optional: true},
+			"region":        {Type: cty.String, Optional: true, Computed: true, Deprecated: true},
+			"tags":          {Type: cty.Map(cty.String), Optional: true},
+		},
+		BlockTypes: map[string]*configschema.NestedBlock{
+			"versioning": {
+				Nesting: configschema.NestingList,
+				Block: configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"enabled":    {Type: cty.Bool, Optional: true},
+						"mfa_delete": {Type: cty.Bool, Optional: true},
+					},
+				},
+			},
+			"lifecycle_rule": {
+				Nesting: configschema.NestingList,
+				Block: configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id":      {Type: cty.String, Optional: true, Computed: true},
+						"enabled": {Type: cty.Bool, Required: true},
+						"prefix":  {Type: cty.String, Optional: true},
+					},
+					BlockTypes: map[string]*configschema.NestedBlock{
+						"expiration": {
+							Nesting: configschema.NestingList,
+							Block: configschema.Block{
+								Attributes: map[string]*configschema.Attribute{
+									"days": {Type: cty.Number, Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			"timeouts": {
+				Nesting: configschema.NestingSingle,
+				Block: configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"create": {Type: cty.String, Optional: true},
+					},
+				},
+			},
+		},
+	},
+}
+
+func testResources() []resource.Resource {
+	return []resource.Resource{
+		&resource.AbstractResource{
+			Id:   "my-logs",
+			Type: "aws_s3_bucket",
+			Sch:  bucketSchema,
+			Attrs: &resource.Attributes{
+				"id":            "my-logs",
+				"arn":           "arn:aws:s3:::my-logs",
+				"bucket":        "my-logs",
+				"acl":           "private",
+				"force_destroy": false,
+				"policy":        "{\"Version\":\"2012-10-17\"}",
+				"region":        "eu-west-3",
+				"tags":          map[string]interface{}{"Name": "Logs", "cost-center": "42", "team:owner": "ops"},
+				"versioning":    []interface{}{map[string]interface{}{"enabled": false, "mfa_delete": false}},
+				"lifecycle_rule": []interface{}{
+					map[string]interface{}{
+						"id":         "expire",
+						"enabled":    true,
+						"prefix":     "tmp/",
+						"expiration": []interface{}{map[string]interface{}{"days": float64(7)}},
+					},
+				},
+				"timeouts": map[string]interface{}{"create": "5m"},
+			},
+		},
+		&resource.AbstractResource{
+			Id:    "my-assets",
+			Type:  "aws_s3_bucket",
+			Sch:   bucketSchema,
+			Attrs: &resource.Attributes{"bucket": "my-assets", "tags": map[string]interface{}{"Name": "logs"}},
+		},
+		&resource.SerializedResource{Id: "my-team", Type: "github_team"},
+	}
+}
+
+func TestWriteHCL(t *testing.T) {
+	got := &bytes.Buffer{}
+	assert.Nil(t, WriteHCL(got, NewNamer(), testResources()))
+
+	expected, err := os.ReadFile("testdata/hcl.tf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, string(expected), got.String())
+}
+
+func TestWriteHCLFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	assert.Nil(t, WriteHCLFiles(filepath.Join(dir, "unmanaged.tf"), false, testResources()))
+	single, err := os.ReadFile(filepath.Join(dir, "unmanaged.tf"))
+	assert.Nil(t, err)
+	expected, _ := os.ReadFile("testdata/hcl.tf")
+	assert.Equal(t, string(expected), string(single))
+
+	assert.Nil(t, WriteHCLFiles(filepath.Join(dir, "split"), true, testResources()))
+	files, err := filepath.Glob(filepath.Join(dir, "split", "*.tf"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "split", "aws_s3_bucket.tf"), filepath.Join(dir, "split", "github_team.tf")}, files)
+	team, _ := os.ReadFile(filepath.Join(dir, "split", "github_team.tf"))
+	assert.Equal(t, "resource \"github_team\" \"my-team\" {\n  # Attributes of my-team could not be generated as its schema is unknown\n}\n", string(team))
+}
+
+func TestWriteHCL_ZeroValues(t *testing.T) {
+	// enable_dns_support defaults to true, leaving out false would enable it back on apply
+	vpc := &resource.AbstractResource{
+		Id:   "vpc-0123",
+		Type: "aws_vpc",
+		Sch: &resource.Schema{
+			Block: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"cidr_block":         {Type: cty.String, Required: true},
+					"enable_dns_support": {Type: cty.Bool, Optional: true},
+					"instance_tenancy":   {Type: cty.String, Optional: true},
+					"tags":               {Type: cty.Map(cty.String), Optional: true},
+				},
+			},
+		},
+		Attrs: &resource.Attributes{
+			"cidr_block":         "10.0.0.0/16",
+			"enable_dns_support": false,
+			"instance_tenancy":   nil,
+			"tags":               map[string]interface{}{},
+		},
+	}
+
+	got := &bytes.Buffer{}
+	assert.Nil(t, WriteHCL(got, NewNamer(), []resource.Resource{vpc}))
+	assert.Equal(t, "resource \"aws_vpc\" \"vpc-0123\" {\n  cidr_block         = \"10.0.0.0/16\"\n  enable_dns_support = false\n}\n", got.String())
+}
@@ -0,0 +1,136 @@
+package generate
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
+)
+
+const (
+	// ImportCommands are terraform import commands
+	ImportCommands = "command"
+	// ImportBlocks are import blocks supported since Terraform 1.5
+	ImportBlocks = "block"
+)
+
+// SupportedImportFormats lists formats imports can be written in
+var SupportedImportFormats = []string{ImportCommands, ImportBlocks}
+
+// Import is a resource to import into a Terraform address
+type Import struct {
+	Address string
+	Id      string
+	// Reason why the resource cannot be imported, Id is empty when set
+	Unsupported string
+}
+
+var (
+	// errNoImportId is returned when the import id of a resource cannot be built from its id and human readable attributes
+	errNoImportId      = errors.New("its import id cannot be built from the scan result")
+	errDefaultResource = errors.New("default resources are adopted by declaring them instead of being imported")
+)
+
+// importIds builds import ids of types whose Terraform import id differs from the id driftctl gives them
+var importIds = map[string]func(res resource.Resource) (string, error){
+	resourceaws.AwsRouteResourceType: func(res resource.Resource) (string, error) {
+		attrs := resource.HumanReadableAttributes(res)
+		if attrs["Table"] == "" || attrs["Destination"] == "" {
+			return "", errNoImportId
+		}
+		return fmt.Sprintf("%s_%s", attrs["Table"], attrs["Destination"]), nil
+	},
+	resourceaws.AwsRouteTableAssociationResourceType: func(res resource.Resource) (string, error) {
+		attrs := resource.HumanReadableAttributes(res)
+		target := attrs["Subnet"]
+		if target == "" {
+			target = attrs["Gateway"]
+		}
+		if attrs["Table"] == "" || target == "" {
+			return "", errNoImportId
+		}
+		return fmt.Sprintf("%s/%s", target, attrs["Table"]), nil
+	},
+	// Ids of these resources are computed by driftctl and do not hold what Terraform needs to import them
+	resourceaws.AwsSecurityGroupRuleResourceType:       unsupportedImport(errNoImportId),
+	resourceaws.AwsIamRolePolicyAttachmentResourceType: unsupportedImport(errNoImportId),
+	resourceaws.AwsIamUserPolicyAttachmentResourceType: unsupportedImport(errNoImportId),
+	resourceaws.AwsDefaultVpcResourceType:              unsupportedImport(errDefaultResource),
+	resourceaws.AwsDefaultSubnetResourceType:           unsupportedImport(errDefaultResource),
+	resourceaws.AwsDefaultRouteTableResourceType:       unsupportedImport(errDefaultResource),
+	resourceaws.AwsDefaultSecurityGroupResourceType:    unsupportedImport(errDefaultResource),
+}
+
+func unsupportedImport(err error) func(res resource.Resource) (string, error) {
+	return func(res resource.Resource) (string, error) {
+		return "", err
+	}
+}
+
+// Imports suggests an address for each resource, resources are expected to have distinct ids per type.
+// Resources that cannot be imported are returned with the reason why.
+func Imports(namer *Namer, resources []resource.Resource) []Import {
+	imports := make([]Import, 0, len(resources))
+	for _, res := range resources {
+		imp := Import{
+			Address: fmt.Sprintf("%s.%s", res.TerraformType(), namer.Name(res)),
+			Id:      res.TerraformId(),
+		}
+		if importId, exist := importIds[res.TerraformType()]; exist {
+			id, err := importId(res)
+			if err != nil {
+				imp.Id = ""
+				imp.Unsupported = fmt.Sprintf("%s.%s cannot be imported, %s", res.TerraformType(), res.TerraformId(), err)
+			} else {
+				imp.Id = id
+			}
+		}
+		imports = append(imports, imp)
+	}
+	return imports
+}
+
+// WriteImports writes imports in the given format, resources that cannot be imported are written as comments
+func WriteImports(w io.Writer, format string, imports []Import) error {
+	if format != ImportBlocks && format != ImportCommands {
+		return errors.Errorf("unsupported import format %s", format)
+	}
+	for i, imp := range imports {
+		separator := ""
+		if i > 0 && format == ImportBlocks {
+			separator = "\n"
+		}
+		var err error
+		switch {
+		case imp.Unsupported != "":
+			_, err = fmt.Fprintf(w, "%s# %s\n", separator, imp.Unsupported)
+		case format == ImportBlocks:
+			_, err = fmt.Fprintf(w, "%simport {\n  to = %s\n  id = %s\n}\n", separator, imp.Address, quoteHCLString(imp.Id))
+		default:
+			_, err = fmt.Fprintf(w, "terraform import %s %s\n", imp.Address, quoteShellArg(imp.Id))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var safeShellArg = regexp.MustCompile(`^[A-Za-z0-9_./:=@,+-]+$`)
+
+func quoteShellArg(arg string) string {
+	if safeShellArg.MatchString(arg) {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'"'"'`) + "'"
+}
+
+func quoteHCLString(value string) string {
+	value = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "${", "$${", "%{", "%%{").Replace(value)
+	return `"` + value + `"`
+}
@@ -0,0 +1,69 @@
+package generate
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+func TestWriteImports(t *testing.T) {
+	imports := Imports(NewNamer(), []resource.Resource{
+		&resource.SerializedResource{Id: "my-bucket", Type: "aws_s3_bucket"},
+		&resource.SerializedResource{Id: `it's "${quoted}"`, Type: "github_team"},
+	})
+	assert.Equal(t, []Import{
+		{Address: "aws_s3_bucket.my-bucket", Id: "my-bucket"},
+		{Address: "github_team.it_s_quoted", Id: `it's "${quoted}"`},
+	}, imports)
+
+	commands := &bytes.Buffer{}
+	assert.Nil(t, WriteImports(commands, ImportCommands, imports))
+	assert.Equal(t, `terraform import aws_s3_bucket.my-bucket my-bucket
+terraform import github_team.it_s_quoted 'it'"'"'s "${quoted}"'
+`, commands.String())
+
+	blocks := &bytes.Buffer{}
+	assert.Nil(t, WriteImports(blocks, ImportBlocks, imports))
+	assert.Equal(t, `import {
+  to = aws_s3_bucket.my-bucket
+  id = "my-bucket"
+}
+
+import {
+  to = github_team.it_s_quoted
+  id = "it's \"$${quoted}\""
+}
+`, blocks.String())
+
+	assert.EqualError(t, WriteImports(&bytes.Buffer{}, "json", imports), "unsupported import format json")
+}
+
+func TestImports_ImportIds(t *testing.T) {
+	imports := Imports(NewNamer(), []resource.Resource{
+		&resource.SerializedResource{Id: "r-rtb-0123456789", Type: "aws_route", HumanReadableAttributes: map[string]string{"Table": "rtb-01234", "Destination": "10.0.0.0/16"}},
+		&resource.SerializedResource{Id: "r-rtb-9876543210", Type: "aws_route", HumanReadableAttributes: map[string]string{"Table": "rtb-01234"}},
+		&resource.SerializedResource{Id: "rtbassoc-0123", Type: "aws_route_table_association", HumanReadableAttributes: map[string]string{"Table": "rtb-01234", "Subnet": "subnet-0123"}},
+		&resource.SerializedResource{Id: "sgrule-3970541193", Type: "aws_security_group_rule"},
+		&resource.SerializedResource{Id: "vpc-0123", Type: "aws_default_vpc"},
+	})
+	assert.Equal(t, []Import{
+		{Address: "aws_route.r-rtb-0123456789", Id: "rtb-01234_10.0.0.0/16"},
+		{Address: "aws_route.r-rtb-9876543210", Unsupported: "aws_route.r-rtb-9876543210 cannot be imported, its import id cannot be built from the scan result"},
+		{Address: "aws_route_table_association.rtbassoc-0123", Id: "subnet-0123/rtb-01234"},
+		{Address: "aws_security_group_rule.sgrule-3970541193", Unsupported: "aws_security_group_rule.sgrule-3970541193 cannot be imported, its import id cannot be built from the scan result"},
+		{Address: "aws_default_vpc.vpc-0123", Unsupported: "aws_default_vpc.vpc-0123 cannot be imported, default resources are adopted by declaring them instead of being imported"},
+	}, imports)
+
+	blocks := &bytes.Buffer{}
+	assert.Nil(t, WriteImports(blocks, ImportBlocks, imports[2:4]))
+	assert.Equal(t, `import {
+  to = aws_route_table_association.rtbassoc-0123
+  id = "subnet-0123/rtb-01234"
+}
+
+# aws_security_group_rule.sgrule-3970541193 cannot be imported, its import id cannot be built from the scan result
+`, blocks.String())
+}
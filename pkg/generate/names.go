@@ -0,0 +1,61 @@
+package generate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+// nameAttributes are human readable attributes names are suggested from, by order of preference
+var nameAttributes = []string{"Name", "DisplayName", "Fqdn", "User", "Branch"}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// Namer suggests Terraform resource names, each name is only given once per resource type
+type Namer struct {
+	taken map[string]struct{}
+}
+
+func NewNamer() *Namer {
+	return &Namer{taken: make(map[string]struct{})}
+}
+
+// Name returns a Terraform name for the resource, based on its human readable attributes or its id.
+// Only those are used, so that a resource read from a scan result is given the same name as during the scan.
+// A number is appended when the name was already given to another resource of the same type.
+func (n *Namer) Name(res resource.Resource) string {
+	name := sanitizeName(suggestName(res))
+	candidate := name
+	for i := 2; ; i++ {
+		key := fmt.Sprintf("%s.%s", res.TerraformType(), candidate)
+		if _, exist := n.taken[key]; !exist {
+			n.taken[key] = struct{}{}
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s_%d", name, i)
+	}
+}
+
+func suggestName(res resource.Resource) string {
+	humanAttrs := resource.HumanReadableAttributes(res)
+	for _, key := range nameAttributes {
+		if name := humanAttrs[key]; name != "" {
+			return name
+		}
+	}
+	return res.TerraformId()
+}
+
+// sanitizeName turns a string into a valid Terraform identifier
+func sanitizeName(name string) string {
+	name = strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(name), "_"), "_-")
+	if name == "" {
+		return "resource"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "r_" + name
+	}
+	return name
+}
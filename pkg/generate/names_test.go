@@ -0,0 +1,66 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+func TestNamer_Name(t *testing.T) {
+	namer := NewNamer()
+
+	cases := []struct {
+		name     string
+		res      resource.Resource
+		expected string
+	}{
+		{
+			name:     "from the Name human readable attribute",
+			res:      &resource.SerializedResource{Id: "i-0123", Type: "aws_instance", HumanReadableAttributes: map[string]string{"Name": "Web Server"}},
+			expected: "web_server",
+		},
+		{
+			name:     "same name is suffixed",
+			res:      &resource.SerializedResource{Id: "i-4567", Type: "aws_instance", HumanReadableAttributes: map[string]string{"Name": "web server"}},
+			expected: "web_server_2",
+		},
+		{
+			name:     "same name of another type",
+			res:      &resource.SerializedResource{Id: "web-server", Type: "aws_iam_user", HumanReadableAttributes: map[string]string{"User": "web.server"}},
+			expected: "web_server",
+		},
+		{
+			name:     "from human readable attributes",
+			res:      &resource.SerializedResource{Id: "Z123_example.com_A", Type: "aws_route53_record", HumanReadableAttributes: map[string]string{"Fqdn": "example.com", "Type": "A"}},
+			expected: "example_com",
+		},
+		{
+			name:     "tags are not used",
+			res:      &resource.AbstractResource{Id: "my-assets", Type: "aws_s3_bucket", Attrs: &resource.Attributes{"tags": map[string]interface{}{"Name": "Assets"}}},
+			expected: "my-assets",
+		},
+		{
+			name:     "from the id",
+			res:      &resource.SerializedResource{Id: "my-bucket", Type: "aws_s3_bucket"},
+			expected: "my-bucket",
+		},
+		{
+			name:     "id starting with a digit",
+			res:      &resource.SerializedResource{Id: "4556715", Type: "github_team"},
+			expected: "r_4556715",
+		},
+		{
+			name:     "id without any valid character",
+			res:      &resource.SerializedResource{Id: "***", Type: "github_team"},
+			expected: "resource",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, namer.Name(c.res))
+		})
+	}
+}
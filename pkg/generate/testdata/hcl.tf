@@ -0,0 +1,37 @@
+resource "aws_s3_bucket" "my-logs" {
+  acl           = "private"
+  bucket        = "my-logs"
+  force_destroy = false
+  policy        = "{\"Version\":\"2012-10-17\"}"
+  tags          = {
+    Name         = "Logs"
+    cost-center  = "42"
+    "team:owner" = "ops"
+  }
+
+  lifecycle_rule {
+    enabled = true
+    id      = "expire"
+    prefix  = "tmp/"
+
+    expiration {
+      days = 7
+    }
+  }
+
+  versioning {
+    enabled    = false
+    mfa_delete = false
+  }
+}
+
+resource "aws_s3_bucket" "my-assets" {
+  bucket = "my-assets"
+  tags   = {
+    Name = "logs"
+  }
+}
+
+resource "github_team" "my-team" {
+  # Attributes of my-team could not be generated as its schema is unknown
+}
@@ -1,7 +1,18 @@
 package config
 
+import "fmt"
+
 type SupplierConfig struct {
 	Key     string
 	Backend string
 	Path    string
 }
+
+// String returns the config in the format of the from flag (e.g. tfstate+s3://bucket/terraform.tfstate)
+func (c SupplierConfig) String() string {
+	scheme := c.Key
+	if c.Backend != "" {
+		scheme = fmt.Sprintf("%s+%s", c.Key, c.Backend)
+	}
+	return fmt.Sprintf("%s://%s", scheme, c.Path)
+}
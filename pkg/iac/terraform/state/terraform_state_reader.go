@@ -1,6 +1,7 @@
 package state
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
 	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/enumerator"
 	"github.com/cloudskiff/driftctl/pkg/resource"
+	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
 	"github.com/cloudskiff/driftctl/pkg/terraform"
 )
 
@@ -48,7 +50,13 @@ func NewReader(config config.SupplierConfig, library *terraform.ProviderLibrary,
 	return &reader, nil
 }
 
-func (r *TerraformStateReader) retrieve() (map[string][]cty.Value, error) {
+// stateValue is a resource instance decoded from a state along with its Terraform address
+type stateValue struct {
+	value   cty.Value
+	address string
+}
+
+func (r *TerraformStateReader) retrieve() (map[string][]stateValue, error) {
 	b, err := backend.GetBackend(r.config, r.backendOptions)
 	if err != nil {
 		return nil, err
@@ -61,7 +69,7 @@ func (r *TerraformStateReader) retrieve() (map[string][]cty.Value, error) {
 		return nil, err
 	}
 
-	resMap := make(map[string][]cty.Value)
+	resMap := make(map[string][]stateValue)
 	for moduleName, module := range state.Modules {
 		logrus.WithFields(logrus.Fields{
 			"module":        moduleName,
@@ -87,7 +95,7 @@ func (r *TerraformStateReader) retrieve() (map[string][]cty.Value, error) {
 				continue
 			}
 			schema := provider.Schema()[stateRes.Addr.Resource.Type]
-			for _, instance := range stateRes.Instances {
+			for key, instance := range stateRes.Instances {
 				decodedVal, err := instance.Current.Decode(schema.Block.ImpliedType())
 				if err != nil {
 					// Try to do a manual type conversion if we got a path error
@@ -113,14 +121,10 @@ func (r *TerraformStateReader) retrieve() (map[string][]cty.Value, error) {
 						return nil, err
 					}
 				}
-				_, exists := resMap[stateRes.Addr.Resource.Type]
-				if !exists {
-					resMap[stateRes.Addr.Resource.Type] = []cty.Value{
-						decodedVal.Value,
-					}
-				} else {
-					resMap[stateRes.Addr.Resource.Type] = append(resMap[stateRes.Addr.Resource.Type], decodedVal.Value)
-				}
+				resMap[stateRes.Addr.Resource.Type] = append(resMap[stateRes.Addr.Resource.Type], stateValue{
+					value:   decodedVal.Value,
+					address: stateRes.Addr.Instance(key).String(),
+				})
 			}
 		}
 	}
@@ -156,30 +160,47 @@ func (r *TerraformStateReader) convertInstance(instance *states.ResourceInstance
 	return instanceObj, nil
 }
 
-func (r *TerraformStateReader) decode(values map[string][]cty.Value) ([]resource.Resource, error) {
+func (r *TerraformStateReader) decode(values map[string][]stateValue) ([]resource.Resource, error) {
 	results := make([]resource.Resource, 0)
+	source := r.config.String()
 
-	for ty, val := range values {
+	for ty, stateValues := range values {
 		if !resource.IsResourceTypeSupported(ty) {
 			continue
 		}
+		val := make([]cty.Value, 0, len(stateValues))
+		for _, v := range stateValues {
+			val = append(val, v.value)
+		}
 		decodedResources, err := r.deserializer.Deserialize(ty, val)
 		if err != nil {
 			logrus.WithField("ty", ty).Warnf("Could not read from state: %+v", err)
 			continue
 		}
+		// Resources are deserialized in the order of values, keep track of where each one is defined.
+		// The account and the region are only known from the ARN, so that resources sharing the same id
+		// in several accounts or regions are told apart.
+		for i, res := range decodedResources {
+			if withMeta, ok := res.(resource.ResourceWithMeta); ok {
+				withMeta.Metadata().Address = stateValues[i].address
+				withMeta.Metadata().Source = source
+				location := resourceaws.LocationFromArn(res)
+				withMeta.Metadata().Account = location.Account
+				withMeta.Metadata().Region = location.Region
+			}
+		}
 		results = append(results, decodedResources...)
 	}
 
 	return results, nil
 }
 
-func (r *TerraformStateReader) Resources() ([]resource.Resource, error) {
+func (r *TerraformStateReader) Resources(ctx context.Context) ([]resource.Resource, error) {
 	if r.enumerator == nil {
 		return r.retrieveForState(r.config.Path)
 	}
 
-	return r.retrieveMultiplesStates()
+	return r.retrieveMultiplesStates(ctx)
 }
 
 func (r *TerraformStateReader) retrieveForState(path string) ([]resource.Resource, error) {
@@ -196,7 +217,7 @@ func (r *TerraformStateReader) retrieveForState(path string) ([]resource.Resourc
 	return r.decode(values)
 }
 
-func (r *TerraformStateReader) retrieveMultiplesStates() ([]resource.Resource, error) {
+func (r *TerraformStateReader) retrieveMultiplesStates(ctx context.Context) ([]resource.Resource, error) {
 	keys, err := r.enumerator.Enumerate()
 	if err != nil {
 		return nil, err
@@ -207,6 +228,9 @@ func (r *TerraformStateReader) retrieveMultiplesStates() ([]resource.Resource, e
 	results := make([]resource.Resource, 0)
 
 	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		resources, err := r.retrieveForState(key)
 		if err != nil {
 			if _, ok := err.(*UnsupportedVersionError); ok {
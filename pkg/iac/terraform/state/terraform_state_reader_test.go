@@ -1,6 +1,8 @@
 package state
 
 import (
+	"context"
+
 	"encoding/json"
 	"os"
 	"path"
@@ -110,7 +112,7 @@ func TestTerraformStateReader_AWS_Resources(t *testing.T) {
 
 			if shouldUpdate {
 				var err error
-				realProvider, err = aws.NewAWSTerraformProvider("", progress, "")
+				realProvider, err = aws.NewAWSTerraformProvider(context.Background(), "", progress, "", aws.Options{}, nil)
 				if err != nil {
 					t.Fatal(err)
 				}
@@ -138,7 +140,7 @@ func TestTerraformStateReader_AWS_Resources(t *testing.T) {
 				deserializer: resource.NewDeserializer(factory),
 			}
 
-			got, err := r.Resources()
+			got, err := r.Resources(context.Background())
 			resGoldenName := "result.golden.json"
 			if shouldUpdate {
 				unm, err := json.Marshal(got)
@@ -171,6 +173,43 @@ func TestTerraformStateReader_AWS_Resources(t *testing.T) {
 	}
 }
 
+func TestTerraformStateReader_ResourceAddresses(t *testing.T) {
+	progress := &output.MockProgress{}
+	progress.On("Inc").Return()
+
+	library := terraform.NewProviderLibrary()
+	library.AddProvider(terraform.GITHUB, mocks.NewMockedGoldenTFProvider("github_branch_protection", nil, false))
+
+	repo := testresource.InitFakeSchemaRepository(terraform.GITHUB, "4.4.0")
+	resourcegithub.InitResourcesMetadata(repo)
+
+	statePath := path.Join(goldenfile.GoldenFilePath, "github_branch_protection", "terraform.tfstate")
+	r := &TerraformStateReader{
+		config: config.SupplierConfig{
+			Key:  "tfstate",
+			Path: statePath,
+		},
+		library:      library,
+		progress:     progress,
+		deserializer: resource.NewDeserializer(terraform.NewTerraformResourceFactory(repo)),
+	}
+
+	got, err := r.Resources(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metas := map[string]resource.Meta{}
+	for _, res := range got {
+		metas[res.TerraformId()] = resource.MetadataOf(res)
+	}
+	assert.Equal(t, map[string]resource.Meta{
+		"MDIwOkJyYW5jaFByb3RlY3Rpb25SdWxlMTk1NDg0NzI=": {Address: "github_branch_protection.main_repo[0]", Source: "tfstate://" + statePath},
+		"MDIwOkJyYW5jaFByb3RlY3Rpb25SdWxlMTk1NDg0NzQ=": {Address: "github_branch_protection.main_repo[1]", Source: "tfstate://" + statePath},
+		"MDIwOkJyYW5jaFByb3RlY3Rpb25SdWxlMTk1NDg0NzE=": {Address: "github_branch_protection.main_repo[2]", Source: "tfstate://" + statePath},
+	}, metas)
+}
+
 func TestTerraformStateReader_Github_Resources(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -195,7 +234,7 @@ func TestTerraformStateReader_Github_Resources(t *testing.T) {
 
 			if shouldUpdate {
 				var err error
-				realProvider, err = github.NewGithubTerraformProvider("", progress, "")
+				realProvider, err = github.NewGithubTerraformProvider(context.Background(), "", progress, "", github.Options{}, nil)
 				if err != nil {
 					t.Fatal(err)
 				}
@@ -222,7 +261,7 @@ func TestTerraformStateReader_Github_Resources(t *testing.T) {
 				deserializer: resource.NewDeserializer(factory),
 			}
 
-			got, err := r.Resources()
+			got, err := r.Resources(context.Background())
 			resGoldenName := "result.golden.json"
 			if shouldUpdate {
 				unm, err := json.Marshal(got)
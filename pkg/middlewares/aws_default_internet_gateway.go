@@ -17,6 +17,8 @@ func NewAwsDefaultInternetGateway() AwsDefaultInternetGateway {
 func (m AwsDefaultInternetGateway) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
 	newRemoteResources := make([]resource.Resource, 0)
 
+	stateIndex := resource.NewIndex(*resourcesFromState)
+
 	for _, remoteResource := range *remoteResources {
 		// Ignore all resources other than internet gateways
 		if remoteResource.TerraformType() != aws.AwsInternetGatewayResourceType {
@@ -32,13 +34,7 @@ func (m AwsDefaultInternetGateway) Execute(remoteResources, resourcesFromState *
 		}
 
 		// Check if internet gateway is managed by IaC
-		existInState := false
-		for _, stateResource := range *resourcesFromState {
-			if resource.IsSameResource(remoteResource, stateResource) {
-				existInState = true
-				break
-			}
-		}
+		existInState := stateIndex.Contains(remoteResource)
 
 		// Include resource if it's managed in IaC
 		if existInState {
@@ -18,6 +18,8 @@ func (m AwsDefaultRoute) Execute(remoteResources, resourcesFromState *[]resource
 
 	newRemoteResources := make([]resource.Resource, 0)
 
+	stateIndex := resource.NewIndex(*resourcesFromState)
+
 	for _, remoteResource := range *remoteResources {
 		// Ignore all resources other than routes
 		if remoteResource.TerraformType() != aws.AwsRouteResourceType {
@@ -33,13 +35,7 @@ func (m AwsDefaultRoute) Execute(remoteResources, resourcesFromState *[]resource
 		}
 
 		// Check if route is managed by IaC
-		existInState := false
-		for _, stateResource := range *resourcesFromState {
-			if resource.IsSameResource(remoteResource, stateResource) {
-				existInState = true
-				break
-			}
-		}
+		existInState := stateIndex.Contains(remoteResource)
 
 		// Include resource if it's managed in IaC
 		if existInState {
@@ -20,6 +20,8 @@ func NewAwsDefaultSqsQueuePolicy() AwsDefaultSqsQueuePolicy {
 
 func (m AwsDefaultSqsQueuePolicy) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
 	newRemoteResources := make([]resource.Resource, 0)
+	stateIndex := resource.NewIndex(*resourcesFromState)
+
 	for _, res := range *remoteResources {
 		// Ignore all resources other than sqs_queue_policy
 		if res.TerraformType() != aws.AwsSqsQueuePolicyResourceType {
@@ -38,13 +40,7 @@ func (m AwsDefaultSqsQueuePolicy) Execute(remoteResources, resourcesFromState *[
 		}
 
 		// Check if queue policy is managed by IaC
-		existInState := false
-		for _, stateResource := range *resourcesFromState {
-			if resource.IsSameResource(res, stateResource) {
-				existInState = true
-				break
-			}
-		}
+		existInState := stateIndex.Contains(res)
 
 		// Include resource if it's managed in IaC
 		if existInState {
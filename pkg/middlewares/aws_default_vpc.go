@@ -18,21 +18,16 @@ func (m AwsDefaultVPC) Execute(remoteResources, resourcesFromState *[]resource.R
 
 	newRemoteResources := make([]resource.Resource, 0)
 
-	for _, remoteResource := range *remoteResources {
-		existInState := false
+	stateIndex := resource.NewIndex(*resourcesFromState)
 
+	for _, remoteResource := range *remoteResources {
 		// Ignore all resources other than default VPC
 		if remoteResource.TerraformType() != aws.AwsDefaultVpcResourceType {
 			newRemoteResources = append(newRemoteResources, remoteResource)
 			continue
 		}
 
-		for _, stateResource := range *resourcesFromState {
-			if resource.IsSameResource(remoteResource, stateResource) {
-				existInState = true
-				break
-			}
-		}
+		existInState := stateIndex.Contains(remoteResource)
 
 		if existInState {
 			newRemoteResources = append(newRemoteResources, remoteResource)
@@ -38,6 +38,7 @@ func (m AwsDefaults) awsIamRoleDefaults(remoteResources []resource.Resource) []r
 
 func (m AwsDefaults) awsIamRolePolicyDefaults(remoteResources []resource.Resource) []resource.Resource {
 	resourcesToIgnore := make([]resource.Resource, 0)
+	remoteIndex := resource.NewIndex(remoteResources)
 
 	for _, remoteResource := range remoteResources {
 		// Ignore all resources other than role policy
@@ -45,14 +46,17 @@ func (m AwsDefaults) awsIamRolePolicyDefaults(remoteResources []resource.Resourc
 			continue
 		}
 
-		var role *resource.AbstractResource
-		for _, res := range remoteResources {
-			if res.TerraformType() == aws.AwsIamRoleResourceType &&
-				res.TerraformId() == (*remoteResource.(*resource.AbstractResource).Attrs)["role"] {
-				role = res.(*resource.AbstractResource)
-				break
-			}
+		roleId, _ := (*remoteResource.(*resource.AbstractResource).Attrs)["role"].(string)
+		// Roles are global, only the account of the policy tells which role it is attached to
+		res, found := remoteIndex.Find(&resource.AbstractResource{
+			Id:   roleId,
+			Type: aws.AwsIamRoleResourceType,
+			Meta: resource.Meta{Account: resource.MetadataOf(remoteResource).Account},
+		})
+		if !found {
+			continue
 		}
+		role := res.(*resource.AbstractResource)
 
 		if match := strings.HasPrefix((*role.Attrs)["path"].(string), defaultIamRolePathPrefix); match {
 			resourcesToIgnore = append(resourcesToIgnore, remoteResource)
@@ -69,18 +73,10 @@ func (m AwsDefaults) Execute(remoteResources, resourcesFromState *[]resource.Res
 
 	resourcesToIgnore = append(resourcesToIgnore, m.awsIamRoleDefaults(*remoteResources)...)
 	resourcesToIgnore = append(resourcesToIgnore, m.awsIamRolePolicyDefaults(*remoteResources)...)
+	ignoreIndex := resource.NewIndex(resourcesToIgnore)
 
 	for _, res := range *remoteResources {
-		ignored := false
-
-		for _, resourceToIgnore := range resourcesToIgnore {
-			if resource.IsSameResource(res, resourceToIgnore) {
-				ignored = true
-				break
-			}
-		}
-
-		if !ignored {
+		if !ignoreIndex.Contains(res) {
 			newRemoteResources = append(newRemoteResources, res)
 			continue
 		}
@@ -92,16 +88,7 @@ func (m AwsDefaults) Execute(remoteResources, resourcesFromState *[]resource.Res
 	}
 
 	for _, res := range *resourcesFromState {
-		ignored := false
-
-		for _, resourceToIgnore := range resourcesToIgnore {
-			if resource.IsSameResource(res, resourceToIgnore) {
-				ignored = true
-				break
-			}
-		}
-
-		if !ignored {
+		if !ignoreIndex.Contains(res) {
 			newResourcesFromState = append(newResourcesFromState, res)
 			continue
 		}
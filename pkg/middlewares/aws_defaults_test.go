@@ -321,3 +321,48 @@ func TestAwsDefaults_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestAwsDefaults_Execute_RolePoliciesOfSeveralAccounts(t *testing.T) {
+	managedRole := &resource.AbstractResource{
+		Id:    "admin",
+		Type:  aws.AwsIamRoleResourceType,
+		Attrs: &resource.Attributes{"path": "/"},
+		Meta:  resource.Meta{Account: "111111111111"},
+	}
+	defaultRole := &resource.AbstractResource{
+		Id:    "admin",
+		Type:  aws.AwsIamRoleResourceType,
+		Attrs: &resource.Attributes{"path": "/aws-service-role/sso.amazonaws.com"},
+		Meta:  resource.Meta{Account: "222222222222"},
+	}
+	managedPolicy := &resource.AbstractResource{
+		Id:    "admin:policy",
+		Type:  aws.AwsIamRolePolicyResourceType,
+		Attrs: &resource.Attributes{"role": "admin"},
+		Meta:  resource.Meta{Account: "111111111111"},
+	}
+	defaultPolicy := &resource.AbstractResource{
+		Id:    "admin:policy",
+		Type:  aws.AwsIamRolePolicyResourceType,
+		Attrs: &resource.Attributes{"role": "admin"},
+		Meta:  resource.Meta{Account: "222222222222"},
+	}
+
+	remoteResources := []resource.Resource{managedRole, defaultRole, managedPolicy, defaultPolicy}
+	resourcesFromState := []resource.Resource{}
+
+	m := NewAwsDefaults()
+	if err := m.Execute(&remoteResources, &resourcesFromState); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []resource.Resource{managedRole, managedPolicy}
+	if len(remoteResources) != len(expected) {
+		t.Fatalf("Got %d resources, expected %d", len(remoteResources), len(expected))
+	}
+	for i := range expected {
+		if remoteResources[i] != expected[i] {
+			t.Errorf("Got %+v at %d, expected %+v", remoteResources[i], i, expected[i])
+		}
+	}
+}
@@ -19,9 +19,9 @@ func (m Route53DefaultZoneRecordSanitizer) Execute(remoteResources, resourcesFro
 
 	// We iterate on remote resource and adding them to a new slice except for default records
 	// added by aws in the zone at creation
-	for _, remoteResource := range *remoteResources {
-		existInState := false
+	stateIndex := resource.NewIndex(*resourcesFromState)
 
+	for _, remoteResource := range *remoteResources {
 		// Ignore all resources other than route53 records
 		if remoteResource.TerraformType() != aws.AwsRoute53RecordResourceType {
 			newRemoteResources = append(newRemoteResources, remoteResource)
@@ -35,12 +35,7 @@ func (m Route53DefaultZoneRecordSanitizer) Execute(remoteResources, resourcesFro
 			continue
 		}
 
-		for _, stateResource := range *resourcesFromState {
-			if resource.IsSameResource(remoteResource, stateResource) {
-				existInState = true
-				break
-			}
-		}
+		existInState := stateIndex.Contains(remoteResource)
 
 		if existInState {
 			newRemoteResources = append(newRemoteResources, remoteResource)
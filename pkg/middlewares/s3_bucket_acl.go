@@ -16,6 +16,8 @@ func NewS3BucketAcl() S3BucketAcl {
 
 func (m S3BucketAcl) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
 
+	remoteIndex := resource.NewIndex(*remoteResources)
+
 	for _, iacResource := range *resourcesFromState {
 		// Ignore all resources other than s3 buckets
 		if iacResource.TerraformType() != aws.AwsS3BucketResourceType {
@@ -24,21 +26,15 @@ func (m S3BucketAcl) Execute(remoteResources, resourcesFromState *[]resource.Res
 
 		decodedIacResource, _ := iacResource.(*resource.AbstractResource)
 
-		for _, remoteResource := range *remoteResources {
-			if resource.IsSameResource(remoteResource, decodedIacResource) {
-				decodedRemoteResource, _ := remoteResource.(*resource.AbstractResource)
-				aclAttr, exist := decodedIacResource.Attrs.Get("acl")
-				if !exist || aclAttr == nil || aclAttr == "" {
-					break
-				}
-				if aclAttr != "private" {
-					logrus.WithFields(logrus.Fields{
-						"type": decodedRemoteResource.TerraformType(),
-						"id":   decodedRemoteResource.TerraformId(),
-					}).Debug("Found a resource to update")
-					decodedRemoteResource.Attrs.SafeDelete([]string{"grant"})
-				}
-				break
+		if remoteResource, found := remoteIndex.Find(decodedIacResource); found {
+			decodedRemoteResource, _ := remoteResource.(*resource.AbstractResource)
+			aclAttr, exist := decodedIacResource.Attrs.Get("acl")
+			if exist && aclAttr != nil && aclAttr != "" && aclAttr != "private" {
+				logrus.WithFields(logrus.Fields{
+					"type": decodedRemoteResource.TerraformType(),
+					"id":   decodedRemoteResource.TerraformId(),
+				}).Debug("Found a resource to update")
+				decodedRemoteResource.Attrs.SafeDelete([]string{"grant"})
 			}
 		}
 
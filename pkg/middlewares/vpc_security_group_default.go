@@ -16,21 +16,16 @@ func NewVPCDefaultSecurityGroupSanitizer() VPCDefaultSecurityGroupSanitizer {
 func (m VPCDefaultSecurityGroupSanitizer) Execute(remoteResources, resourcesFromState *[]resource.Resource) error {
 	newRemoteResources := make([]resource.Resource, 0)
 
-	for _, remoteResource := range *remoteResources {
-		existInState := false
+	stateIndex := resource.NewIndex(*resourcesFromState)
 
+	for _, remoteResource := range *remoteResources {
 		// Ignore all resources other than default security group
 		if remoteResource.TerraformType() != aws.AwsDefaultSecurityGroupResourceType {
 			newRemoteResources = append(newRemoteResources, remoteResource)
 			continue
 		}
 
-		for _, stateResource := range *resourcesFromState {
-			if resource.IsSameResource(remoteResource, stateResource) {
-				existInState = true
-				break
-			}
-		}
+		existInState := stateIndex.Contains(remoteResource)
 
 		if existInState {
 			newRemoteResources = append(newRemoteResources, remoteResource)
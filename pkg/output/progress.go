@@ -141,3 +141,20 @@ func (p *progress) printf(format string, args ...interface{}) {
 	p.flush()
 	Printf(txt)
 }
+
+// VoidProgress does not render anything, it is used when nobody is watching the scan
+type VoidProgress struct {
+	count atomic.Uint64
+}
+
+func (v *VoidProgress) Start() {}
+
+func (v *VoidProgress) Stop() {}
+
+func (v *VoidProgress) Inc() {
+	v.count.Inc()
+}
+
+func (v *VoidProgress) Val() uint64 {
+	return v.count.Load()
+}
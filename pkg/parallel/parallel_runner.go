@@ -19,8 +19,9 @@ type ParallelRunner struct {
 	ctx     context.Context
 	cancel  context.CancelFunc
 	resChan chan interface{}
+	errLock sync.Mutex
+	// err is the error the runner was stopped with, it is set once
 	err     error
-	hasErr  *atomic.Bool
 	waiting *atomic.Bool
 }
 
@@ -32,8 +33,6 @@ func NewParallelRunner(ctx context.Context, maxRun int64) *ParallelRunner {
 		ctx:     ctx,
 		cancel:  cancelFunc,
 		resChan: make(chan interface{}),
-		err:     nil,
-		hasErr:  atomic.NewBool(false),
 		waiting: atomic.NewBool(false),
 	}
 }
@@ -46,8 +45,6 @@ func (p *ParallelRunner) SubRunner() *ParallelRunner {
 		ctx:     ctx,
 		cancel:  cancelFunc,
 		resChan: make(chan interface{}),
-		err:     nil,
-		hasErr:  atomic.NewBool(false),
 		waiting: atomic.NewBool(false),
 	}
 }
@@ -61,8 +58,14 @@ func (p *ParallelRunner) DoneChan() <-chan struct{} {
 	return p.ctx.Done()
 }
 
+// Err returns the error the runner was stopped with, or why its parent context is done
 func (p *ParallelRunner) Err() error {
-	return p.err
+	p.errLock.Lock()
+	defer p.errLock.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	return p.ctx.Err()
 }
 
 func (p *ParallelRunner) wait() {
@@ -98,14 +101,22 @@ func (p *ParallelRunner) Run(runnable func() (interface{}, error)) {
 		if err != nil {
 			p.Stop(err)
 		}
-		p.resChan <- res
+		// Nobody reads results anymore once the runner is done
+		select {
+		case p.resChan <- res:
+		case <-p.ctx.Done():
+		}
 	}()
 }
 
 func (p *ParallelRunner) Stop(err error) {
-	if !p.hasErr.Swap(true) {
-		logrus.Debug("Stopping ParallelRunner")
-		p.err = err
-		p.cancel()
+	p.errLock.Lock()
+	defer p.errLock.Unlock()
+	if p.err != nil {
+		return
 	}
+	logrus.Debug("Stopping ParallelRunner")
+	p.err = err
+	// The error is set before the context is cancelled, so that it is returned as soon as the runner is done
+	p.cancel()
 }
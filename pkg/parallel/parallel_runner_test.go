@@ -72,3 +72,39 @@ Loop:
 	assert.Equal(err, runner.Err())
 	assert.Less(val, 100)
 }
+
+func TestParallelRunner_ParentContextCancelled(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runner := NewParallelRunner(ctx, 10)
+
+	block := make(chan struct{})
+	defer close(block)
+	runner.Run(func() (interface{}, error) {
+		<-block
+		return 1, nil
+	})
+	cancel()
+
+	<-runner.DoneChan()
+	assert.Equal(context.Canceled, runner.Err())
+}
+
+func TestParallelRunner_ErrOnceDone(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		runner := NewParallelRunner(context.Background(), 2)
+		runner.Run(func() (interface{}, error) {
+			return nil, errors.New("first")
+		})
+		runner.Run(func() (interface{}, error) {
+			return nil, errors.New("second")
+		})
+
+		// The error is known as soon as the runner is done
+		<-runner.DoneChan()
+		err := runner.Err()
+		assert.NotNil(t, err)
+		assert.Contains(t, []string{"first", "second"}, err.Error())
+	}
+}
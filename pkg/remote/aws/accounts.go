@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+	"github.com/cloudskiff/driftctl/pkg/remote/cache"
+	"github.com/cloudskiff/driftctl/pkg/remote/retry"
+)
+
+// Options tells which regions and accounts are scanned
+type Options struct {
+	Regions []string
+	// Role ARNs assumed to scan other accounts
+	AssumeRoles          []string
+	AssumeRoleExternalId string
+	// Name of the role assumed in every active account of the organization
+	OrganizationRole string
+	// Maximum number of resources read at the same time from each account
+	Concurrency int64
+	Retry       retry.Options
+	// Saves resources listed and read on disk, per account and region
+	Cache cache.DiskOptions
+}
+
+// MultiAccount returns true when resources are read from other accounts than the one of the session
+func (o Options) MultiAccount() bool {
+	return len(o.AssumeRoles) > 0 || o.OrganizationRole != ""
+}
+
+// Account is an AWS account to scan, RoleArn is empty when the account is
+// read with the credentials of the session
+type Account struct {
+	Id      string
+	RoleArn string
+}
+
+// ResolveAccounts returns accounts to scan from assumed roles and accounts of the organization.
+// callerAccountId is the account of the session, it is read without assuming any role.
+func ResolveAccounts(ctx context.Context, opts Options, callerAccountId string, repo repository.OrganizationsRepository) ([]Account, error) {
+	accounts := make([]Account, 0, len(opts.AssumeRoles))
+	seen := make(map[string]struct{}, len(opts.AssumeRoles))
+	add := func(account Account) {
+		if _, exist := seen[account.Id]; exist {
+			logrus.WithFields(logrus.Fields{
+				"account": account.Id,
+			}).Debug("Account already scanned, skipping")
+			return
+		}
+		seen[account.Id] = struct{}{}
+		accounts = append(accounts, account)
+	}
+
+	for _, roleArn := range opts.AssumeRoles {
+		parsed, err := arn.Parse(roleArn)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid role ARN %s", roleArn)
+		}
+		if parsed.AccountID == callerAccountId {
+			add(Account{Id: callerAccountId})
+			continue
+		}
+		add(Account{Id: parsed.AccountID, RoleArn: roleArn})
+	}
+
+	if opts.OrganizationRole == "" {
+		return accounts, nil
+	}
+
+	orgAccounts, err := repo.ListAllAccounts(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list accounts of the organization")
+	}
+	for _, account := range orgAccounts {
+		if account.Status == nil || *account.Status != organizations.AccountStatusActive {
+			logrus.WithFields(logrus.Fields{
+				"account": *account.Id,
+			}).Debug("Skipping inactive account of the organization")
+			continue
+		}
+		if *account.Id == callerAccountId {
+			add(Account{Id: callerAccountId})
+			continue
+		}
+		partition := "aws"
+		if account.Arn != nil {
+			if parsed, err := arn.Parse(*account.Arn); err == nil {
+				partition = parsed.Partition
+			}
+		}
+		add(Account{
+			Id:      *account.Id,
+			RoleArn: fmt.Sprintf("arn:%s:iam::%s:role/%s", partition, *account.Id, opts.OrganizationRole),
+		})
+	}
+	return accounts, nil
+}
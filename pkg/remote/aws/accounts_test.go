@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
+)
+
+func TestResolveAccounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    Options
+		mocks   func(repo *repository.MockOrganizationsRepository)
+		want    []Account
+		wantErr string
+	}{
+		{
+			name: "assume roles",
+			opts: Options{AssumeRoles: []string{
+				"arn:aws:iam::111111111111:role/driftctl",
+				"arn:aws:iam::222222222222:role/driftctl",
+				"arn:aws:iam::111111111111:role/other",
+				"arn:aws:iam::000000000000:role/driftctl",
+			}},
+			want: []Account{
+				{Id: "111111111111", RoleArn: "arn:aws:iam::111111111111:role/driftctl"},
+				{Id: "222222222222", RoleArn: "arn:aws:iam::222222222222:role/driftctl"},
+				{Id: "000000000000"},
+			},
+		},
+		{
+			name:    "invalid role",
+			opts:    Options{AssumeRoles: []string{"driftctl"}},
+			wantErr: "invalid role ARN driftctl: arn: invalid prefix",
+		},
+		{
+			name: "organization accounts",
+			opts: Options{
+				AssumeRoles:      []string{"arn:aws:iam::111111111111:role/driftctl"},
+				OrganizationRole: "OrganizationAccountAccessRole",
+			},
+			mocks: func(repo *repository.MockOrganizationsRepository) {
+				repo.On("ListAllAccounts", mock.Anything).Return([]*organizations.Account{
+					{
+						Id:     awssdk.String("000000000000"),
+						Arn:    awssdk.String("arn:aws:organizations::000000000000:account/o-abcdef/000000000000"),
+						Status: awssdk.String(organizations.AccountStatusActive),
+					},
+					{
+						Id:     awssdk.String("111111111111"),
+						Arn:    awssdk.String("arn:aws:organizations::000000000000:account/o-abcdef/111111111111"),
+						Status: awssdk.String(organizations.AccountStatusActive),
+					},
+					{
+						Id:     awssdk.String("222222222222"),
+						Arn:    awssdk.String("arn:aws-us-gov:organizations::000000000000:account/o-abcdef/222222222222"),
+						Status: awssdk.String(organizations.AccountStatusActive),
+					},
+					{
+						Id:     awssdk.String("333333333333"),
+						Arn:    awssdk.String("arn:aws:organizations::000000000000:account/o-abcdef/333333333333"),
+						Status: awssdk.String(organizations.AccountStatusSuspended),
+					},
+				}, nil)
+			},
+			want: []Account{
+				{Id: "111111111111", RoleArn: "arn:aws:iam::111111111111:role/driftctl"},
+				{Id: "000000000000"},
+				{Id: "222222222222", RoleArn: "arn:aws-us-gov:iam::222222222222:role/OrganizationAccountAccessRole"},
+			},
+		},
+		{
+			name: "cannot list organization accounts",
+			opts: Options{OrganizationRole: "OrganizationAccountAccessRole"},
+			mocks: func(repo *repository.MockOrganizationsRepository) {
+				repo.On("ListAllAccounts", mock.Anything).Return(nil, errors.New("AccessDeniedException"))
+			},
+			wantErr: "unable to list accounts of the organization: AccessDeniedException",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &repository.MockOrganizationsRepository{}
+			if tt.mocks != nil {
+				tt.mocks(repo)
+			}
+
+			got, err := ResolveAccounts(context.Background(), tt.opts, "000000000000", repo)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
@@ -27,8 +28,8 @@ func NewCloudfrontDistributionSupplier(provider *AWSTerraformProvider, deseriali
 	}
 }
 
-func (s *CloudfrontDistributionSupplier) Resources() ([]resource.Resource, error) {
-	distributions, err := s.client.ListAllDistributions()
+func (s *CloudfrontDistributionSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	distributions, err := s.client.ListAllDistributions(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsCloudfrontDistributionResourceType)
 	}
@@ -36,11 +37,11 @@ func (s *CloudfrontDistributionSupplier) Resources() ([]resource.Resource, error
 	for _, distribution := range distributions {
 		d := *distribution
 		s.runner.Run(func() (cty.Value, error) {
-			return s.readCloudfrontDistribution(d)
+			return s.readCloudfrontDistribution(ctx, d)
 		})
 	}
 
-	resources, err := s.runner.Wait()
+	resources, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -48,10 +49,11 @@ func (s *CloudfrontDistributionSupplier) Resources() ([]resource.Resource, error
 	return s.deserializer.Deserialize(aws.AwsCloudfrontDistributionResourceType, resources)
 }
 
-func (s *CloudfrontDistributionSupplier) readCloudfrontDistribution(distribution cloudfront.DistributionSummary) (cty.Value, error) {
+func (s *CloudfrontDistributionSupplier) readCloudfrontDistribution(ctx context.Context, distribution cloudfront.DistributionSummary) (cty.Value, error) {
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		ID: *distribution.Id,
-		Ty: aws.AwsCloudfrontDistributionResourceType,
+		Ctx: ctx,
+		ID:  *distribution.Id,
+		Ty:  aws.AwsCloudfrontDistributionResourceType,
 	})
 	if err != nil {
 		logrus.Error(err)
@@ -38,7 +38,7 @@ func TestCloudfrontDistributionSupplier_Resources(t *testing.T) {
 			test:    "no cloudfront distribution",
 			dirName: "cloudfront_distribution_empty",
 			mocks: func(client *mocks.CloudfrontRepository) {
-				client.On("ListAllDistributions").Return([]*cloudfront.DistributionSummary{}, nil)
+				client.On("ListAllDistributions", mock.Anything).Return([]*cloudfront.DistributionSummary{}, nil)
 			},
 			err: nil,
 		},
@@ -46,7 +46,7 @@ func TestCloudfrontDistributionSupplier_Resources(t *testing.T) {
 			test:    "one cloudfront distribution",
 			dirName: "cloudfront_distribution_one",
 			mocks: func(client *mocks.CloudfrontRepository) {
-				client.On("ListAllDistributions").Return([]*cloudfront.DistributionSummary{
+				client.On("ListAllDistributions", mock.Anything).Return([]*cloudfront.DistributionSummary{
 					{Id: aws.String("E1M9CNS0XSHI19")},
 				}, nil)
 			},
@@ -56,7 +56,7 @@ func TestCloudfrontDistributionSupplier_Resources(t *testing.T) {
 			test:    "cannot list cloudfront distributions",
 			dirName: "cloudfront_distribution_empty",
 			mocks: func(client *mocks.CloudfrontRepository) {
-				client.On("ListAllDistributions").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllDistributions", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsCloudfrontDistributionResourceType),
 		},
@@ -90,7 +90,7 @@ func TestCloudfrontDistributionSupplier_Resources(t *testing.T) {
 				&fakeCloudfront,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
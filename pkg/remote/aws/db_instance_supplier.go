@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 	"github.com/cloudskiff/driftctl/pkg/resource"
@@ -27,9 +28,9 @@ func NewDBInstanceSupplier(provider *AWSTerraformProvider, deserializer *resourc
 	}
 }
 
-func (s *DBInstanceSupplier) Resources() ([]resource.Resource, error) {
+func (s *DBInstanceSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
 
-	resourceList, err := s.client.ListAllDBInstances()
+	resourceList, err := s.client.ListAllDBInstances(ctx)
 
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsDbInstanceResourceType)
@@ -39,8 +40,9 @@ func (s *DBInstanceSupplier) Resources() ([]resource.Resource, error) {
 		id := *res.DBInstanceIdentifier
 		s.runner.Run(func() (cty.Value, error) {
 			completeResource, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-				Ty: resourceaws.AwsDbInstanceResourceType,
-				ID: id,
+				Ctx: ctx,
+				Ty:  resourceaws.AwsDbInstanceResourceType,
+				ID:  id,
 			})
 			if err != nil {
 				logrus.Warnf("Error reading %s[%s]: %+v", id, resourceaws.AwsDbInstanceResourceType, err)
@@ -50,7 +52,7 @@ func (s *DBInstanceSupplier) Resources() ([]resource.Resource, error) {
 		})
 	}
 
-	results, err := s.runner.Wait()
+	results, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
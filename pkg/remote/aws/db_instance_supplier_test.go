@@ -13,6 +13,7 @@ import (
 	resourceaws "github.com/cloudskiff/driftctl/pkg/resource/aws"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
@@ -41,7 +42,7 @@ func TestDBInstanceSupplier_Resources(t *testing.T) {
 			test:    "no dbs",
 			dirName: "db_instance_empty",
 			mocks: func(client *repository.MockRDSRepository) {
-				client.On("ListAllDBInstances").Return([]*rds.DBInstance{}, nil)
+				client.On("ListAllDBInstances", mock.Anything).Return([]*rds.DBInstance{}, nil)
 			},
 			err: nil,
 		},
@@ -49,7 +50,7 @@ func TestDBInstanceSupplier_Resources(t *testing.T) {
 			test:    "single db",
 			dirName: "db_instance_single",
 			mocks: func(client *repository.MockRDSRepository) {
-				client.On("ListAllDBInstances").Return([]*rds.DBInstance{
+				client.On("ListAllDBInstances", mock.Anything).Return([]*rds.DBInstance{
 					{
 						DBInstanceIdentifier: awssdk.String("terraform-20201015115018309600000001"),
 					},
@@ -61,7 +62,7 @@ func TestDBInstanceSupplier_Resources(t *testing.T) {
 			test:    "multiples mixed db",
 			dirName: "db_instance_multiple",
 			mocks: func(client *repository.MockRDSRepository) {
-				client.On("ListAllDBInstances").Return([]*rds.DBInstance{
+				client.On("ListAllDBInstances", mock.Anything).Return([]*rds.DBInstance{
 					{
 						DBInstanceIdentifier: awssdk.String("terraform-20201015115018309600000001"),
 					},
@@ -76,7 +77,7 @@ func TestDBInstanceSupplier_Resources(t *testing.T) {
 			test:    "multiples mixed db",
 			dirName: "db_instance_multiple",
 			mocks: func(client *repository.MockRDSRepository) {
-				client.On("ListAllDBInstances").Return([]*rds.DBInstance{
+				client.On("ListAllDBInstances", mock.Anything).Return([]*rds.DBInstance{
 					{
 						DBInstanceIdentifier: awssdk.String("terraform-20201015115018309600000001"),
 					},
@@ -91,7 +92,7 @@ func TestDBInstanceSupplier_Resources(t *testing.T) {
 			test:    "Cannot list db instances",
 			dirName: "db_instance_empty",
 			mocks: func(client *repository.MockRDSRepository) {
-				client.On("ListAllDBInstances").Return([]*rds.DBInstance{}, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllDBInstances", mock.Anything).Return([]*rds.DBInstance{}, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsDbInstanceResourceType),
 		},
@@ -127,7 +128,7 @@ func TestDBInstanceSupplier_Resources(t *testing.T) {
 				client,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, tt.err, err)
 
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 	"github.com/cloudskiff/driftctl/pkg/resource/aws"
@@ -31,9 +32,9 @@ func NewDBSubnetGroupSupplier(provider *AWSTerraformProvider, deserializer *reso
 	}
 }
 
-func (s *DBSubnetGroupSupplier) Resources() ([]resource.Resource, error) {
+func (s *DBSubnetGroupSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
 
-	subnetGroups, err := s.client.ListAllDbSubnetGroups()
+	subnetGroups, err := s.client.ListAllDbSubnetGroups(ctx)
 
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsDbSubnetGroupResourceType)
@@ -42,20 +43,21 @@ func (s *DBSubnetGroupSupplier) Resources() ([]resource.Resource, error) {
 	for _, subnetGroup := range subnetGroups {
 		sub := *subnetGroup
 		s.runner.Run(func() (cty.Value, error) {
-			return s.readSubnetGroup(sub)
+			return s.readSubnetGroup(ctx, sub)
 		})
 	}
-	ctyValues, err := s.runner.Wait()
+	ctyValues, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
 	return s.deserializer.Deserialize(aws.AwsDbSubnetGroupResourceType, ctyValues)
 }
 
-func (s *DBSubnetGroupSupplier) readSubnetGroup(subnetGroup rds.DBSubnetGroup) (cty.Value, error) {
+func (s *DBSubnetGroupSupplier) readSubnetGroup(ctx context.Context, subnetGroup rds.DBSubnetGroup) (cty.Value, error) {
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		ID: *subnetGroup.DBSubnetGroupName,
-		Ty: aws.AwsDbSubnetGroupResourceType,
+		Ctx: ctx,
+		ID:  *subnetGroup.DBSubnetGroupName,
+		Ty:  aws.AwsDbSubnetGroupResourceType,
 	})
 	if err != nil {
 		logrus.Error(err)
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/cloudskiff/driftctl/pkg/parallel"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/cloudskiff/driftctl/test/goldenfile"
 
@@ -40,7 +41,7 @@ func TestDBSubnetGroupSupplier_Resources(t *testing.T) {
 			test:    "no subnets",
 			dirName: "db_subnet_empty",
 			mocks: func(client *repository.MockRDSRepository) {
-				client.On("ListAllDbSubnetGroups").Return([]*rds.DBSubnetGroup{}, nil)
+				client.On("ListAllDbSubnetGroups", mock.Anything).Return([]*rds.DBSubnetGroup{}, nil)
 			},
 			err: nil,
 		},
@@ -48,7 +49,7 @@ func TestDBSubnetGroupSupplier_Resources(t *testing.T) {
 			test:    "multiples db subnets",
 			dirName: "db_subnet_multiples",
 			mocks: func(client *repository.MockRDSRepository) {
-				client.On("ListAllDbSubnetGroups").Return([]*rds.DBSubnetGroup{
+				client.On("ListAllDbSubnetGroups", mock.Anything).Return([]*rds.DBSubnetGroup{
 					{
 						DBSubnetGroupName: aws.String("foo"),
 					},
@@ -63,7 +64,7 @@ func TestDBSubnetGroupSupplier_Resources(t *testing.T) {
 			test:    "Cannot list subnet",
 			dirName: "db_subnet_empty",
 			mocks: func(client *repository.MockRDSRepository) {
-				client.On("ListAllDbSubnetGroups").Return([]*rds.DBSubnetGroup{}, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllDbSubnetGroups", mock.Anything).Return([]*rds.DBSubnetGroup{}, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsDbSubnetGroupResourceType),
 		},
@@ -99,7 +100,7 @@ func TestDBSubnetGroupSupplier_Resources(t *testing.T) {
 				client,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, tt.err, err)
 
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
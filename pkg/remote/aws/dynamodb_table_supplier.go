@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 	"github.com/sirupsen/logrus"
@@ -28,8 +29,8 @@ func NewDynamoDBTableSupplier(provider *AWSTerraformProvider, deserializer *reso
 	}
 }
 
-func (s *DynamoDBTableSupplier) Resources() ([]resource.Resource, error) {
-	tables, err := s.repository.ListAllTables()
+func (s *DynamoDBTableSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	tables, err := s.repository.ListAllTables(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsDynamodbTableResourceType)
 	}
@@ -37,11 +38,11 @@ func (s *DynamoDBTableSupplier) Resources() ([]resource.Resource, error) {
 	for _, table := range tables {
 		table := table
 		s.runner.Run(func() (cty.Value, error) {
-			return s.readTable(table)
+			return s.readTable(ctx, table)
 		})
 	}
 
-	retrieve, err := s.runner.Wait()
+	retrieve, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -49,10 +50,11 @@ func (s *DynamoDBTableSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(aws.AwsDynamodbTableResourceType, retrieve)
 }
 
-func (s *DynamoDBTableSupplier) readTable(tableName *string) (cty.Value, error) {
+func (s *DynamoDBTableSupplier) readTable(ctx context.Context, tableName *string) (cty.Value, error) {
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		ID: *tableName,
-		Ty: aws.AwsDynamodbTableResourceType,
+		Ctx: ctx,
+		ID:  *tableName,
+		Ty:  aws.AwsDynamodbTableResourceType,
 		Attributes: map[string]string{
 			"table_name": *tableName,
 		},
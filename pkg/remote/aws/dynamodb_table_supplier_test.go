@@ -41,7 +41,7 @@ func TestDynamoDBTableSupplier_Resources(t *testing.T) {
 			test:    "no DynamoDB Table",
 			dirName: "dynamodb_table_empty",
 			mocks: func(client *mocks.DynamoDBRepository) {
-				client.On("ListAllTables").Return([]*string{}, nil)
+				client.On("ListAllTables", mock.Anything).Return([]*string{}, nil)
 			},
 			err: nil,
 		},
@@ -49,7 +49,7 @@ func TestDynamoDBTableSupplier_Resources(t *testing.T) {
 			test:    "Multiple DynamoDB Table",
 			dirName: "dynamodb_table_multiple",
 			mocks: func(client *mocks.DynamoDBRepository) {
-				client.On("ListAllTables").Return([]*string{
+				client.On("ListAllTables", mock.Anything).Return([]*string{
 					aws.String("GameScores"),
 					aws.String("example"),
 				}, nil)
@@ -60,7 +60,7 @@ func TestDynamoDBTableSupplier_Resources(t *testing.T) {
 			test:    "cannot list DynamoDB Table",
 			dirName: "dynamodb_table_list",
 			mocks: func(client *mocks.DynamoDBRepository) {
-				client.On("ListAllTables").Return(nil, awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 400, ""))
+				client.On("ListAllTables", mock.Anything).Return(nil, awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 400, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 400, ""), resourceaws.AwsDynamodbTableResourceType),
 		},
@@ -96,7 +96,7 @@ func TestDynamoDBTableSupplier_Resources(t *testing.T) {
 				&fakeClient,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 
@@ -30,8 +31,8 @@ func NewEC2AmiSupplier(provider *AWSTerraformProvider, deserializer *resource.De
 	}
 }
 
-func (s *EC2AmiSupplier) Resources() ([]resource.Resource, error) {
-	images, err := s.client.ListAllImages()
+func (s *EC2AmiSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	images, err := s.client.ListAllImages(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsAmiResourceType)
 	}
@@ -40,10 +41,10 @@ func (s *EC2AmiSupplier) Resources() ([]resource.Resource, error) {
 		for _, image := range images {
 			id := aws.StringValue(image.ImageId)
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readAMI(id)
+				return s.readAMI(ctx, id)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -51,10 +52,11 @@ func (s *EC2AmiSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(resourceaws.AwsAmiResourceType, results)
 }
 
-func (s *EC2AmiSupplier) readAMI(id string) (cty.Value, error) {
+func (s *EC2AmiSupplier) readAMI(ctx context.Context, id string) (cty.Value, error) {
 	resImage, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		Ty: resourceaws.AwsAmiResourceType,
-		ID: id,
+		Ctx: ctx,
+		Ty:  resourceaws.AwsAmiResourceType,
+		ID:  id,
 	})
 	if err != nil {
 		logrus.Warnf("Error reading image %s[%s]: %+v", id, resourceaws.AwsAmiResourceType, err)
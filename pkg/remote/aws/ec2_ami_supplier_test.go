@@ -19,6 +19,7 @@ import (
 	"github.com/cloudskiff/driftctl/pkg/parallel"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/cloudskiff/driftctl/test/goldenfile"
 
@@ -32,22 +33,22 @@ func TestEC2AmiSupplier_Resources(t *testing.T) {
 	tests := []struct {
 		test    string
 		dirName string
-		mock    func(mock *repository.MockEC2Repository)
+		mock    func(repo *repository.MockEC2Repository)
 		err     error
 	}{
 		{
 			test:    "no amis",
 			dirName: "ec2_ami_empty",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllImages").Return([]*ec2.Image{}, nil)
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllImages", mock.Anything).Return([]*ec2.Image{}, nil)
 			},
 			err: nil,
 		},
 		{
 			test:    "with amis",
 			dirName: "ec2_ami_multiple",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllImages").Return([]*ec2.Image{
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllImages", mock.Anything).Return([]*ec2.Image{
 					{ImageId: aws.String("ami-03a578b46f4c3081b")},
 					{ImageId: aws.String("ami-025962fd8b456731f")},
 				}, nil)
@@ -57,8 +58,8 @@ func TestEC2AmiSupplier_Resources(t *testing.T) {
 		{
 			test:    "cannot list amis",
 			dirName: "ec2_ami_empty",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllImages").Return([]*ec2.Image{}, awserr.NewRequestFailure(nil, 403, ""))
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllImages", mock.Anything).Return([]*ec2.Image{}, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsAmiResourceType),
 		},
@@ -92,7 +93,7 @@ func TestEC2AmiSupplier_Resources(t *testing.T) {
 				client,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, tt.err, err)
 
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
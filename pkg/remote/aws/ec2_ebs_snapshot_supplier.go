@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 
@@ -31,8 +32,8 @@ func NewEC2EbsSnapshotSupplier(provider *AWSTerraformProvider, deserializer *res
 	}
 }
 
-func (s *EC2EbsSnapshotSupplier) Resources() ([]resource.Resource, error) {
-	snapshots, err := s.client.ListAllSnapshots()
+func (s *EC2EbsSnapshotSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	snapshots, err := s.client.ListAllSnapshots(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsEbsSnapshotResourceType)
 	}
@@ -41,10 +42,10 @@ func (s *EC2EbsSnapshotSupplier) Resources() ([]resource.Resource, error) {
 		for _, snapshot := range snapshots {
 			snap := *snapshot
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readEbsSnapshot(snap)
+				return s.readEbsSnapshot(ctx, snap)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -52,11 +53,12 @@ func (s *EC2EbsSnapshotSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(resourceaws.AwsEbsSnapshotResourceType, results)
 }
 
-func (s *EC2EbsSnapshotSupplier) readEbsSnapshot(snapshot ec2.Snapshot) (cty.Value, error) {
+func (s *EC2EbsSnapshotSupplier) readEbsSnapshot(ctx context.Context, snapshot ec2.Snapshot) (cty.Value, error) {
 	id := aws.StringValue(snapshot.SnapshotId)
 	resSnapshot, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		Ty: resourceaws.AwsEbsSnapshotResourceType,
-		ID: id,
+		Ctx: ctx,
+		Ty:  resourceaws.AwsEbsSnapshotResourceType,
+		ID:  id,
 	})
 	if err != nil {
 		logrus.Warnf("Error reading snapshot %s[%s]: %+v", id, resourceaws.AwsEbsSnapshotResourceType, err)
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/cloudskiff/driftctl/pkg/parallel"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/cloudskiff/driftctl/test/goldenfile"
 
@@ -31,22 +32,22 @@ func TestEC2EbsSnapshotSupplier_Resources(t *testing.T) {
 	tests := []struct {
 		test    string
 		dirName string
-		mock    func(mock *repository.MockEC2Repository)
+		mock    func(repo *repository.MockEC2Repository)
 		err     error
 	}{
 		{
 			test:    "no snapshots",
 			dirName: "ec2_ebs_snapshot_empty",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllSnapshots").Return([]*ec2.Snapshot{}, nil)
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllSnapshots", mock.Anything).Return([]*ec2.Snapshot{}, nil)
 			},
 			err: nil,
 		},
 		{
 			test:    "with snapshots",
 			dirName: "ec2_ebs_snapshot_multiple",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllSnapshots").Return([]*ec2.Snapshot{
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllSnapshots", mock.Anything).Return([]*ec2.Snapshot{
 					{
 						SnapshotId: aws.String("snap-0c509a2a880d95a39"),
 					},
@@ -60,8 +61,8 @@ func TestEC2EbsSnapshotSupplier_Resources(t *testing.T) {
 		{
 			test:    "cannot list snapshots",
 			dirName: "ec2_ebs_snapshot_empty",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllSnapshots").Return([]*ec2.Snapshot{}, awserr.NewRequestFailure(nil, 403, ""))
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllSnapshots", mock.Anything).Return([]*ec2.Snapshot{}, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEbsSnapshotResourceType),
 		},
@@ -97,7 +98,7 @@ func TestEC2EbsSnapshotSupplier_Resources(t *testing.T) {
 				client,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, tt.err, err)
 
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
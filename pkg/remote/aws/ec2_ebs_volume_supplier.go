@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 
@@ -31,8 +32,8 @@ func NewEC2EbsVolumeSupplier(provider *AWSTerraformProvider, deserializer *resou
 	}
 }
 
-func (s *EC2EbsVolumeSupplier) Resources() ([]resource.Resource, error) {
-	volumes, err := s.client.ListAllVolumes()
+func (s *EC2EbsVolumeSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	volumes, err := s.client.ListAllVolumes(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsEbsVolumeResourceType)
 	}
@@ -41,10 +42,10 @@ func (s *EC2EbsVolumeSupplier) Resources() ([]resource.Resource, error) {
 		for _, volume := range volumes {
 			vol := *volume
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readEbsVolume(vol)
+				return s.readEbsVolume(ctx, vol)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -52,11 +53,12 @@ func (s *EC2EbsVolumeSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(resourceaws.AwsEbsVolumeResourceType, results)
 }
 
-func (s *EC2EbsVolumeSupplier) readEbsVolume(volume ec2.Volume) (cty.Value, error) {
+func (s *EC2EbsVolumeSupplier) readEbsVolume(ctx context.Context, volume ec2.Volume) (cty.Value, error) {
 	id := aws.StringValue(volume.VolumeId)
 	resVolume, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		Ty: resourceaws.AwsEbsVolumeResourceType,
-		ID: id,
+		Ctx: ctx,
+		Ty:  resourceaws.AwsEbsVolumeResourceType,
+		ID:  id,
 	})
 	if err != nil {
 		logrus.Warnf("Error reading volume %s[%s]: %+v", id, resourceaws.AwsEbsVolumeResourceType, err)
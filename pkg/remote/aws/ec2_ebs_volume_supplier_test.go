@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/cloudskiff/driftctl/pkg/parallel"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/cloudskiff/driftctl/test/goldenfile"
 
@@ -31,22 +32,22 @@ func TestEC2EbsVolumeSupplier_Resources(t *testing.T) {
 	tests := []struct {
 		test    string
 		dirName string
-		mock    func(mock *repository.MockEC2Repository)
+		mock    func(repo *repository.MockEC2Repository)
 		err     error
 	}{
 		{
 			test:    "no volumes",
 			dirName: "ec2_ebs_volume_empty",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllVolumes").Return([]*ec2.Volume{}, nil)
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllVolumes", mock.Anything).Return([]*ec2.Volume{}, nil)
 			},
 			err: nil,
 		},
 		{
 			test:    "with volumes",
 			dirName: "ec2_ebs_volume_multiple",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllVolumes").Return([]*ec2.Volume{
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllVolumes", mock.Anything).Return([]*ec2.Volume{
 					{
 						VolumeId: aws.String("vol-081c7272a57a09db1"),
 					},
@@ -60,8 +61,8 @@ func TestEC2EbsVolumeSupplier_Resources(t *testing.T) {
 		{
 			test:    "cannot list volumes",
 			dirName: "ec2_ebs_volume_empty",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllVolumes").Return([]*ec2.Volume{}, awserr.NewRequestFailure(nil, 403, ""))
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllVolumes", mock.Anything).Return([]*ec2.Volume{}, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEbsVolumeResourceType),
 		},
@@ -96,7 +97,7 @@ func TestEC2EbsVolumeSupplier_Resources(t *testing.T) {
 				client,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, tt.err, err)
 
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 
@@ -28,8 +29,8 @@ func NewEC2EipAssociationSupplier(provider *AWSTerraformProvider, deserializer *
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner())}
 }
 
-func (s *EC2EipAssociationSupplier) Resources() ([]resource.Resource, error) {
-	associationIds, err := s.client.ListAllAddressesAssociation()
+func (s *EC2EipAssociationSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	associationIds, err := s.client.ListAllAddressesAssociation(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsEipAssociationResourceType)
 	}
@@ -38,10 +39,10 @@ func (s *EC2EipAssociationSupplier) Resources() ([]resource.Resource, error) {
 		for _, assocId := range associationIds {
 			assocId := assocId
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readEIPAssociation(assocId)
+				return s.readEIPAssociation(ctx, assocId)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -49,10 +50,11 @@ func (s *EC2EipAssociationSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(resourceaws.AwsEipAssociationResourceType, results)
 }
 
-func (s *EC2EipAssociationSupplier) readEIPAssociation(assocId string) (cty.Value, error) {
+func (s *EC2EipAssociationSupplier) readEIPAssociation(ctx context.Context, assocId string) (cty.Value, error) {
 	resAssoc, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		Ty: resourceaws.AwsEipAssociationResourceType,
-		ID: assocId,
+		Ctx: ctx,
+		Ty:  resourceaws.AwsEipAssociationResourceType,
+		ID:  assocId,
 	})
 	if err != nil {
 		logrus.Warnf("Error reading eip association %s[%s]: %+v", assocId, resourceaws.AwsEipAssociationResourceType, err)
@@ -14,6 +14,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/cloudskiff/driftctl/pkg/parallel"
 
@@ -29,22 +30,22 @@ func TestEC2EipAssociationSupplier_Resources(t *testing.T) {
 	tests := []struct {
 		test    string
 		dirName string
-		mock    func(mock *repository.MockEC2Repository)
+		mock    func(repo *repository.MockEC2Repository)
 		err     error
 	}{
 		{
 			test:    "no eip associations",
 			dirName: "ec2_eip_association_empty",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllAddressesAssociation").Return([]string{}, nil)
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllAddressesAssociation", mock.Anything).Return([]string{}, nil)
 			},
 			err: nil,
 		},
 		{
 			test:    "with eip associations",
 			dirName: "ec2_eip_association_single",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllAddressesAssociation").Return([]string{
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllAddressesAssociation", mock.Anything).Return([]string{
 					"eipassoc-0e9a7356e30f0c3d1",
 				}, nil)
 			},
@@ -53,8 +54,8 @@ func TestEC2EipAssociationSupplier_Resources(t *testing.T) {
 		{
 			test:    "Cannot list eip associations",
 			dirName: "ec2_eip_association_empty",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllAddressesAssociation").Return([]string{}, awserr.NewRequestFailure(nil, 403, ""))
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllAddressesAssociation", mock.Anything).Return([]string{}, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEipAssociationResourceType),
 		},
@@ -88,7 +89,7 @@ func TestEC2EipAssociationSupplier_Resources(t *testing.T) {
 				client,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, tt.err, err)
 
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 
@@ -31,8 +32,8 @@ func NewEC2EipSupplier(provider *AWSTerraformProvider, repo repository.EC2Reposi
 	}
 }
 
-func (s *EC2EipSupplier) Resources() ([]resource.Resource, error) {
-	addresses, err := s.client.ListAllAddresses()
+func (s *EC2EipSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	addresses, err := s.client.ListAllAddresses(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsEipResourceType)
 	}
@@ -41,10 +42,10 @@ func (s *EC2EipSupplier) Resources() ([]resource.Resource, error) {
 		for _, address := range addresses {
 			addr := *address
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readEIP(addr)
+				return s.readEIP(ctx, addr)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -52,11 +53,12 @@ func (s *EC2EipSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(resourceaws.AwsEipResourceType, results)
 }
 
-func (s *EC2EipSupplier) readEIP(address ec2.Address) (cty.Value, error) {
+func (s *EC2EipSupplier) readEIP(ctx context.Context, address ec2.Address) (cty.Value, error) {
 	id := aws.StringValue(address.AllocationId)
 	resAddress, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		Ty: resourceaws.AwsEipResourceType,
-		ID: id,
+		Ctx: ctx,
+		Ty:  resourceaws.AwsEipResourceType,
+		ID:  id,
 	})
 	if err != nil {
 		logrus.Warnf("Error reading eip %s[%s]: %+v", id, resourceaws.AwsEipResourceType, err)
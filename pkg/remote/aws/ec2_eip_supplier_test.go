@@ -14,6 +14,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/cloudskiff/driftctl/pkg/parallel"
 
@@ -32,22 +33,22 @@ func TestEC2EipSupplier_Resources(t *testing.T) {
 	tests := []struct {
 		test    string
 		dirName string
-		mock    func(mock *repository.MockEC2Repository)
+		mock    func(repo *repository.MockEC2Repository)
 		err     error
 	}{
 		{
 			test:    "no eips",
 			dirName: "ec2_eip_empty",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllAddresses").Return([]*ec2.Address{}, nil)
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllAddresses", mock.Anything).Return([]*ec2.Address{}, nil)
 			},
 			err: nil,
 		},
 		{
 			test:    "with eips",
 			dirName: "ec2_eip_multiple",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllAddresses").Return([]*ec2.Address{
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllAddresses", mock.Anything).Return([]*ec2.Address{
 					{
 						AllocationId: aws.String("eipalloc-017d5267e4dda73f1"),
 					},
@@ -61,8 +62,8 @@ func TestEC2EipSupplier_Resources(t *testing.T) {
 		{
 			test:    "Cannot list eips",
 			dirName: "ec2_eip_empty",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllAddresses").Return([]*ec2.Address{}, awserr.NewRequestFailure(nil, 403, ""))
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllAddresses", mock.Anything).Return([]*ec2.Address{}, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEipResourceType),
 		},
@@ -96,7 +97,7 @@ func TestEC2EipSupplier_Resources(t *testing.T) {
 				client,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, tt.err, err)
 
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
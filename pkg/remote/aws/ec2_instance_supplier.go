@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 
@@ -30,8 +31,8 @@ func NewEC2InstanceSupplier(provider *AWSTerraformProvider, deserializer *resour
 	}
 }
 
-func (s *EC2InstanceSupplier) Resources() ([]resource.Resource, error) {
-	instances, err := s.client.ListAllInstances()
+func (s *EC2InstanceSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	instances, err := s.client.ListAllInstances(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsInstanceResourceType)
 	}
@@ -41,10 +42,10 @@ func (s *EC2InstanceSupplier) Resources() ([]resource.Resource, error) {
 		for _, instance := range instances {
 			id := aws.StringValue(instance.InstanceId)
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readInstance(id)
+				return s.readInstance(ctx, id)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -52,10 +53,11 @@ func (s *EC2InstanceSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(resourceaws.AwsInstanceResourceType, results)
 }
 
-func (s *EC2InstanceSupplier) readInstance(id string) (cty.Value, error) {
+func (s *EC2InstanceSupplier) readInstance(ctx context.Context, id string) (cty.Value, error) {
 	resInstance, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		Ty: resourceaws.AwsInstanceResourceType,
-		ID: id,
+		Ctx: ctx,
+		Ty:  resourceaws.AwsInstanceResourceType,
+		ID:  id,
 	})
 	if err != nil {
 		logrus.Warnf("Error reading instance %s: %+v", id, err)
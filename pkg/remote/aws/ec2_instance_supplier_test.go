@@ -14,6 +14,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/cloudskiff/driftctl/pkg/parallel"
 
@@ -32,22 +33,22 @@ func TestEC2InstanceSupplier_Resources(t *testing.T) {
 	tests := []struct {
 		test    string
 		dirName string
-		mock    func(mock *repository.MockEC2Repository)
+		mock    func(repo *repository.MockEC2Repository)
 		err     error
 	}{
 		{
 			test:    "no instances",
 			dirName: "ec2_instance_empty",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllInstances").Return([]*ec2.Instance{}, nil)
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllInstances", mock.Anything).Return([]*ec2.Instance{}, nil)
 			},
 			err: nil,
 		},
 		{
 			test:    "with instances",
 			dirName: "ec2_instance_multiple",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllInstances").Return([]*ec2.Instance{
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllInstances", mock.Anything).Return([]*ec2.Instance{
 					{
 						InstanceId: aws.String("i-0d3650a23f4e45dc0"),
 					},
@@ -61,8 +62,8 @@ func TestEC2InstanceSupplier_Resources(t *testing.T) {
 		{
 			test:    "with terminated instances",
 			dirName: "ec2_instance_terminated",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllInstances").Return([]*ec2.Instance{
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllInstances", mock.Anything).Return([]*ec2.Instance{
 					{
 						InstanceId: aws.String("i-0e1543baf4f2cd990"),
 					},
@@ -76,8 +77,8 @@ func TestEC2InstanceSupplier_Resources(t *testing.T) {
 		{
 			test:    "Cannot list instances",
 			dirName: "ec2_instance_empty",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllInstances").Return([]*ec2.Instance{}, awserr.NewRequestFailure(nil, 403, ""))
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllInstances", mock.Anything).Return([]*ec2.Instance{}, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsInstanceResourceType),
 		},
@@ -111,7 +112,7 @@ func TestEC2InstanceSupplier_Resources(t *testing.T) {
 				client,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, tt.err, err)
 
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 
@@ -30,8 +31,8 @@ func NewEC2KeyPairSupplier(provider *AWSTerraformProvider, deserializer *resourc
 	}
 }
 
-func (s *EC2KeyPairSupplier) Resources() ([]resource.Resource, error) {
-	keyPairs, err := s.client.ListAllKeyPairs()
+func (s *EC2KeyPairSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	keyPairs, err := s.client.ListAllKeyPairs(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsKeyPairResourceType)
 	}
@@ -40,10 +41,10 @@ func (s *EC2KeyPairSupplier) Resources() ([]resource.Resource, error) {
 		for _, kp := range keyPairs {
 			name := aws.StringValue(kp.KeyName)
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readKeyPair(name)
+				return s.readKeyPair(ctx, name)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -51,10 +52,11 @@ func (s *EC2KeyPairSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(resourceaws.AwsKeyPairResourceType, results)
 }
 
-func (s *EC2KeyPairSupplier) readKeyPair(name string) (cty.Value, error) {
+func (s *EC2KeyPairSupplier) readKeyPair(ctx context.Context, name string) (cty.Value, error) {
 	resKp, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		Ty: resourceaws.AwsKeyPairResourceType,
-		ID: name,
+		Ctx: ctx,
+		Ty:  resourceaws.AwsKeyPairResourceType,
+		ID:  name,
 	})
 	if err != nil {
 		logrus.Warnf("Error reading key pair %s: %+v", name, err)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/cloudskiff/driftctl/pkg/parallel"
 
@@ -30,22 +31,22 @@ func TestEC2KeyPairSupplier_Resources(t *testing.T) {
 	tests := []struct {
 		test    string
 		dirName string
-		mock    func(mock *repository.MockEC2Repository)
+		mock    func(repo *repository.MockEC2Repository)
 		err     error
 	}{
 		{
 			test:    "no key pairs",
 			dirName: "ec2_key_pair_empty",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllKeyPairs").Return([]*ec2.KeyPairInfo{}, nil)
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllKeyPairs", mock.Anything).Return([]*ec2.KeyPairInfo{}, nil)
 			},
 			err: nil,
 		},
 		{
 			test:    "with key pairs",
 			dirName: "ec2_key_pair_multiple",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllKeyPairs").Return([]*ec2.KeyPairInfo{
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllKeyPairs", mock.Anything).Return([]*ec2.KeyPairInfo{
 					{
 						KeyName: aws.String("test"),
 					},
@@ -59,8 +60,8 @@ func TestEC2KeyPairSupplier_Resources(t *testing.T) {
 		{
 			test:    "cannot list key pairs",
 			dirName: "ec2_key_pair_empty",
-			mock: func(mock *repository.MockEC2Repository) {
-				mock.On("ListAllKeyPairs").Return([]*ec2.KeyPairInfo{}, awserr.NewRequestFailure(nil, 403, ""))
+			mock: func(repo *repository.MockEC2Repository) {
+				repo.On("ListAllKeyPairs", mock.Anything).Return([]*ec2.KeyPairInfo{}, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsKeyPairResourceType),
 		},
@@ -94,7 +95,7 @@ func TestEC2KeyPairSupplier_Resources(t *testing.T) {
 				client,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, tt.err, err)
 
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
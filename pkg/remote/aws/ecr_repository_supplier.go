@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
@@ -28,8 +30,8 @@ func NewECRRepositorySupplier(provider *AWSTerraformProvider, deserializer *reso
 	}
 }
 
-func (r *ECRRepositorySupplier) Resources() ([]resource.Resource, error) {
-	repositories, err := r.client.ListAllRepositories()
+func (r *ECRRepositorySupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	repositories, err := r.client.ListAllRepositories(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsEcrRepositoryResourceType)
 	}
@@ -37,11 +39,11 @@ func (r *ECRRepositorySupplier) Resources() ([]resource.Resource, error) {
 	for _, repository := range repositories {
 		repository := repository
 		r.runner.Run(func() (cty.Value, error) {
-			return r.readRepository(repository)
+			return r.readRepository(ctx, repository)
 		})
 	}
 
-	retrieve, err := r.runner.Wait()
+	retrieve, err := r.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -49,10 +51,11 @@ func (r *ECRRepositorySupplier) Resources() ([]resource.Resource, error) {
 	return r.deserializer.Deserialize(aws.AwsEcrRepositoryResourceType, retrieve)
 }
 
-func (r *ECRRepositorySupplier) readRepository(repository *ecr.Repository) (cty.Value, error) {
+func (r *ECRRepositorySupplier) readRepository(ctx context.Context, repository *ecr.Repository) (cty.Value, error) {
 	val, err := r.reader.ReadResource(terraform.ReadResourceArgs{
-		ID: *repository.RepositoryName,
-		Ty: aws.AwsEcrRepositoryResourceType,
+		Ctx: ctx,
+		ID:  *repository.RepositoryName,
+		Ty:  aws.AwsEcrRepositoryResourceType,
 	})
 	if err != nil {
 		logrus.Error(err)
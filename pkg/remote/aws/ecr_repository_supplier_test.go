@@ -36,7 +36,7 @@ func TestEcrRepositorySupplier_Resources(t *testing.T) {
 			test:    "no repository",
 			dirName: "ecr_repository_empty",
 			mocks: func(client *repository.MockECRRepository) {
-				client.On("ListAllRepositories").Return([]*ecr.Repository{}, nil)
+				client.On("ListAllRepositories", mock.Anything).Return([]*ecr.Repository{}, nil)
 			},
 			err: nil,
 		},
@@ -44,7 +44,7 @@ func TestEcrRepositorySupplier_Resources(t *testing.T) {
 			test:    "multiple repositories",
 			dirName: "ecr_repository_multiple",
 			mocks: func(client *repository.MockECRRepository) {
-				client.On("ListAllRepositories").Return([]*ecr.Repository{
+				client.On("ListAllRepositories", mock.Anything).Return([]*ecr.Repository{
 					{RepositoryName: aws.String("test_ecr")},
 					{RepositoryName: aws.String("bar")},
 				}, nil)
@@ -55,7 +55,7 @@ func TestEcrRepositorySupplier_Resources(t *testing.T) {
 			test:    "cannot list repository",
 			dirName: "ecr_repository_empty",
 			mocks: func(client *repository.MockECRRepository) {
-				client.On("ListAllRepositories").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllRepositories", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsEcrRepositoryResourceType),
 		},
@@ -89,7 +89,7 @@ func TestEcrRepositorySupplier_Resources(t *testing.T) {
 				&fakeClient,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 			mock.AssertExpectationsForObjects(tt)
 			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
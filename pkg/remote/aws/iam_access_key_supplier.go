@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/iam"
 
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
@@ -30,12 +31,12 @@ func NewIamAccessKeySupplier(provider *AWSTerraformProvider, deserializer *resou
 	}
 }
 
-func (s *IamAccessKeySupplier) Resources() ([]resource.Resource, error) {
-	users, err := s.repo.ListAllUsers()
+func (s *IamAccessKeySupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	users, err := s.repo.ListAllUsers(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsIamAccessKeyResourceType, resourceaws.AwsIamUserResourceType)
 	}
-	keys, err := s.repo.ListAllAccessKeys(users)
+	keys, err := s.repo.ListAllAccessKeys(ctx, users)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsIamAccessKeyResourceType)
 	}
@@ -44,10 +45,10 @@ func (s *IamAccessKeySupplier) Resources() ([]resource.Resource, error) {
 		for _, key := range keys {
 			k := *key
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readAccessKey(&k)
+				return s.readAccessKey(ctx, &k)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -55,11 +56,12 @@ func (s *IamAccessKeySupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(resourceaws.AwsIamAccessKeyResourceType, results)
 }
 
-func (s *IamAccessKeySupplier) readAccessKey(key *iam.AccessKeyMetadata) (cty.Value, error) {
+func (s *IamAccessKeySupplier) readAccessKey(ctx context.Context, key *iam.AccessKeyMetadata) (cty.Value, error) {
 	res, err := s.reader.ReadResource(
 		terraform.ReadResourceArgs{
-			Ty: resourceaws.AwsIamAccessKeyResourceType,
-			ID: *key.AccessKeyId,
+			Ctx: ctx,
+			Ty:  resourceaws.AwsIamAccessKeyResourceType,
+			ID:  *key.AccessKeyId,
 			Attributes: map[string]string{
 				"user": *key.UserName,
 			},
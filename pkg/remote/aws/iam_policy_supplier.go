@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/iam"
 
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
@@ -31,8 +32,8 @@ func NewIamPolicySupplier(provider *AWSTerraformProvider, deserializer *resource
 	}
 }
 
-func (s *IamPolicySupplier) Resources() ([]resource.Resource, error) {
-	policies, err := s.repo.ListAllPolicies()
+func (s *IamPolicySupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	policies, err := s.repo.ListAllPolicies(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsIamPolicyResourceType)
 	}
@@ -41,10 +42,10 @@ func (s *IamPolicySupplier) Resources() ([]resource.Resource, error) {
 		for _, policy := range policies {
 			u := *policy
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readPolicy(&u)
+				return s.readPolicy(ctx, &u)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -52,11 +53,12 @@ func (s *IamPolicySupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(resourceaws.AwsIamPolicyResourceType, results)
 }
 
-func (s *IamPolicySupplier) readPolicy(resource *iam.Policy) (cty.Value, error) {
+func (s *IamPolicySupplier) readPolicy(ctx context.Context, resource *iam.Policy) (cty.Value, error) {
 	res, err := s.reader.ReadResource(
 		terraform.ReadResourceArgs{
-			Ty: resourceaws.AwsIamPolicyResourceType,
-			ID: *resource.Arn,
+			Ctx: ctx,
+			Ty:  resourceaws.AwsIamPolicyResourceType,
+			ID:  *resource.Arn,
 		},
 	)
 	if err != nil {
@@ -42,7 +42,7 @@ func TestIamPolicySupplier_Resources(t *testing.T) {
 			test:    "no iam custom policies",
 			dirName: "iam_policy_empty",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllPolicies").Once().Return([]*iam.Policy{}, nil)
+				repo.On("ListAllPolicies", mock.Anything).Once().Return([]*iam.Policy{}, nil)
 			},
 			err: nil,
 		},
@@ -50,7 +50,7 @@ func TestIamPolicySupplier_Resources(t *testing.T) {
 			test:    "iam multiples custom policies",
 			dirName: "iam_policy_multiple",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllPolicies").Once().Return([]*iam.Policy{
+				repo.On("ListAllPolicies", mock.Anything).Once().Return([]*iam.Policy{
 					{
 						Arn: aws.String("arn:aws:iam::929327065333:policy/policy-0"),
 					},
@@ -68,7 +68,7 @@ func TestIamPolicySupplier_Resources(t *testing.T) {
 			test:    "cannot list iam custom policies",
 			dirName: "iam_policy_empty",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllPolicies").Once().Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				repo.On("ListAllPolicies", mock.Anything).Once().Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsIamPolicyResourceType),
 		},
@@ -103,7 +103,7 @@ func TestIamPolicySupplier_Resources(t *testing.T) {
 				&fakeIam,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
@@ -31,12 +32,12 @@ func NewIamRolePolicyAttachmentSupplier(provider *AWSTerraformProvider, deserial
 	}
 }
 
-func (s *IamRolePolicyAttachmentSupplier) Resources() ([]resource.Resource, error) {
-	roles, err := s.repo.ListAllRoles()
+func (s *IamRolePolicyAttachmentSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	roles, err := s.repo.ListAllRoles(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsIamRolePolicyAttachmentResourceType, resourceaws.AwsIamRoleResourceType)
 	}
-	policyAttachments, err := s.repo.ListAllRolePolicyAttachments(roles)
+	policyAttachments, err := s.repo.ListAllRolePolicyAttachments(ctx, roles)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsIamRolePolicyAttachmentResourceType)
 	}
@@ -46,10 +47,10 @@ func (s *IamRolePolicyAttachmentSupplier) Resources() ([]resource.Resource, erro
 		for _, attachedPolicy := range policyAttachments {
 			attached := *attachedPolicy
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readRolePolicyAttachment(&attached)
+				return s.readRolePolicyAttachment(ctx, &attached)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -58,11 +59,12 @@ func (s *IamRolePolicyAttachmentSupplier) Resources() ([]resource.Resource, erro
 	return s.deserializer.Deserialize(resourceaws.AwsIamRolePolicyAttachmentResourceType, results)
 }
 
-func (s *IamRolePolicyAttachmentSupplier) readRolePolicyAttachment(attachedPol *repository.AttachedRolePolicy) (cty.Value, error) {
+func (s *IamRolePolicyAttachmentSupplier) readRolePolicyAttachment(ctx context.Context, attachedPol *repository.AttachedRolePolicy) (cty.Value, error) {
 	res, err := s.reader.ReadResource(
 		terraform.ReadResourceArgs{
-			Ty: resourceaws.AwsIamRolePolicyAttachmentResourceType,
-			ID: fmt.Sprintf("%s-%s", *attachedPol.PolicyName, attachedPol.RoleName),
+			Ctx: ctx,
+			Ty:  resourceaws.AwsIamRolePolicyAttachmentResourceType,
+			ID:  fmt.Sprintf("%s-%s", *attachedPol.PolicyName, attachedPol.RoleName),
 			Attributes: map[string]string{
 				"role":       attachedPol.RoleName,
 				"policy_arn": *attachedPol.PolicyArn,
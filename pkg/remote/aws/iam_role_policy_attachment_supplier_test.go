@@ -45,8 +45,8 @@ func TestIamRolePolicyAttachmentSupplier_Resources(t *testing.T) {
 						RoleName: aws.String("test-role"),
 					},
 				}
-				repo.On("ListAllRoles").Return(roles, nil)
-				repo.On("ListAllRolePolicyAttachments", roles).Return([]*repository.AttachedRolePolicy{}, nil)
+				repo.On("ListAllRoles", mock.Anything).Return(roles, nil)
+				repo.On("ListAllRolePolicyAttachments", mock.Anything, roles).Return([]*repository.AttachedRolePolicy{}, nil)
 			},
 			err: nil,
 		},
@@ -62,8 +62,8 @@ func TestIamRolePolicyAttachmentSupplier_Resources(t *testing.T) {
 						RoleName: aws.String("test-role2"),
 					},
 				}
-				repo.On("ListAllRoles").Return(roles, nil)
-				repo.On("ListAllRolePolicyAttachments", roles).Return([]*repository.AttachedRolePolicy{
+				repo.On("ListAllRoles", mock.Anything).Return(roles, nil)
+				repo.On("ListAllRolePolicyAttachments", mock.Anything, roles).Return([]*repository.AttachedRolePolicy{
 					{
 						AttachedPolicy: iam.AttachedPolicy{
 							PolicyArn:  aws.String("arn:aws:iam::526954929923:policy/test-policy"),
@@ -114,7 +114,7 @@ func TestIamRolePolicyAttachmentSupplier_Resources(t *testing.T) {
 			test:    "Cannot list roles",
 			dirName: "iam_role_policy_attachment_for_ignored_roles",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllRoles").Once().Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				repo.On("ListAllRoles", mock.Anything).Once().Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsIamRolePolicyAttachmentResourceType, resourceaws.AwsIamRoleResourceType),
 		},
@@ -122,7 +122,7 @@ func TestIamRolePolicyAttachmentSupplier_Resources(t *testing.T) {
 			test:    "Cannot list roles policy attachment",
 			dirName: "iam_role_policy_attachment_for_ignored_roles",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllRoles").Once().Return([]*iam.Role{}, nil)
+				repo.On("ListAllRoles", mock.Anything).Once().Return([]*iam.Role{}, nil)
 				repo.On("ListAllRolePolicyAttachments", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsIamRolePolicyAttachmentResourceType),
@@ -158,7 +158,7 @@ func TestIamRolePolicyAttachmentSupplier_Resources(t *testing.T) {
 				&fakeIam,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 1)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
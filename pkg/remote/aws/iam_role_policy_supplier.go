@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 
@@ -28,12 +29,12 @@ func NewIamRolePolicySupplier(provider *AWSTerraformProvider, deserializer *reso
 	}
 }
 
-func (s *IamRolePolicySupplier) Resources() ([]resource.Resource, error) {
-	roles, err := s.repo.ListAllRoles()
+func (s *IamRolePolicySupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	roles, err := s.repo.ListAllRoles(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsIamRolePolicyResourceType, resourceaws.AwsIamRoleResourceType)
 	}
-	policies, err := s.repo.ListAllRolePolicies(roles)
+	policies, err := s.repo.ListAllRolePolicies(ctx, roles)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsIamRolePolicyResourceType)
 	}
@@ -42,10 +43,10 @@ func (s *IamRolePolicySupplier) Resources() ([]resource.Resource, error) {
 		for _, policy := range policies {
 			p := policy
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readRolePolicy(p)
+				return s.readRolePolicy(ctx, p)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -54,11 +55,12 @@ func (s *IamRolePolicySupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(resourceaws.AwsIamRolePolicyResourceType, results)
 }
 
-func (s *IamRolePolicySupplier) readRolePolicy(policyName string) (cty.Value, error) {
+func (s *IamRolePolicySupplier) readRolePolicy(ctx context.Context, policyName string) (cty.Value, error) {
 	res, err := s.reader.ReadResource(
 		terraform.ReadResourceArgs{
-			Ty: resourceaws.AwsIamRolePolicyResourceType,
-			ID: policyName,
+			Ctx: ctx,
+			Ty:  resourceaws.AwsIamRolePolicyResourceType,
+			ID:  policyName,
 		},
 	)
 	if err != nil {
@@ -46,8 +46,8 @@ func TestIamRolePolicySupplier_Resources(t *testing.T) {
 						RoleName: aws.String("test_role"),
 					},
 				}
-				repo.On("ListAllRoles").Return(roles, nil)
-				repo.On("ListAllRolePolicies", roles).Return([]string{}, nil)
+				repo.On("ListAllRoles", mock.Anything).Return(roles, nil)
+				repo.On("ListAllRolePolicies", mock.Anything, roles).Return([]string{}, nil)
 			},
 			err: nil,
 		},
@@ -63,8 +63,8 @@ func TestIamRolePolicySupplier_Resources(t *testing.T) {
 						RoleName: aws.String("test_role_1"),
 					},
 				}
-				repo.On("ListAllRoles").Return(roles, nil)
-				repo.On("ListAllRolePolicies", roles).Return([]string{
+				repo.On("ListAllRoles", mock.Anything).Return(roles, nil)
+				repo.On("ListAllRolePolicies", mock.Anything, roles).Return([]string{
 					*aws.String("test_role_0:policy-role0-0"),
 					*aws.String("test_role_0:policy-role0-1"),
 					*aws.String("test_role_0:policy-role0-2"),
@@ -79,7 +79,7 @@ func TestIamRolePolicySupplier_Resources(t *testing.T) {
 			test:    "Cannot list roles",
 			dirName: "iam_role_policy_empty",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllRoles").Once().Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				repo.On("ListAllRoles", mock.Anything).Once().Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsIamRolePolicyResourceType, resourceaws.AwsIamRoleResourceType),
 		},
@@ -87,7 +87,7 @@ func TestIamRolePolicySupplier_Resources(t *testing.T) {
 			test:    "cannot list role policy",
 			dirName: "iam_role_policy_empty",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllRoles").Once().Return([]*iam.Role{}, nil)
+				repo.On("ListAllRoles", mock.Anything).Once().Return([]*iam.Role{}, nil)
 				repo.On("ListAllRolePolicies", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsIamRolePolicyResourceType),
@@ -123,7 +123,7 @@ func TestIamRolePolicySupplier_Resources(t *testing.T) {
 				&fakeIam,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/iam"
 
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
@@ -44,8 +45,8 @@ func awsIamRoleShouldBeIgnored(roleName string) bool {
 	return ok
 }
 
-func (s *IamRoleSupplier) Resources() ([]resource.Resource, error) {
-	roles, err := s.repo.ListAllRoles()
+func (s *IamRoleSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	roles, err := s.repo.ListAllRoles(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsIamRoleResourceType)
 	}
@@ -57,10 +58,10 @@ func (s *IamRoleSupplier) Resources() ([]resource.Resource, error) {
 				continue
 			}
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readRole(&u)
+				return s.readRole(ctx, &u)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -68,11 +69,12 @@ func (s *IamRoleSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(resourceaws.AwsIamRoleResourceType, results)
 }
 
-func (s *IamRoleSupplier) readRole(resource *iam.Role) (cty.Value, error) {
+func (s *IamRoleSupplier) readRole(ctx context.Context, resource *iam.Role) (cty.Value, error) {
 	res, err := s.reader.ReadResource(
 		terraform.ReadResourceArgs{
-			Ty: resourceaws.AwsIamRoleResourceType,
-			ID: *resource.RoleName,
+			Ctx: ctx,
+			Ty:  resourceaws.AwsIamRoleResourceType,
+			ID:  *resource.RoleName,
 		},
 	)
 	if err != nil {
@@ -42,7 +42,7 @@ func TestIamRoleSupplier_Resources(t *testing.T) {
 			test:    "no iam roles",
 			dirName: "iam_role_empty",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllRoles").Return([]*iam.Role{}, nil)
+				repo.On("ListAllRoles", mock.Anything).Return([]*iam.Role{}, nil)
 			},
 			err: nil,
 		},
@@ -50,7 +50,7 @@ func TestIamRoleSupplier_Resources(t *testing.T) {
 			test:    "iam multiples roles",
 			dirName: "iam_role_multiple",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllRoles").Return([]*iam.Role{
+				repo.On("ListAllRoles", mock.Anything).Return([]*iam.Role{
 					{
 						RoleName: aws.String("test_role_0"),
 					},
@@ -68,7 +68,7 @@ func TestIamRoleSupplier_Resources(t *testing.T) {
 			test:    "iam roles ignore services roles",
 			dirName: "iam_role_ignore_services_roles",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllRoles").Return([]*iam.Role{
+				repo.On("ListAllRoles", mock.Anything).Return([]*iam.Role{
 					{
 						RoleName: aws.String("AWSServiceRoleForOrganizations"),
 					},
@@ -86,7 +86,7 @@ func TestIamRoleSupplier_Resources(t *testing.T) {
 			test:    "cannot list iam roles",
 			dirName: "iam_role_empty",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllRoles").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				repo.On("ListAllRoles", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsIamRoleResourceType),
 		},
@@ -121,7 +121,7 @@ func TestIamRoleSupplier_Resources(t *testing.T) {
 				&fakeIam,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
@@ -31,12 +32,12 @@ func NewIamUserPolicyAttachmentSupplier(provider *AWSTerraformProvider, deserial
 	}
 }
 
-func (s *IamUserPolicyAttachmentSupplier) Resources() ([]resource.Resource, error) {
-	users, err := s.repo.ListAllUsers()
+func (s *IamUserPolicyAttachmentSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	users, err := s.repo.ListAllUsers(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsIamUserPolicyAttachmentResourceType, resourceaws.AwsIamUserResourceType)
 	}
-	policyAttachments, err := s.repo.ListAllUserPolicyAttachments(users)
+	policyAttachments, err := s.repo.ListAllUserPolicyAttachments(ctx, users)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsIamUserPolicyAttachmentResourceType)
 	}
@@ -45,10 +46,10 @@ func (s *IamUserPolicyAttachmentSupplier) Resources() ([]resource.Resource, erro
 		for _, attachedPolicy := range policyAttachments {
 			attached := *attachedPolicy
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readUserPolicyAttachment(&attached)
+				return s.readUserPolicyAttachment(ctx, &attached)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -57,11 +58,12 @@ func (s *IamUserPolicyAttachmentSupplier) Resources() ([]resource.Resource, erro
 	return s.deserializer.Deserialize(resourceaws.AwsIamUserPolicyAttachmentResourceType, results)
 }
 
-func (s *IamUserPolicyAttachmentSupplier) readUserPolicyAttachment(attachedPol *repository.AttachedUserPolicy) (cty.Value, error) {
+func (s *IamUserPolicyAttachmentSupplier) readUserPolicyAttachment(ctx context.Context, attachedPol *repository.AttachedUserPolicy) (cty.Value, error) {
 	res, err := s.reader.ReadResource(
 		terraform.ReadResourceArgs{
-			Ty: resourceaws.AwsIamUserPolicyAttachmentResourceType,
-			ID: fmt.Sprintf("%s-%s", *attachedPol.PolicyName, attachedPol.UserName),
+			Ctx: ctx,
+			Ty:  resourceaws.AwsIamUserPolicyAttachmentResourceType,
+			ID:  fmt.Sprintf("%s-%s", *attachedPol.PolicyName, attachedPol.UserName),
 			Attributes: map[string]string{
 				"user":       attachedPol.UserName,
 				"policy_arn": *attachedPol.PolicyArn,
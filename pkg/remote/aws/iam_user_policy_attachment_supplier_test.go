@@ -47,8 +47,8 @@ func TestIamUserPolicyAttachmentSupplier_Resources(t *testing.T) {
 						UserName: aws.String("loadbalancer"),
 					},
 				}
-				repo.On("ListAllUsers").Return(users, nil)
-				repo.On("ListAllUserPolicyAttachments", users).Return([]*repository.AttachedUserPolicy{}, nil)
+				repo.On("ListAllUsers", mock.Anything).Return(users, nil)
+				repo.On("ListAllUserPolicyAttachments", mock.Anything, users).Return([]*repository.AttachedUserPolicy{}, nil)
 			},
 			err: nil,
 		},
@@ -67,8 +67,8 @@ func TestIamUserPolicyAttachmentSupplier_Resources(t *testing.T) {
 						UserName: aws.String("loadbalancer3"),
 					},
 				}
-				repo.On("ListAllUsers").Return(users, nil)
-				repo.On("ListAllUserPolicyAttachments", users).Return([]*repository.AttachedUserPolicy{
+				repo.On("ListAllUsers", mock.Anything).Return(users, nil)
+				repo.On("ListAllUserPolicyAttachments", mock.Anything, users).Return([]*repository.AttachedUserPolicy{
 					{
 						AttachedPolicy: iam.AttachedPolicy{
 							PolicyArn:  aws.String("arn:aws:iam::526954929923:policy/test"),
@@ -155,7 +155,7 @@ func TestIamUserPolicyAttachmentSupplier_Resources(t *testing.T) {
 			test:    "cannot list user",
 			dirName: "iam_user_policy_empty",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllUsers").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				repo.On("ListAllUsers", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsIamUserPolicyAttachmentResourceType, resourceaws.AwsIamUserResourceType),
 		},
@@ -163,7 +163,7 @@ func TestIamUserPolicyAttachmentSupplier_Resources(t *testing.T) {
 			test:    "cannot list user policies attachment",
 			dirName: "iam_user_policy_empty",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllUsers").Once().Return([]*iam.User{}, nil)
+				repo.On("ListAllUsers", mock.Anything).Once().Return([]*iam.User{}, nil)
 				repo.On("ListAllUserPolicyAttachments", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsIamUserPolicyAttachmentResourceType),
@@ -199,7 +199,7 @@ func TestIamUserPolicyAttachmentSupplier_Resources(t *testing.T) {
 				&fakeIam,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 1)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 
@@ -28,12 +29,12 @@ func NewIamUserPolicySupplier(provider *AWSTerraformProvider, deserializer *reso
 	}
 }
 
-func (s *IamUserPolicySupplier) Resources() ([]resource.Resource, error) {
-	users, err := s.repo.ListAllUsers()
+func (s *IamUserPolicySupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	users, err := s.repo.ListAllUsers(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsIamUserPolicyResourceType, resourceaws.AwsIamUserResourceType)
 	}
-	policies, err := s.repo.ListAllUserPolicies(users)
+	policies, err := s.repo.ListAllUserPolicies(ctx, users)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsIamUserPolicyResourceType)
 	}
@@ -42,10 +43,10 @@ func (s *IamUserPolicySupplier) Resources() ([]resource.Resource, error) {
 		for _, policy := range policies {
 			p := policy
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readUserPolicy(p)
+				return s.readUserPolicy(ctx, p)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -53,11 +54,12 @@ func (s *IamUserPolicySupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(resourceaws.AwsIamUserPolicyResourceType, results)
 }
 
-func (s *IamUserPolicySupplier) readUserPolicy(policyName string) (cty.Value, error) {
+func (s *IamUserPolicySupplier) readUserPolicy(ctx context.Context, policyName string) (cty.Value, error) {
 	res, err := s.reader.ReadResource(
 		terraform.ReadResourceArgs{
-			Ty: resourceaws.AwsIamUserPolicyResourceType,
-			ID: policyName,
+			Ctx: ctx,
+			Ty:  resourceaws.AwsIamUserPolicyResourceType,
+			ID:  policyName,
 		},
 	)
 	if err != nil {
@@ -46,8 +46,8 @@ func TestIamUserPolicySupplier_Resources(t *testing.T) {
 						UserName: aws.String("loadbalancer"),
 					},
 				}
-				repo.On("ListAllUsers").Return(users, nil)
-				repo.On("ListAllUserPolicies", users).Return([]string{}, nil)
+				repo.On("ListAllUsers", mock.Anything).Return(users, nil)
+				repo.On("ListAllUserPolicies", mock.Anything, users).Return([]string{}, nil)
 			},
 			err: nil,
 		},
@@ -66,8 +66,8 @@ func TestIamUserPolicySupplier_Resources(t *testing.T) {
 						UserName: aws.String("loadbalancer3"),
 					},
 				}
-				repo.On("ListAllUsers").Return(users, nil)
-				repo.On("ListAllUserPolicies", users).Once().Return([]string{
+				repo.On("ListAllUsers", mock.Anything).Return(users, nil)
+				repo.On("ListAllUserPolicies", mock.Anything, users).Once().Return([]string{
 					*aws.String("loadbalancer:test"),
 					*aws.String("loadbalancer:test2"),
 					*aws.String("loadbalancer:test3"),
@@ -88,7 +88,7 @@ func TestIamUserPolicySupplier_Resources(t *testing.T) {
 			test:    "cannot list user",
 			dirName: "iam_user_policy_empty",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllUsers").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				repo.On("ListAllUsers", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsIamUserPolicyResourceType, resourceaws.AwsIamUserResourceType),
 		},
@@ -96,7 +96,7 @@ func TestIamUserPolicySupplier_Resources(t *testing.T) {
 			test:    "cannot list user policy",
 			dirName: "iam_user_policy_empty",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllUsers").Once().Return([]*iam.User{}, nil)
+				repo.On("ListAllUsers", mock.Anything).Once().Return([]*iam.User{}, nil)
 				repo.On("ListAllUserPolicies", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsIamUserPolicyResourceType),
@@ -132,7 +132,7 @@ func TestIamUserPolicySupplier_Resources(t *testing.T) {
 				&fakeIam,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/iam"
 
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
@@ -30,8 +31,8 @@ func NewIamUserSupplier(provider *AWSTerraformProvider, deserializer *resource.D
 	}
 }
 
-func (s *IamUserSupplier) Resources() ([]resource.Resource, error) {
-	users, err := s.repo.ListAllUsers()
+func (s *IamUserSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	users, err := s.repo.ListAllUsers(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsIamUserResourceType)
 	}
@@ -40,10 +41,10 @@ func (s *IamUserSupplier) Resources() ([]resource.Resource, error) {
 		for _, user := range users {
 			u := *user
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readUser(&u)
+				return s.readUser(ctx, &u)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -51,11 +52,12 @@ func (s *IamUserSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(resourceaws.AwsIamUserResourceType, results)
 }
 
-func (s *IamUserSupplier) readUser(user *iam.User) (cty.Value, error) {
+func (s *IamUserSupplier) readUser(ctx context.Context, user *iam.User) (cty.Value, error) {
 	res, err := s.reader.ReadResource(
 		terraform.ReadResourceArgs{
-			Ty: resourceaws.AwsIamUserResourceType,
-			ID: *user.UserName,
+			Ctx: ctx,
+			Ty:  resourceaws.AwsIamUserResourceType,
+			ID:  *user.UserName,
 		},
 	)
 	if err != nil {
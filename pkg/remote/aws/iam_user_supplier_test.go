@@ -43,7 +43,7 @@ func TestIamUserSupplier_Resources(t *testing.T) {
 			test:    "no iam user",
 			dirName: "iam_user_empty",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllUsers").Return([]*iam.User{}, nil)
+				repo.On("ListAllUsers", mock.Anything).Return([]*iam.User{}, nil)
 			},
 			err: nil,
 		},
@@ -51,7 +51,7 @@ func TestIamUserSupplier_Resources(t *testing.T) {
 			test:    "iam multiples users",
 			dirName: "iam_user_multiple",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllUsers").Return([]*iam.User{
+				repo.On("ListAllUsers", mock.Anything).Return([]*iam.User{
 					{
 						UserName: aws.String("test-driftctl-0"),
 					},
@@ -69,7 +69,7 @@ func TestIamUserSupplier_Resources(t *testing.T) {
 			test:    "cannot list iam user",
 			dirName: "iam_user_empty",
 			mocks: func(repo *repository.MockIAMRepository) {
-				repo.On("ListAllUsers").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				repo.On("ListAllUsers", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsIamUserResourceType),
 		},
@@ -104,7 +104,7 @@ func TestIamUserSupplier_Resources(t *testing.T) {
 				&fakeIam,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
@@ -1,6 +1,9 @@
 package aws
 
 import (
+	"context"
+	"path/filepath"
+
 	"github.com/cloudskiff/driftctl/pkg/alerter"
 	"github.com/cloudskiff/driftctl/pkg/output"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/client"
@@ -8,107 +11,212 @@ import (
 	"github.com/cloudskiff/driftctl/pkg/remote/cache"
 	"github.com/cloudskiff/driftctl/pkg/resource"
 	"github.com/cloudskiff/driftctl/pkg/resource/aws"
+	"github.com/cloudskiff/driftctl/pkg/stats"
 	"github.com/cloudskiff/driftctl/pkg/terraform"
 )
 
 const RemoteAWSTerraform = "aws+tf"
 
-/**
- * Initialize remote (configure credentials, launch tf providers and start gRPC clients)
- * Required to use Scanner
- */
+// Remote holds providers of every scanned account, they are started once and reused by every scan
+type Remote struct {
+	opts     Options
+	recorder *stats.Recorder
+	accounts []remoteAccount
+}
 
-func Init(version string, alerter *alerter.Alerter,
+type remoteAccount struct {
+	// Id is empty for the account of the session when no role is assumed
+	id       string
+	provider *AWSTerraformProvider
+	cacheDir string
+}
+
+// NewRemote configures credentials and starts providers of every account to scan, they are added to providerLibrary to be cleaned up
+func NewRemote(ctx context.Context, version string,
 	providerLibrary *terraform.ProviderLibrary,
-	supplierLibrary *resource.SupplierLibrary,
 	progress output.Progress,
 	resourceSchemaRepository *resource.SchemaRepository,
-	factory resource.ResourceFactory,
-	configDir string) error {
+	configDir string,
+	opts Options,
+	recorder *stats.Recorder) (*Remote, error) {
 
 	if version == "" {
 		version = "3.19.0"
 	}
-	provider, err := NewAWSTerraformProvider(version, progress, configDir)
+	provider, err := NewAWSTerraformProvider(ctx, version, progress, configDir, opts, recorder)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	err = provider.Init()
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	providerLibrary.AddProvider(terraform.AWS, provider)
+
+	// Resources saved on disk are kept apart per account, even when only the one of the session is scanned
+	var callerAccountId string
+	if opts.MultiAccount() || opts.Cache.Enabled() {
+		callerAccountId, err = provider.CallerAccountId()
+		if err != nil {
+			return nil, err
+		}
+	}
+	cacheDir := cache.Dir(configDir, terraform.AWS, version)
+
+	// Without any role to assume, resources are read from the account of the session and are not tagged
+	accounts := []Account{{}}
+	if opts.MultiAccount() {
+		organizationsCache := cache.NewDiskCache(cache.New(1), opts.Cache, filepath.Join(cacheDir, callerAccountId))
+		organizationsRepository := repository.NewOrganizationsRepository(provider.session, recorder.Cache("organizations", organizationsCache))
+		accounts, err = ResolveAccounts(ctx, opts, callerAccountId, organizationsRepository)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	remote := &Remote{
+		opts:     opts,
+		recorder: recorder,
+	}
+	for _, account := range accounts {
+		accountProvider, err := provider.ForAccount(account, opts.AssumeRoleExternalId)
+		if err != nil {
+			return nil, err
+		}
+		accountCacheDir := filepath.Join(cacheDir, callerAccountId)
+		if account.Id != "" {
+			accountCacheDir = filepath.Join(cacheDir, account.Id)
+		}
+		remote.accounts = append(remote.accounts, remoteAccount{
+			id:       account.Id,
+			provider: accountProvider,
+			cacheDir: accountCacheDir,
+		})
 	}
 
-	repositoryCache := cache.New(100)
-
-	s3Repository := repository.NewS3Repository(client.NewAWSClientFactory(provider.session), repositoryCache)
-	ec2repository := repository.NewEC2Repository(provider.session, repositoryCache)
-	route53repository := repository.NewRoute53Repository(provider.session, repositoryCache)
-	lambdaRepository := repository.NewLambdaRepository(provider.session, repositoryCache)
-	rdsRepository := repository.NewRDSRepository(provider.session, repositoryCache)
-	sqsRepository := repository.NewSQSClient(provider.session, repositoryCache)
-	snsRepository := repository.NewSNSClient(provider.session, repositoryCache)
-	cloudfrontRepository := repository.NewCloudfrontClient(provider.session, repositoryCache)
-	dynamoDBRepository := repository.NewDynamoDBRepository(provider.session, repositoryCache)
-	ecrRepository := repository.NewECRRepository(provider.session, repositoryCache)
-	kmsRepository := repository.NewKMSRepository(provider.session, repositoryCache)
-	iamRepository := repository.NewIAMRepository(provider.session, repositoryCache)
+	err = resourceSchemaRepository.Init(terraform.AWS, version, provider.Schema())
+	if err != nil {
+		return nil, err
+	}
+	aws.InitResourcesMetadata(resourceSchemaRepository)
 
+	return remote, nil
+}
+
+// AddSuppliers adds suppliers of every account to the library. Suppliers and their repositories
+// can only be used for a single scan, so they are added again before each scan.
+func (r *Remote) AddSuppliers(alerter *alerter.Alerter, supplierLibrary *resource.SupplierLibrary, factory resource.ResourceFactory) error {
 	deserializer := resource.NewDeserializer(factory)
-	providerLibrary.AddProvider(terraform.AWS, provider)
+	for _, account := range r.accounts {
+		err := initAccount(account.id, account.provider, r.opts.Regions, r.opts.Cache, account.cacheDir, alerter, supplierLibrary, deserializer, r.recorder)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	supplierLibrary.AddSupplier(NewS3BucketSupplier(provider, s3Repository, deserializer))
-	supplierLibrary.AddSupplier(NewS3BucketAnalyticSupplier(provider, s3Repository, deserializer))
-	supplierLibrary.AddSupplier(NewS3BucketInventorySupplier(provider, s3Repository, deserializer))
-	supplierLibrary.AddSupplier(NewS3BucketMetricSupplier(provider, s3Repository, deserializer))
-	supplierLibrary.AddSupplier(NewS3BucketNotificationSupplier(provider, s3Repository, deserializer))
-	supplierLibrary.AddSupplier(NewS3BucketPolicySupplier(provider, s3Repository, deserializer))
-	supplierLibrary.AddSupplier(NewEC2EipSupplier(provider, ec2repository, deserializer))
-	supplierLibrary.AddSupplier(NewEC2EipAssociationSupplier(provider, deserializer, ec2repository))
-	supplierLibrary.AddSupplier(NewEC2EbsVolumeSupplier(provider, deserializer, ec2repository))
-	supplierLibrary.AddSupplier(NewEC2EbsSnapshotSupplier(provider, deserializer, ec2repository))
-	supplierLibrary.AddSupplier(NewRoute53ZoneSupplier(provider, deserializer, route53repository))
-	supplierLibrary.AddSupplier(NewRoute53RecordSupplier(provider, deserializer, route53repository))
-	supplierLibrary.AddSupplier(NewEC2InstanceSupplier(provider, deserializer, ec2repository))
-	supplierLibrary.AddSupplier(NewEC2AmiSupplier(provider, deserializer, ec2repository))
-	supplierLibrary.AddSupplier(NewEC2KeyPairSupplier(provider, deserializer, ec2repository))
-	supplierLibrary.AddSupplier(NewLambdaFunctionSupplier(provider, deserializer, lambdaRepository))
-	supplierLibrary.AddSupplier(NewDBSubnetGroupSupplier(provider, deserializer, rdsRepository))
-	supplierLibrary.AddSupplier(NewDBInstanceSupplier(provider, deserializer, rdsRepository))
-	supplierLibrary.AddSupplier(NewVPCSecurityGroupSupplier(provider, deserializer, ec2repository))
-	supplierLibrary.AddSupplier(NewIamUserSupplier(provider, deserializer, iamRepository))
-	supplierLibrary.AddSupplier(NewIamUserPolicySupplier(provider, deserializer, iamRepository))
-	supplierLibrary.AddSupplier(NewIamUserPolicyAttachmentSupplier(provider, deserializer, iamRepository))
-	supplierLibrary.AddSupplier(NewIamAccessKeySupplier(provider, deserializer, iamRepository))
-	supplierLibrary.AddSupplier(NewIamRoleSupplier(provider, deserializer, iamRepository))
-	supplierLibrary.AddSupplier(NewIamPolicySupplier(provider, deserializer, iamRepository))
-	supplierLibrary.AddSupplier(NewIamRolePolicySupplier(provider, deserializer, iamRepository))
-	supplierLibrary.AddSupplier(NewIamRolePolicyAttachmentSupplier(provider, deserializer, iamRepository))
-	supplierLibrary.AddSupplier(NewVPCSecurityGroupRuleSupplier(provider, deserializer, ec2repository))
-	supplierLibrary.AddSupplier(NewVPCSupplier(provider, deserializer, ec2repository))
-	supplierLibrary.AddSupplier(NewSubnetSupplier(provider, deserializer, ec2repository))
-	supplierLibrary.AddSupplier(NewRouteTableSupplier(provider, deserializer, ec2repository))
-	supplierLibrary.AddSupplier(NewRouteSupplier(provider, deserializer, ec2repository))
-	supplierLibrary.AddSupplier(NewRouteTableAssociationSupplier(provider, deserializer, ec2repository))
-	supplierLibrary.AddSupplier(NewNatGatewaySupplier(provider, deserializer, ec2repository))
-	supplierLibrary.AddSupplier(NewInternetGatewaySupplier(provider, deserializer, ec2repository))
-	supplierLibrary.AddSupplier(NewSqsQueueSupplier(provider, deserializer, sqsRepository))
-	supplierLibrary.AddSupplier(NewSqsQueuePolicySupplier(provider, deserializer, sqsRepository))
-	supplierLibrary.AddSupplier(NewSNSTopicSupplier(provider, deserializer, snsRepository))
-	supplierLibrary.AddSupplier(NewSNSTopicPolicySupplier(provider, deserializer, snsRepository))
-	supplierLibrary.AddSupplier(NewSNSTopicSubscriptionSupplier(provider, alerter, deserializer, snsRepository))
-	supplierLibrary.AddSupplier(NewDynamoDBTableSupplier(provider, deserializer, dynamoDBRepository))
-	supplierLibrary.AddSupplier(NewRoute53HealthCheckSupplier(provider, deserializer, route53repository))
-	supplierLibrary.AddSupplier(NewCloudfrontDistributionSupplier(provider, deserializer, cloudfrontRepository))
-	supplierLibrary.AddSupplier(NewECRRepositorySupplier(provider, deserializer, ecrRepository))
-	supplierLibrary.AddSupplier(NewKMSKeySupplier(provider, deserializer, kmsRepository))
-	supplierLibrary.AddSupplier(NewKMSAliasSupplier(provider, deserializer, kmsRepository))
-	supplierLibrary.AddSupplier(NewLambdaEventSourceMappingSupplier(provider, deserializer, lambdaRepository))
-
-	err = resourceSchemaRepository.Init(version, provider.Schema())
+// initAccount adds suppliers reading global resources of an account, and regional resources of every given region.
+// Resources listed and read are saved in cacheDir when caching on disk is enabled.
+func initAccount(accountId string,
+	provider *AWSTerraformProvider,
+	regions []string,
+	cacheOpts cache.DiskOptions,
+	cacheDir string,
+	alerter *alerter.Alerter,
+	supplierLibrary *resource.SupplierLibrary,
+	deserializer *resource.Deserializer,
+	recorder *stats.Recorder) error {
+
+	regions, err := provider.ResolveRegions(regions)
 	if err != nil {
 		return err
 	}
-	aws.InitResourcesMetadata(resourceSchemaRepository)
+
+	addGlobalSupplier := func(supplier resource.Supplier, types ...resource.ResourceType) {
+		supplierLibrary.AddSupplier(NewMetaSupplier(resource.Meta{Account: accountId}, supplier), types...)
+	}
+
+	if cacheOpts.Enabled() {
+		// Every resource is read once per run, they are only worth keeping on disk
+		provider = provider.WithCache(recorder.Cache("terraform", cache.NewDiskCache(cache.New(0), cacheOpts, filepath.Join(cacheDir, "resources"))))
+	}
+
+	// Global services are read once, using the default region
+	globalCache := cache.NewDiskCache(cache.New(100), cacheOpts, filepath.Join(cacheDir, "global"))
+	s3Repository := repository.NewS3Repository(client.NewAWSClientFactory(provider.session), recorder.Cache("s3", globalCache))
+	route53repository := repository.NewRoute53Repository(provider.session, recorder.Cache("route53", globalCache))
+	cloudfrontRepository := repository.NewCloudfrontClient(provider.session, recorder.Cache("cloudfront", globalCache))
+	iamRepository := repository.NewIAMRepository(provider.session, recorder.Cache("iam", globalCache))
+
+	addGlobalSupplier(NewRoute53ZoneSupplier(provider, deserializer, route53repository), aws.AwsRoute53ZoneResourceType)
+	addGlobalSupplier(NewRoute53RecordSupplier(provider, deserializer, route53repository), aws.AwsRoute53RecordResourceType)
+	addGlobalSupplier(NewIamUserSupplier(provider, deserializer, iamRepository), aws.AwsIamUserResourceType)
+	addGlobalSupplier(NewIamUserPolicySupplier(provider, deserializer, iamRepository), aws.AwsIamUserPolicyResourceType)
+	addGlobalSupplier(NewIamUserPolicyAttachmentSupplier(provider, deserializer, iamRepository), aws.AwsIamUserPolicyAttachmentResourceType)
+	addGlobalSupplier(NewIamAccessKeySupplier(provider, deserializer, iamRepository), aws.AwsIamAccessKeyResourceType)
+	addGlobalSupplier(NewIamRoleSupplier(provider, deserializer, iamRepository), aws.AwsIamRoleResourceType)
+	addGlobalSupplier(NewIamPolicySupplier(provider, deserializer, iamRepository), aws.AwsIamPolicyResourceType)
+	addGlobalSupplier(NewIamRolePolicySupplier(provider, deserializer, iamRepository), aws.AwsIamRolePolicyResourceType)
+	addGlobalSupplier(NewIamRolePolicyAttachmentSupplier(provider, deserializer, iamRepository), aws.AwsIamRolePolicyAttachmentResourceType)
+	addGlobalSupplier(NewRoute53HealthCheckSupplier(provider, deserializer, route53repository), aws.AwsRoute53HealthCheckResourceType)
+	addGlobalSupplier(NewCloudfrontDistributionSupplier(provider, deserializer, cloudfrontRepository), aws.AwsCloudfrontDistributionResourceType)
+
+	for _, region := range regions {
+		regionalProvider := provider.ForRegion(region)
+		repositoryCache := cache.NewDiskCache(cache.New(100), cacheOpts, filepath.Join(cacheDir, region))
+
+		ec2repository := repository.NewEC2Repository(regionalProvider.session, recorder.Cache("ec2", repositoryCache))
+		lambdaRepository := repository.NewLambdaRepository(regionalProvider.session, recorder.Cache("lambda", repositoryCache))
+		rdsRepository := repository.NewRDSRepository(regionalProvider.session, recorder.Cache("rds", repositoryCache))
+		sqsRepository := repository.NewSQSClient(regionalProvider.session, recorder.Cache("sqs", repositoryCache))
+		snsRepository := repository.NewSNSClient(regionalProvider.session, recorder.Cache("sns", repositoryCache))
+		dynamoDBRepository := repository.NewDynamoDBRepository(regionalProvider.session, recorder.Cache("dynamodb", repositoryCache))
+		ecrRepository := repository.NewECRRepository(regionalProvider.session, recorder.Cache("ecr", repositoryCache))
+		kmsRepository := repository.NewKMSRepository(regionalProvider.session, recorder.Cache("kms", repositoryCache))
+
+		region := region
+		addRegionalSupplier := func(supplier resource.Supplier, types ...resource.ResourceType) {
+			supplierLibrary.AddSupplier(NewMetaSupplier(resource.Meta{Account: accountId, Region: region}, supplier), types...)
+		}
+
+		addRegionalSupplier(NewS3BucketSupplier(regionalProvider, s3Repository, deserializer), aws.AwsS3BucketResourceType)
+		addRegionalSupplier(NewS3BucketAnalyticSupplier(regionalProvider, s3Repository, deserializer), aws.AwsS3BucketAnalyticsConfigurationResourceType)
+		addRegionalSupplier(NewS3BucketInventorySupplier(regionalProvider, s3Repository, deserializer), aws.AwsS3BucketInventoryResourceType)
+		addRegionalSupplier(NewS3BucketMetricSupplier(regionalProvider, s3Repository, deserializer), aws.AwsS3BucketMetricResourceType)
+		addRegionalSupplier(NewS3BucketNotificationSupplier(regionalProvider, s3Repository, deserializer), aws.AwsS3BucketNotificationResourceType)
+		addRegionalSupplier(NewS3BucketPolicySupplier(regionalProvider, s3Repository, deserializer), aws.AwsS3BucketPolicyResourceType)
+		addRegionalSupplier(NewEC2EipSupplier(regionalProvider, ec2repository, deserializer), aws.AwsEipResourceType)
+		addRegionalSupplier(NewEC2EipAssociationSupplier(regionalProvider, deserializer, ec2repository), aws.AwsEipAssociationResourceType)
+		addRegionalSupplier(NewEC2EbsVolumeSupplier(regionalProvider, deserializer, ec2repository), aws.AwsEbsVolumeResourceType)
+		addRegionalSupplier(NewEC2EbsSnapshotSupplier(regionalProvider, deserializer, ec2repository), aws.AwsEbsSnapshotResourceType)
+		addRegionalSupplier(NewEC2InstanceSupplier(regionalProvider, deserializer, ec2repository), aws.AwsInstanceResourceType)
+		addRegionalSupplier(NewEC2AmiSupplier(regionalProvider, deserializer, ec2repository), aws.AwsAmiResourceType)
+		addRegionalSupplier(NewEC2KeyPairSupplier(regionalProvider, deserializer, ec2repository), aws.AwsKeyPairResourceType)
+		addRegionalSupplier(NewLambdaFunctionSupplier(regionalProvider, deserializer, lambdaRepository), aws.AwsLambdaFunctionResourceType)
+		addRegionalSupplier(NewDBSubnetGroupSupplier(regionalProvider, deserializer, rdsRepository), aws.AwsDbSubnetGroupResourceType)
+		addRegionalSupplier(NewDBInstanceSupplier(regionalProvider, deserializer, rdsRepository), aws.AwsDbInstanceResourceType)
+		addRegionalSupplier(NewVPCSecurityGroupSupplier(regionalProvider, deserializer, ec2repository), aws.AwsSecurityGroupResourceType, aws.AwsDefaultSecurityGroupResourceType)
+		addRegionalSupplier(NewVPCSecurityGroupRuleSupplier(regionalProvider, deserializer, ec2repository), aws.AwsSecurityGroupRuleResourceType)
+		addRegionalSupplier(NewVPCSupplier(regionalProvider, deserializer, ec2repository), aws.AwsVpcResourceType, aws.AwsDefaultVpcResourceType)
+		addRegionalSupplier(NewSubnetSupplier(regionalProvider, deserializer, ec2repository), aws.AwsSubnetResourceType, aws.AwsDefaultSubnetResourceType)
+		addRegionalSupplier(NewRouteTableSupplier(regionalProvider, deserializer, ec2repository), aws.AwsRouteTableResourceType, aws.AwsDefaultRouteTableResourceType)
+		addRegionalSupplier(NewRouteSupplier(regionalProvider, deserializer, ec2repository), aws.AwsRouteResourceType)
+		addRegionalSupplier(NewRouteTableAssociationSupplier(regionalProvider, deserializer, ec2repository), aws.AwsRouteTableAssociationResourceType)
+		addRegionalSupplier(NewNatGatewaySupplier(regionalProvider, deserializer, ec2repository), aws.AwsNatGatewayResourceType)
+		addRegionalSupplier(NewInternetGatewaySupplier(regionalProvider, deserializer, ec2repository), aws.AwsInternetGatewayResourceType)
+		addRegionalSupplier(NewSqsQueueSupplier(regionalProvider, deserializer, sqsRepository), aws.AwsSqsQueueResourceType)
+		addRegionalSupplier(NewSqsQueuePolicySupplier(regionalProvider, deserializer, sqsRepository), aws.AwsSqsQueuePolicyResourceType)
+		addRegionalSupplier(NewSNSTopicSupplier(regionalProvider, deserializer, snsRepository), aws.AwsSnsTopicResourceType)
+		addRegionalSupplier(NewSNSTopicPolicySupplier(regionalProvider, deserializer, snsRepository), aws.AwsSnsTopicPolicyResourceType)
+		addRegionalSupplier(NewSNSTopicSubscriptionSupplier(regionalProvider, alerter, deserializer, snsRepository), aws.AwsSnsTopicSubscriptionResourceType)
+		addRegionalSupplier(NewDynamoDBTableSupplier(regionalProvider, deserializer, dynamoDBRepository), aws.AwsDynamodbTableResourceType)
+		addRegionalSupplier(NewECRRepositorySupplier(regionalProvider, deserializer, ecrRepository), aws.AwsEcrRepositoryResourceType)
+		addRegionalSupplier(NewKMSKeySupplier(regionalProvider, deserializer, kmsRepository), aws.AwsKmsKeyResourceType)
+		addRegionalSupplier(NewKMSAliasSupplier(regionalProvider, deserializer, kmsRepository), aws.AwsKmsAliasResourceType)
+		addRegionalSupplier(NewLambdaEventSourceMappingSupplier(regionalProvider, deserializer, lambdaRepository), aws.AwsLambdaEventSourceMappingResourceType)
+	}
 
 	return nil
 }
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/cloudskiff/driftctl/pkg/output"
 	"github.com/cloudskiff/driftctl/pkg/terraform"
 )
@@ -8,7 +10,7 @@ import (
 func InitTestAwsProvider(providerLibrary *terraform.ProviderLibrary) (*AWSTerraformProvider, error) {
 	progress := &output.MockProgress{}
 	progress.On("Inc").Maybe().Return()
-	provider, err := NewAWSTerraformProvider("", progress, "")
+	provider, err := NewAWSTerraformProvider(context.Background(), "", progress, "", Options{}, nil)
 	if err != nil {
 		return nil, err
 	}
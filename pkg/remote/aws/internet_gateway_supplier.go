@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
@@ -28,8 +29,8 @@ func NewInternetGatewaySupplier(provider *AWSTerraformProvider, deserializer *re
 	}
 }
 
-func (s *InternetGatewaySupplier) Resources() ([]resource.Resource, error) {
-	internetGateways, err := s.repo.ListAllInternetGateways()
+func (s *InternetGatewaySupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	internetGateways, err := s.repo.ListAllInternetGateways(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsInternetGatewayResourceType)
 	}
@@ -37,11 +38,11 @@ func (s *InternetGatewaySupplier) Resources() ([]resource.Resource, error) {
 	for _, internetGateway := range internetGateways {
 		gtw := *internetGateway
 		s.runner.Run(func() (cty.Value, error) {
-			return s.readInternetGateway(gtw)
+			return s.readInternetGateway(ctx, gtw)
 		})
 	}
 
-	resources, err := s.runner.Wait()
+	resources, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -49,11 +50,12 @@ func (s *InternetGatewaySupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(aws.AwsInternetGatewayResourceType, resources)
 }
 
-func (s *InternetGatewaySupplier) readInternetGateway(internetGateway ec2.InternetGateway) (cty.Value, error) {
+func (s *InternetGatewaySupplier) readInternetGateway(ctx context.Context, internetGateway ec2.InternetGateway) (cty.Value, error) {
 	var Ty resource.ResourceType = aws.AwsInternetGatewayResourceType
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		Ty: Ty,
-		ID: *internetGateway.InternetGatewayId,
+		Ctx: ctx,
+		Ty:  Ty,
+		ID:  *internetGateway.InternetGatewayId,
 	})
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
@@ -38,7 +38,7 @@ func TestInternetGatewaySupplier_Resources(t *testing.T) {
 			test:    "no internet gateways",
 			dirName: "internet_gateway_empty",
 			mocks: func(repo *repository.MockEC2Repository) {
-				repo.On("ListAllInternetGateways").Once().Return([]*ec2.InternetGateway{}, nil)
+				repo.On("ListAllInternetGateways", mock.Anything).Once().Return([]*ec2.InternetGateway{}, nil)
 			},
 			err: nil,
 		},
@@ -46,7 +46,7 @@ func TestInternetGatewaySupplier_Resources(t *testing.T) {
 			test:    "multiple internet gateways",
 			dirName: "internet_gateway_multiple",
 			mocks: func(repo *repository.MockEC2Repository) {
-				repo.On("ListAllInternetGateways").Once().Return([]*ec2.InternetGateway{
+				repo.On("ListAllInternetGateways", mock.Anything).Once().Return([]*ec2.InternetGateway{
 					{
 						InternetGatewayId: awssdk.String("igw-0184eb41aadc62d1c"),
 					},
@@ -61,7 +61,7 @@ func TestInternetGatewaySupplier_Resources(t *testing.T) {
 			test:    "cannot list internet gateways",
 			dirName: "internet_gateway_empty",
 			mocks: func(repo *repository.MockEC2Repository) {
-				repo.On("ListAllInternetGateways").Once().Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				repo.On("ListAllInternetGateways", mock.Anything).Once().Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsInternetGatewayResourceType),
 		},
@@ -95,7 +95,7 @@ func TestInternetGatewaySupplier_Resources(t *testing.T) {
 				&fakeEC2,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
@@ -29,8 +30,8 @@ func NewKMSAliasSupplier(provider *AWSTerraformProvider, deserializer *resource.
 	}
 }
 
-func (s *KMSAliasSupplier) Resources() ([]resource.Resource, error) {
-	aliases, err := s.client.ListAllAliases()
+func (s *KMSAliasSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	aliases, err := s.client.ListAllAliases(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsKmsAliasResourceType)
 	}
@@ -38,11 +39,11 @@ func (s *KMSAliasSupplier) Resources() ([]resource.Resource, error) {
 	for _, alias := range aliases {
 		alias := alias
 		s.runner.Run(func() (cty.Value, error) {
-			return s.readAlias(alias)
+			return s.readAlias(ctx, alias)
 		})
 	}
 
-	retrieve, err := s.runner.Wait()
+	retrieve, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -50,10 +51,11 @@ func (s *KMSAliasSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(aws.AwsKmsAliasResourceType, retrieve)
 }
 
-func (s *KMSAliasSupplier) readAlias(alias *kms.AliasListEntry) (cty.Value, error) {
+func (s *KMSAliasSupplier) readAlias(ctx context.Context, alias *kms.AliasListEntry) (cty.Value, error) {
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		ID: *alias.AliasName,
-		Ty: aws.AwsKmsAliasResourceType,
+		Ctx: ctx,
+		ID:  *alias.AliasName,
+		Ty:  aws.AwsKmsAliasResourceType,
 	})
 	if err != nil {
 		logrus.Error(err)
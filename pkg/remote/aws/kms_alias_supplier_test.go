@@ -37,7 +37,7 @@ func TestKMSAliasSupplier_Resources(t *testing.T) {
 			test:    "no aliases",
 			dirName: "kms_alias_empty",
 			mocks: func(client *repository.MockKMSRepository) {
-				client.On("ListAllAliases").Return([]*kms.AliasListEntry{}, nil)
+				client.On("ListAllAliases", mock.Anything).Return([]*kms.AliasListEntry{}, nil)
 			},
 			err: nil,
 		},
@@ -45,7 +45,7 @@ func TestKMSAliasSupplier_Resources(t *testing.T) {
 			test:    "multiple aliases",
 			dirName: "kms_alias_multiple",
 			mocks: func(client *repository.MockKMSRepository) {
-				client.On("ListAllAliases").Return([]*kms.AliasListEntry{
+				client.On("ListAllAliases", mock.Anything).Return([]*kms.AliasListEntry{
 					{AliasName: aws.String("alias/foo")},
 					{AliasName: aws.String("alias/bar")},
 					{AliasName: aws.String("alias/baz20210225124429210500000001")},
@@ -57,7 +57,7 @@ func TestKMSAliasSupplier_Resources(t *testing.T) {
 			test:    "cannot list aliases",
 			dirName: "kms_alias_empty",
 			mocks: func(client *repository.MockKMSRepository) {
-				client.On("ListAllAliases").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllAliases", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsKmsAliasResourceType),
 		},
@@ -91,7 +91,7 @@ func TestKMSAliasSupplier_Resources(t *testing.T) {
 				&fakeClient,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 			mock.AssertExpectationsForObjects(tt)
 			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
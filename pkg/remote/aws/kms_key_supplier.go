@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
@@ -29,8 +30,8 @@ func NewKMSKeySupplier(provider *AWSTerraformProvider, deserializer *resource.De
 	}
 }
 
-func (s *KMSKeySupplier) Resources() ([]resource.Resource, error) {
-	keys, err := s.client.ListAllKeys()
+func (s *KMSKeySupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	keys, err := s.client.ListAllKeys(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsKmsKeyResourceType)
 	}
@@ -38,11 +39,11 @@ func (s *KMSKeySupplier) Resources() ([]resource.Resource, error) {
 	for _, key := range keys {
 		key := key
 		s.runner.Run(func() (cty.Value, error) {
-			return s.readKey(key)
+			return s.readKey(ctx, key)
 		})
 	}
 
-	retrieve, err := s.runner.Wait()
+	retrieve, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -50,10 +51,11 @@ func (s *KMSKeySupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(aws.AwsKmsKeyResourceType, retrieve)
 }
 
-func (s *KMSKeySupplier) readKey(key *kms.KeyListEntry) (cty.Value, error) {
+func (s *KMSKeySupplier) readKey(ctx context.Context, key *kms.KeyListEntry) (cty.Value, error) {
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		ID: *key.KeyId,
-		Ty: aws.AwsKmsKeyResourceType,
+		Ctx: ctx,
+		ID:  *key.KeyId,
+		Ty:  aws.AwsKmsKeyResourceType,
 	})
 	if err != nil {
 		logrus.Error(err)
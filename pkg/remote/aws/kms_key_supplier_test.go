@@ -37,7 +37,7 @@ func TestKMSKeySupplier_Resources(t *testing.T) {
 			test:    "no keys",
 			dirName: "kms_key_empty",
 			mocks: func(client *repository.MockKMSRepository) {
-				client.On("ListAllKeys").Return([]*kms.KeyListEntry{}, nil)
+				client.On("ListAllKeys", mock.Anything).Return([]*kms.KeyListEntry{}, nil)
 			},
 			err: nil,
 		},
@@ -45,7 +45,7 @@ func TestKMSKeySupplier_Resources(t *testing.T) {
 			test:    "multiple keys",
 			dirName: "kms_key_multiple",
 			mocks: func(client *repository.MockKMSRepository) {
-				client.On("ListAllKeys").Return([]*kms.KeyListEntry{
+				client.On("ListAllKeys", mock.Anything).Return([]*kms.KeyListEntry{
 					{KeyId: aws.String("8ee21d91-c000-428c-8032-235aac55da36")},
 					{KeyId: aws.String("5d765f32-bfdc-4610-b6ab-f82db5d0601b")},
 					{KeyId: aws.String("89d2c023-ea53-40a5-b20a-d84905c622d7")},
@@ -57,7 +57,7 @@ func TestKMSKeySupplier_Resources(t *testing.T) {
 			test:    "cannot list keys",
 			dirName: "kms_key_empty",
 			mocks: func(client *repository.MockKMSRepository) {
-				client.On("ListAllKeys").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllKeys", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsKmsKeyResourceType),
 		},
@@ -91,7 +91,7 @@ func TestKMSKeySupplier_Resources(t *testing.T) {
 				&fakeClient,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 			mock.AssertExpectationsForObjects(tt)
 			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
@@ -28,8 +29,8 @@ func NewLambdaEventSourceMappingSupplier(provider *AWSTerraformProvider, deseria
 	}
 }
 
-func (s *LambdaEventSourceMappingSupplier) Resources() ([]resource.Resource, error) {
-	functions, err := s.client.ListAllLambdaEventSourceMappings()
+func (s *LambdaEventSourceMappingSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	functions, err := s.client.ListAllLambdaEventSourceMappings(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsLambdaEventSourceMappingResourceType)
 	}
@@ -37,10 +38,10 @@ func (s *LambdaEventSourceMappingSupplier) Resources() ([]resource.Resource, err
 	for _, function := range functions {
 		fun := *function
 		s.runner.Run(func() (cty.Value, error) {
-			return s.readLambdaEventSourceMapping(fun)
+			return s.readLambdaEventSourceMapping(ctx, fun)
 		})
 	}
-	results, err = s.runner.Wait()
+	results, err = s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -48,11 +49,12 @@ func (s *LambdaEventSourceMappingSupplier) Resources() ([]resource.Resource, err
 	return s.deserializer.Deserialize(resourceaws.AwsLambdaEventSourceMappingResourceType, results)
 }
 
-func (s *LambdaEventSourceMappingSupplier) readLambdaEventSourceMapping(sourceMappingConfig lambda.EventSourceMappingConfiguration) (cty.Value, error) {
+func (s *LambdaEventSourceMappingSupplier) readLambdaEventSourceMapping(ctx context.Context, sourceMappingConfig lambda.EventSourceMappingConfiguration) (cty.Value, error) {
 	resFunction, err := s.reader.ReadResource(
 		terraform.ReadResourceArgs{
-			Ty: resourceaws.AwsLambdaEventSourceMappingResourceType,
-			ID: *sourceMappingConfig.UUID,
+			Ctx: ctx,
+			Ty:  resourceaws.AwsLambdaEventSourceMappingResourceType,
+			ID:  *sourceMappingConfig.UUID,
 		},
 	)
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	"github.com/cloudskiff/driftctl/test/goldenfile"
 	"github.com/cloudskiff/driftctl/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestEventLambdaSourceMappingSupplier_Resources(t *testing.T) {
@@ -33,7 +34,7 @@ func TestEventLambdaSourceMappingSupplier_Resources(t *testing.T) {
 			test:    "no EventSourceMapping",
 			dirName: "lambda_source_mapping_empty",
 			mocks: func(repo *repository.MockLambdaRepository) {
-				repo.On("ListAllLambdaEventSourceMappings").Return([]*lambda.EventSourceMappingConfiguration{}, nil)
+				repo.On("ListAllLambdaEventSourceMappings", mock.Anything).Return([]*lambda.EventSourceMappingConfiguration{}, nil)
 			},
 			err: nil,
 		},
@@ -41,7 +42,7 @@ func TestEventLambdaSourceMappingSupplier_Resources(t *testing.T) {
 			test:    "with 2 sqs EventSourceMapping",
 			dirName: "lambda_source_mapping_sqs_multiple",
 			mocks: func(repo *repository.MockLambdaRepository) {
-				repo.On("ListAllLambdaEventSourceMappings").Return([]*lambda.EventSourceMappingConfiguration{
+				repo.On("ListAllLambdaEventSourceMappings", mock.Anything).Return([]*lambda.EventSourceMappingConfiguration{
 					{
 						UUID: aws.String("13ff66f8-37eb-4ad6-a0a8-594fea72df4f"),
 					},
@@ -56,7 +57,7 @@ func TestEventLambdaSourceMappingSupplier_Resources(t *testing.T) {
 			test:    "with dynamo EventSourceMapping",
 			dirName: "lambda_source_mapping_dynamo_multiple",
 			mocks: func(repo *repository.MockLambdaRepository) {
-				repo.On("ListAllLambdaEventSourceMappings").Return([]*lambda.EventSourceMappingConfiguration{
+				repo.On("ListAllLambdaEventSourceMappings", mock.Anything).Return([]*lambda.EventSourceMappingConfiguration{
 					{
 						UUID: aws.String("1aa9c4a0-060b-41c1-a9ae-dc304ebcdb00"),
 					},
@@ -68,7 +69,7 @@ func TestEventLambdaSourceMappingSupplier_Resources(t *testing.T) {
 			test:    "cannot list lambda functions",
 			dirName: "lambda_function_empty",
 			mocks: func(repo *repository.MockLambdaRepository) {
-				repo.On("ListAllLambdaEventSourceMappings").Return([]*lambda.EventSourceMappingConfiguration{}, awserr.NewRequestFailure(nil, 403, ""))
+				repo.On("ListAllLambdaEventSourceMappings", mock.Anything).Return([]*lambda.EventSourceMappingConfiguration{}, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsLambdaEventSourceMappingResourceType),
 		},
@@ -102,7 +103,7 @@ func TestEventLambdaSourceMappingSupplier_Resources(t *testing.T) {
 				client,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, tt.err, err)
 
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
@@ -28,8 +29,8 @@ func NewLambdaFunctionSupplier(provider *AWSTerraformProvider, deserializer *res
 	}
 }
 
-func (s *LambdaFunctionSupplier) Resources() ([]resource.Resource, error) {
-	functions, err := s.client.ListAllLambdaFunctions()
+func (s *LambdaFunctionSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	functions, err := s.client.ListAllLambdaFunctions(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsLambdaFunctionResourceType)
 	}
@@ -38,10 +39,10 @@ func (s *LambdaFunctionSupplier) Resources() ([]resource.Resource, error) {
 		for _, function := range functions {
 			fun := *function
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readLambda(fun)
+				return s.readLambda(ctx, fun)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -49,12 +50,13 @@ func (s *LambdaFunctionSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(resourceaws.AwsLambdaFunctionResourceType, results)
 }
 
-func (s *LambdaFunctionSupplier) readLambda(function lambda.FunctionConfiguration) (cty.Value, error) {
+func (s *LambdaFunctionSupplier) readLambda(ctx context.Context, function lambda.FunctionConfiguration) (cty.Value, error) {
 	name := *function.FunctionName
 	resFunction, err := s.reader.ReadResource(
 		terraform.ReadResourceArgs{
-			Ty: resourceaws.AwsLambdaFunctionResourceType,
-			ID: name,
+			Ctx: ctx,
+			Ty:  resourceaws.AwsLambdaFunctionResourceType,
+			ID:  name,
 			Attributes: map[string]string{
 				"function_name": name,
 			},
@@ -14,6 +14,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/cloudskiff/driftctl/pkg/parallel"
 
@@ -39,7 +40,7 @@ func TestLambdaFunctionSupplier_Resources(t *testing.T) {
 			test:    "no lambda functions",
 			dirName: "lambda_function_empty",
 			mocks: func(repo *repository.MockLambdaRepository) {
-				repo.On("ListAllLambdaFunctions").Return([]*lambda.FunctionConfiguration{}, nil)
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return([]*lambda.FunctionConfiguration{}, nil)
 			},
 			err: nil,
 		},
@@ -47,7 +48,7 @@ func TestLambdaFunctionSupplier_Resources(t *testing.T) {
 			test:    "with lambda functions",
 			dirName: "lambda_function_multiple",
 			mocks: func(repo *repository.MockLambdaRepository) {
-				repo.On("ListAllLambdaFunctions").Return([]*lambda.FunctionConfiguration{
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return([]*lambda.FunctionConfiguration{
 					{
 						FunctionName: aws.String("foo"),
 					},
@@ -62,7 +63,7 @@ func TestLambdaFunctionSupplier_Resources(t *testing.T) {
 			test:    "One lambda with signing",
 			dirName: "lambda_function_signed",
 			mocks: func(repo *repository.MockLambdaRepository) {
-				repo.On("ListAllLambdaFunctions").Return([]*lambda.FunctionConfiguration{
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return([]*lambda.FunctionConfiguration{
 					{
 						FunctionName: aws.String("foo"),
 					},
@@ -74,7 +75,7 @@ func TestLambdaFunctionSupplier_Resources(t *testing.T) {
 			test:    "cannot list lambda functions",
 			dirName: "lambda_function_empty",
 			mocks: func(repo *repository.MockLambdaRepository) {
-				repo.On("ListAllLambdaFunctions").Return([]*lambda.FunctionConfiguration{}, awserr.NewRequestFailure(nil, 403, ""))
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return([]*lambda.FunctionConfiguration{}, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsLambdaFunctionResourceType),
 		},
@@ -108,7 +109,7 @@ func TestLambdaFunctionSupplier_Resources(t *testing.T) {
 				client,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, tt.err, err)
 
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
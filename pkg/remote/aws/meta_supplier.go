@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+// MetaSupplier tags resources returned by a supplier with the account and the region they were read from
+type MetaSupplier struct {
+	resource.Supplier
+	meta resource.Meta
+}
+
+func NewMetaSupplier(meta resource.Meta, supplier resource.Supplier) *MetaSupplier {
+	return &MetaSupplier{
+		supplier,
+		meta,
+	}
+}
+
+func (s *MetaSupplier) Meta() resource.Meta {
+	return s.meta
+}
+
+func (s *MetaSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	resources, err := s.Supplier.Resources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range resources {
+		r, ok := res.(resource.ResourceWithMeta)
+		if !ok {
+			continue
+		}
+		if s.meta.Account != "" {
+			r.Metadata().Account = s.meta.Account
+		}
+		if s.meta.Region != "" {
+			r.Metadata().Region = s.meta.Region
+		}
+	}
+	return resources, nil
+}
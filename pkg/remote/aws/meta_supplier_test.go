@@ -0,0 +1,36 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	testresource "github.com/cloudskiff/driftctl/test/resource"
+)
+
+func TestMetaSupplier_Resources(t *testing.T) {
+	fake := &testresource.FakeResource{Id: "fake", Type: "aws_fake"}
+	instance := &resource.AbstractResource{Id: "i-0123", Type: "aws_instance"}
+	role := &resource.AbstractResource{Id: "my-role", Type: "aws_iam_role"}
+
+	regional := &resource.MockSupplier{}
+	regional.On("Resources", mock.Anything).Return([]resource.Resource{instance, fake}, nil)
+	global := &resource.MockSupplier{}
+	global.On("Resources", mock.Anything).Return([]resource.Resource{role}, nil)
+
+	got, err := NewMetaSupplier(resource.Meta{Account: "123456789012", Region: "eu-west-3"}, regional).Resources(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []resource.Resource{instance, fake}, got)
+	assert.Equal(t, resource.Meta{Account: "123456789012", Region: "eu-west-3"}, resource.MetadataOf(instance))
+	assert.Equal(t, resource.Meta{}, resource.MetadataOf(fake))
+
+	_, err = NewMetaSupplier(resource.Meta{Account: "123456789012"}, global).Resources(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, resource.Meta{Account: "123456789012"}, resource.MetadataOf(role))
+
+	regional.AssertExpectations(t)
+	global.AssertExpectations(t)
+}
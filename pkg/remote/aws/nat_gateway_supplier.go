@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
@@ -28,8 +29,8 @@ func NewNatGatewaySupplier(provider *AWSTerraformProvider, deserializer *resourc
 	}
 }
 
-func (s *NatGatewaySupplier) Resources() ([]resource.Resource, error) {
-	retrievedNatGateways, err := s.repo.ListAllNatGateways()
+func (s *NatGatewaySupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	retrievedNatGateways, err := s.repo.ListAllNatGateways(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsNatGatewayResourceType)
 	}
@@ -37,12 +38,12 @@ func (s *NatGatewaySupplier) Resources() ([]resource.Resource, error) {
 	for _, gateway := range retrievedNatGateways {
 		res := *gateway
 		s.runner.Run(func() (cty.Value, error) {
-			return s.readNatGateway(res)
+			return s.readNatGateway(ctx, res)
 		})
 	}
 
 	// Retrieve results from terraform provider
-	natGatewayResources, err := s.runner.Wait()
+	natGatewayResources, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -56,11 +57,12 @@ func (s *NatGatewaySupplier) Resources() ([]resource.Resource, error) {
 	return resources, nil
 }
 
-func (s *NatGatewaySupplier) readNatGateway(gateway ec2.NatGateway) (cty.Value, error) {
+func (s *NatGatewaySupplier) readNatGateway(ctx context.Context, gateway ec2.NatGateway) (cty.Value, error) {
 	var Ty resource.ResourceType = aws.AwsNatGatewayResourceType
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		ID: *gateway.NatGatewayId,
-		Ty: Ty,
+		Ctx: ctx,
+		ID:  *gateway.NatGatewayId,
+		Ty:  Ty,
 	})
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
@@ -36,7 +36,7 @@ func TestNatGatewaySupplier_Resources(t *testing.T) {
 			test:    "no gateway",
 			dirName: "nat_gateway_empty",
 			mocks: func(repo *repository.MockEC2Repository) {
-				repo.On("ListAllNatGateways").Once().Return([]*ec2.NatGateway{}, nil)
+				repo.On("ListAllNatGateways", mock.Anything).Once().Return([]*ec2.NatGateway{}, nil)
 			},
 			err: nil,
 		},
@@ -44,7 +44,7 @@ func TestNatGatewaySupplier_Resources(t *testing.T) {
 			test:    "single aws_nat_gateway",
 			dirName: "nat_gateway",
 			mocks: func(repo *repository.MockEC2Repository) {
-				repo.On("ListAllNatGateways").Once().Return([]*ec2.NatGateway{
+				repo.On("ListAllNatGateways", mock.Anything).Once().Return([]*ec2.NatGateway{
 					{
 						NatGatewayId: aws.String("nat-0a5408508b19ef490"),
 					},
@@ -56,7 +56,7 @@ func TestNatGatewaySupplier_Resources(t *testing.T) {
 			test:    "cannot list gateway",
 			dirName: "nat_gateway_empty",
 			mocks: func(repo *repository.MockEC2Repository) {
-				repo.On("ListAllNatGateways").Once().Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				repo.On("ListAllNatGateways", mock.Anything).Once().Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsNatGatewayResourceType),
 		},
@@ -90,7 +90,7 @@ func TestNatGatewaySupplier_Resources(t *testing.T) {
 				&fakeEC2,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
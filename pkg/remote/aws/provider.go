@@ -1,10 +1,26 @@
 package aws
 
 import (
+	"context"
+
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 
 	"github.com/cloudskiff/driftctl/pkg/output"
+	"github.com/cloudskiff/driftctl/pkg/remote/cache"
+	"github.com/cloudskiff/driftctl/pkg/remote/retry"
 	"github.com/cloudskiff/driftctl/pkg/remote/terraform"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/stats"
 	tf "github.com/cloudskiff/driftctl/pkg/terraform"
 )
 
@@ -15,12 +31,9 @@ type awsConfig struct {
 	Profile       string
 	Token         string
 	Region        string `cty:"region"`
-	MaxRetries    int
+	MaxRetries    int    `cty:"max_retries"`
 
-	AssumeRoleARN         string
-	AssumeRoleExternalID  string
-	AssumeRoleSessionName string
-	AssumeRolePolicy      string
+	AssumeRole []awsAssumeRoleConfig `cty:"assume_role"`
 
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
@@ -37,12 +50,27 @@ type awsConfig struct {
 	S3ForcePathStyle        bool
 }
 
+type awsAssumeRoleConfig struct {
+	RoleARN     string `cty:"role_arn"`
+	ExternalID  string `cty:"external_id"`
+	SessionName string `cty:"session_name"`
+	Policy      string `cty:"policy"`
+}
+
+// Session name used when assuming a role in another account
+const assumeRoleSessionName = "driftctl"
+
+// AllRegions can be used in place of a region list to scan every region enabled for the account
+const AllRegions = "all"
+
 type AWSTerraformProvider struct {
 	*terraform.TerraformProvider
 	session *session.Session
+	// Providers of other accounts, cleaned up along with this one
+	assumed []*AWSTerraformProvider
 }
 
-func NewAWSTerraformProvider(version string, progress output.Progress, configDir string) (*AWSTerraformProvider, error) {
+func NewAWSTerraformProvider(ctx context.Context, version string, progress output.Progress, configDir string, opts Options, recorder *stats.Recorder) (*AWSTerraformProvider, error) {
 	p := &AWSTerraformProvider{}
 	providerKey := "aws"
 	installer, err := tf.NewProviderInstaller(tf.ProviderConfig{
@@ -53,18 +81,37 @@ func NewAWSTerraformProvider(version string, progress output.Progress, configDir
 	if err != nil {
 		return nil, err
 	}
+	sessionConfig := request.WithRetryer(awssdk.NewConfig(), client.DefaultRetryer{
+		NumMaxRetries:    opts.Retry.MaxRetries,
+		MinRetryDelay:    opts.Retry.MinBackoff,
+		MaxRetryDelay:    opts.Retry.MaxBackoff,
+		MinThrottleDelay: opts.Retry.MinBackoff,
+		MaxThrottleDelay: opts.Retry.MaxBackoff,
+	})
 	p.session = session.Must(session.NewSessionWithOptions(session.Options{
+		Config:            *sessionConfig,
 		SharedConfigState: session.SharedConfigEnable,
 	}))
-	tfProvider, err := terraform.NewTerraformProvider(installer, terraform.TerraformProviderConfig{
+	// Sessions copied for other regions and accounts share the same limiter
+	limiter := retry.NewRateLimiter(opts.Retry.RateLimit)
+	p.session.Handlers.Send.PushFront(func(r *request.Request) {
+		limiter.Wait(r.ClientInfo.ServiceName)
+	})
+	p.session.Handlers.Complete.PushBack(func(r *request.Request) {
+		recorder.RecordAPICall(r.ClientInfo.ServiceName, time.Since(r.Time), r.RetryCount)
+	})
+	tfProvider, err := terraform.NewTerraformProvider(ctx, installer, terraform.TerraformProviderConfig{
 		Name:         providerKey,
 		DefaultAlias: *p.session.Config.Region,
 		GetProviderConfig: func(alias string) interface{} {
 			return awsConfig{
 				Region:     alias,
-				MaxRetries: 10, // TODO make this configurable
+				MaxRetries: opts.Retry.MaxRetries,
 			}
 		},
+		Concurrency: opts.Concurrency,
+		Retry:       opts.Retry,
+		Stats:       recorder,
 	}, progress)
 	if err != nil {
 		return nil, err
@@ -72,3 +119,127 @@ func NewAWSTerraformProvider(version string, progress output.Progress, configDir
 	p.TerraformProvider = tfProvider
 	return p, err
 }
+
+// ForAccount returns a provider reading resources of the given account by assuming its role.
+// The returned provider is initialized, it shares gRPC clients with p when no role needs to be assumed.
+func (p *AWSTerraformProvider) ForAccount(account Account, externalId string) (*AWSTerraformProvider, error) {
+	if account.RoleArn == "" {
+		return &AWSTerraformProvider{
+			TerraformProvider: p.TerraformProvider.WithStatsScope(resource.Meta{Account: account.Id}),
+			session:           p.session,
+		}, nil
+	}
+
+	assumeRole := awsAssumeRoleConfig{
+		RoleARN:     account.RoleArn,
+		ExternalID:  externalId,
+		SessionName: assumeRoleSessionName,
+	}
+	credentials := stscreds.NewCredentials(p.session, account.RoleArn, func(provider *stscreds.AssumeRoleProvider) {
+		provider.RoleSessionName = assumeRoleSessionName
+		if externalId != "" {
+			provider.ExternalID = awssdk.String(externalId)
+		}
+	})
+
+	accountProvider := &AWSTerraformProvider{
+		session: p.session.Copy(&awssdk.Config{Credentials: credentials}),
+	}
+	accountProvider.TerraformProvider = p.TerraformProvider.WithConfig(terraform.TerraformProviderConfig{
+		Name:         p.Config.Name,
+		DefaultAlias: p.Region(),
+		GetProviderConfig: func(alias string) interface{} {
+			return awsConfig{
+				Region:     alias,
+				MaxRetries: p.Config.Retry.MaxRetries,
+				AssumeRole: []awsAssumeRoleConfig{assumeRole},
+			}
+		},
+		Concurrency: p.Config.Concurrency,
+		Retry:       p.Config.Retry,
+		Stats:       p.Config.Stats,
+	}).WithStatsScope(resource.Meta{Account: account.Id})
+	p.assumed = append(p.assumed, accountProvider)
+
+	logrus.WithFields(logrus.Fields{
+		"account": account.Id,
+		"role":    account.RoleArn,
+	}).Debug("Assuming role to scan account")
+
+	if err := accountProvider.Init(); err != nil {
+		return nil, errors.Wrapf(err, "unable to assume role %s", account.RoleArn)
+	}
+	return accountProvider, nil
+}
+
+// CallerAccountId returns the ID of the account the session credentials belong to
+func (p *AWSTerraformProvider) CallerAccountId() (string, error) {
+	output, err := sts.New(p.session).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", err
+	}
+	return *output.Account, nil
+}
+
+func (p *AWSTerraformProvider) Cleanup() {
+	for _, assumed := range p.assumed {
+		assumed.Cleanup()
+	}
+	p.TerraformProvider.Cleanup()
+}
+
+// ForRegion returns a provider reading resources from the given region.
+// It shares gRPC clients with p, one provider alias being created per region.
+func (p *AWSTerraformProvider) ForRegion(region string) *AWSTerraformProvider {
+	return &AWSTerraformProvider{
+		TerraformProvider: p.TerraformProvider.WithAlias(region).WithStatsScope(resource.Meta{
+			Account: p.StatsScope().Account,
+			Region:  region,
+		}),
+		session: p.session.Copy(&awssdk.Config{Region: awssdk.String(region)}),
+	}
+}
+
+// WithCache returns a provider reading resources from the given cache first
+func (p *AWSTerraformProvider) WithCache(c cache.Cache) *AWSTerraformProvider {
+	return &AWSTerraformProvider{
+		TerraformProvider: p.TerraformProvider.WithCache(c),
+		session:           p.session,
+	}
+}
+
+// Region returns the region resources are read from by default
+func (p *AWSTerraformProvider) Region() string {
+	return p.Config.DefaultAlias
+}
+
+// ResolveRegions returns regions to scan, "all" stands for every region enabled for the account.
+// The session default region is scanned when no region is given.
+func (p *AWSTerraformProvider) ResolveRegions(regions []string) ([]string, error) {
+	if len(regions) == 0 {
+		return []string{p.Region()}, nil
+	}
+
+	resolved := make([]string, 0, len(regions))
+	seen := make(map[string]struct{}, len(regions))
+	for _, region := range regions {
+		if region != AllRegions {
+			if _, exist := seen[region]; !exist {
+				seen[region] = struct{}{}
+				resolved = append(resolved, region)
+			}
+			continue
+		}
+		output, err := ec2.New(p.session).DescribeRegions(&ec2.DescribeRegionsInput{})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range output.Regions {
+			if _, exist := seen[*r.RegionName]; !exist {
+				seen[*r.RegionName] = struct{}{}
+				resolved = append(resolved, *r.RegionName)
+			}
+		}
+	}
+	return resolved, nil
+}
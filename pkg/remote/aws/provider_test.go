@@ -0,0 +1,21 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/pkg/remote/terraform"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+func TestAWSTerraformProvider_ForAccount_WithoutRole(t *testing.T) {
+	provider := &AWSTerraformProvider{TerraformProvider: &terraform.TerraformProvider{}}
+
+	accountProvider, err := provider.ForAccount(Account{Id: "000000000000"}, "")
+	assert.Nil(t, err)
+	// Reads are attributed to the account its resources are tagged with
+	assert.Equal(t, resource.Meta{Account: "000000000000"}, accountProvider.StatsScope())
+	assert.Equal(t, resource.Meta{}, provider.StatsScope())
+	assert.Empty(t, provider.assumed)
+}
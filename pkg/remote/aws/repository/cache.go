@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sns"
+
+	"github.com/cloudskiff/driftctl/pkg/remote/cache"
+)
+
+// Types of values cached by repositories, they can be saved on disk and read by next runs
+func init() {
+	cache.RegisterType(
+		"",
+		[]string{},
+		[]*string{},
+		[]*cloudfront.DistributionSummary{},
+		[]*ec2.Address{},
+		[]*ec2.Image{},
+		[]*ec2.Instance{},
+		[]*ec2.InternetGateway{},
+		[]*ec2.KeyPairInfo{},
+		[]*ec2.NatGateway{},
+		[]*ec2.RouteTable{},
+		[]*ec2.SecurityGroup{},
+		[]*ec2.Snapshot{},
+		[]*ec2.Subnet{},
+		[]*ec2.Volume{},
+		[]*ec2.Vpc{},
+		[]*ecr.Repository{},
+		[]*iam.AccessKeyMetadata{},
+		[]*iam.Policy{},
+		[]*iam.Role{},
+		[]*iam.User{},
+		[]*AttachedRolePolicy{},
+		[]*AttachedUserPolicy{},
+		[]*kms.AliasListEntry{},
+		[]*kms.KeyListEntry{},
+		[]*lambda.EventSourceMappingConfiguration{},
+		[]*lambda.FunctionConfiguration{},
+		[]*organizations.Account{},
+		[]*rds.DBInstance{},
+		[]*rds.DBSubnetGroup{},
+		[]*route53.HealthCheck{},
+		[]*route53.HostedZone{},
+		[]*route53.ResourceRecordSet{},
+		[]*s3.AnalyticsConfiguration{},
+		[]*s3.Bucket{},
+		[]*s3.InventoryConfiguration{},
+		[]*s3.MetricsConfiguration{},
+		[]*sns.Subscription{},
+		[]*sns.Topic{},
+	)
+}
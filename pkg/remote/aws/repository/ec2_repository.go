@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -9,19 +10,19 @@ import (
 )
 
 type EC2Repository interface {
-	ListAllImages() ([]*ec2.Image, error)
-	ListAllSnapshots() ([]*ec2.Snapshot, error)
-	ListAllVolumes() ([]*ec2.Volume, error)
-	ListAllAddresses() ([]*ec2.Address, error)
-	ListAllAddressesAssociation() ([]string, error)
-	ListAllInstances() ([]*ec2.Instance, error)
-	ListAllKeyPairs() ([]*ec2.KeyPairInfo, error)
-	ListAllInternetGateways() ([]*ec2.InternetGateway, error)
-	ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, error)
-	ListAllNatGateways() ([]*ec2.NatGateway, error)
-	ListAllRouteTables() ([]*ec2.RouteTable, error)
-	ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error)
-	ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error)
+	ListAllImages(ctx context.Context) ([]*ec2.Image, error)
+	ListAllSnapshots(ctx context.Context) ([]*ec2.Snapshot, error)
+	ListAllVolumes(ctx context.Context) ([]*ec2.Volume, error)
+	ListAllAddresses(ctx context.Context) ([]*ec2.Address, error)
+	ListAllAddressesAssociation(ctx context.Context) ([]string, error)
+	ListAllInstances(ctx context.Context) ([]*ec2.Instance, error)
+	ListAllKeyPairs(ctx context.Context) ([]*ec2.KeyPairInfo, error)
+	ListAllInternetGateways(ctx context.Context) ([]*ec2.InternetGateway, error)
+	ListAllSubnets(ctx context.Context) ([]*ec2.Subnet, []*ec2.Subnet, error)
+	ListAllNatGateways(ctx context.Context) ([]*ec2.NatGateway, error)
+	ListAllRouteTables(ctx context.Context) ([]*ec2.RouteTable, error)
+	ListAllVPCs(ctx context.Context) ([]*ec2.Vpc, []*ec2.Vpc, error)
+	ListAllSecurityGroups(ctx context.Context) ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error)
 }
 
 type EC2Client interface {
@@ -40,7 +41,7 @@ func NewEC2Repository(session *session.Session, c cache.Cache) *ec2Repository {
 	}
 }
 
-func (r *ec2Repository) ListAllImages() ([]*ec2.Image, error) {
+func (r *ec2Repository) ListAllImages(ctx context.Context) ([]*ec2.Image, error) {
 	if v := r.cache.Get("ec2ListAllImages"); v != nil {
 		return v.([]*ec2.Image), nil
 	}
@@ -50,7 +51,7 @@ func (r *ec2Repository) ListAllImages() ([]*ec2.Image, error) {
 			aws.String("self"),
 		},
 	}
-	images, err := r.client.DescribeImages(input)
+	images, err := r.client.DescribeImagesWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +59,7 @@ func (r *ec2Repository) ListAllImages() ([]*ec2.Image, error) {
 	return images.Images, err
 }
 
-func (r *ec2Repository) ListAllSnapshots() ([]*ec2.Snapshot, error) {
+func (r *ec2Repository) ListAllSnapshots(ctx context.Context) ([]*ec2.Snapshot, error) {
 	if v := r.cache.Get("ec2ListAllSnapshots"); v != nil {
 		return v.([]*ec2.Snapshot), nil
 	}
@@ -69,7 +70,7 @@ func (r *ec2Repository) ListAllSnapshots() ([]*ec2.Snapshot, error) {
 			aws.String("self"),
 		},
 	}
-	err := r.client.DescribeSnapshotsPages(input, func(res *ec2.DescribeSnapshotsOutput, lastPage bool) bool {
+	err := r.client.DescribeSnapshotsPagesWithContext(ctx, input, func(res *ec2.DescribeSnapshotsOutput, lastPage bool) bool {
 		snapshots = append(snapshots, res.Snapshots...)
 		return !lastPage
 	})
@@ -80,14 +81,14 @@ func (r *ec2Repository) ListAllSnapshots() ([]*ec2.Snapshot, error) {
 	return snapshots, err
 }
 
-func (r *ec2Repository) ListAllVolumes() ([]*ec2.Volume, error) {
+func (r *ec2Repository) ListAllVolumes(ctx context.Context) ([]*ec2.Volume, error) {
 	if v := r.cache.Get("ec2ListAllVolumes"); v != nil {
 		return v.([]*ec2.Volume), nil
 	}
 
 	var volumes []*ec2.Volume
 	input := &ec2.DescribeVolumesInput{}
-	err := r.client.DescribeVolumesPages(input, func(res *ec2.DescribeVolumesOutput, lastPage bool) bool {
+	err := r.client.DescribeVolumesPagesWithContext(ctx, input, func(res *ec2.DescribeVolumesOutput, lastPage bool) bool {
 		volumes = append(volumes, res.Volumes...)
 		return !lastPage
 	})
@@ -98,13 +99,13 @@ func (r *ec2Repository) ListAllVolumes() ([]*ec2.Volume, error) {
 	return volumes, nil
 }
 
-func (r *ec2Repository) ListAllAddresses() ([]*ec2.Address, error) {
+func (r *ec2Repository) ListAllAddresses(ctx context.Context) ([]*ec2.Address, error) {
 	if v := r.cache.Get("ec2ListAllAddresses"); v != nil {
 		return v.([]*ec2.Address), nil
 	}
 
 	input := &ec2.DescribeAddressesInput{}
-	response, err := r.client.DescribeAddresses(input)
+	response, err := r.client.DescribeAddressesWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -112,13 +113,13 @@ func (r *ec2Repository) ListAllAddresses() ([]*ec2.Address, error) {
 	return response.Addresses, nil
 }
 
-func (r *ec2Repository) ListAllAddressesAssociation() ([]string, error) {
+func (r *ec2Repository) ListAllAddressesAssociation(ctx context.Context) ([]string, error) {
 	if v := r.cache.Get("ec2ListAllAddressesAssociation"); v != nil {
 		return v.([]string), nil
 	}
 
 	results := make([]string, 0)
-	addresses, err := r.ListAllAddresses()
+	addresses, err := r.ListAllAddresses(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -131,14 +132,14 @@ func (r *ec2Repository) ListAllAddressesAssociation() ([]string, error) {
 	return results, nil
 }
 
-func (r *ec2Repository) ListAllInstances() ([]*ec2.Instance, error) {
+func (r *ec2Repository) ListAllInstances(ctx context.Context) ([]*ec2.Instance, error) {
 	if v := r.cache.Get("ec2ListAllInstances"); v != nil {
 		return v.([]*ec2.Instance), nil
 	}
 
 	var instances []*ec2.Instance
 	input := &ec2.DescribeInstancesInput{}
-	err := r.client.DescribeInstancesPages(input, func(res *ec2.DescribeInstancesOutput, lastPage bool) bool {
+	err := r.client.DescribeInstancesPagesWithContext(ctx, input, func(res *ec2.DescribeInstancesOutput, lastPage bool) bool {
 		for _, reservation := range res.Reservations {
 			instances = append(instances, reservation.Instances...)
 		}
@@ -151,13 +152,13 @@ func (r *ec2Repository) ListAllInstances() ([]*ec2.Instance, error) {
 	return instances, nil
 }
 
-func (r *ec2Repository) ListAllKeyPairs() ([]*ec2.KeyPairInfo, error) {
+func (r *ec2Repository) ListAllKeyPairs(ctx context.Context) ([]*ec2.KeyPairInfo, error) {
 	if v := r.cache.Get("ec2ListAllKeyPairs"); v != nil {
 		return v.([]*ec2.KeyPairInfo), nil
 	}
 
 	input := &ec2.DescribeKeyPairsInput{}
-	pairs, err := r.client.DescribeKeyPairs(input)
+	pairs, err := r.client.DescribeKeyPairsWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -165,14 +166,14 @@ func (r *ec2Repository) ListAllKeyPairs() ([]*ec2.KeyPairInfo, error) {
 	return pairs.KeyPairs, err
 }
 
-func (r *ec2Repository) ListAllInternetGateways() ([]*ec2.InternetGateway, error) {
+func (r *ec2Repository) ListAllInternetGateways(ctx context.Context) ([]*ec2.InternetGateway, error) {
 	if v := r.cache.Get("ec2ListAllInternetGateways"); v != nil {
 		return v.([]*ec2.InternetGateway), nil
 	}
 
 	var internetGateways []*ec2.InternetGateway
 	input := ec2.DescribeInternetGatewaysInput{}
-	err := r.client.DescribeInternetGatewaysPages(&input,
+	err := r.client.DescribeInternetGatewaysPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeInternetGatewaysOutput, lastPage bool) bool {
 			internetGateways = append(internetGateways, resp.InternetGateways...)
 			return !lastPage
@@ -185,7 +186,7 @@ func (r *ec2Repository) ListAllInternetGateways() ([]*ec2.InternetGateway, error
 	return internetGateways, nil
 }
 
-func (r *ec2Repository) ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, error) {
+func (r *ec2Repository) ListAllSubnets(ctx context.Context) ([]*ec2.Subnet, []*ec2.Subnet, error) {
 	cacheSubnets := r.cache.Get("ec2ListAllSubnets")
 	cacheDefaultSubnets := r.cache.Get("ec2ListAllDefaultSubnets")
 	if cacheSubnets != nil && cacheDefaultSubnets != nil {
@@ -195,7 +196,7 @@ func (r *ec2Repository) ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, error) {
 	input := ec2.DescribeSubnetsInput{}
 	var subnets []*ec2.Subnet
 	var defaultSubnets []*ec2.Subnet
-	err := r.client.DescribeSubnetsPages(&input,
+	err := r.client.DescribeSubnetsPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeSubnetsOutput, lastPage bool) bool {
 			for _, subnet := range resp.Subnets {
 				if subnet.DefaultForAz != nil && *subnet.DefaultForAz {
@@ -214,14 +215,14 @@ func (r *ec2Repository) ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, error) {
 	return subnets, defaultSubnets, nil
 }
 
-func (r *ec2Repository) ListAllNatGateways() ([]*ec2.NatGateway, error) {
+func (r *ec2Repository) ListAllNatGateways(ctx context.Context) ([]*ec2.NatGateway, error) {
 	if v := r.cache.Get("ec2ListAllNatGateways"); v != nil {
 		return v.([]*ec2.NatGateway), nil
 	}
 
 	var result []*ec2.NatGateway
 	input := ec2.DescribeNatGatewaysInput{}
-	err := r.client.DescribeNatGatewaysPages(&input,
+	err := r.client.DescribeNatGatewaysPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeNatGatewaysOutput, lastPage bool) bool {
 			result = append(result, resp.NatGateways...)
 			return !lastPage
@@ -236,14 +237,14 @@ func (r *ec2Repository) ListAllNatGateways() ([]*ec2.NatGateway, error) {
 	return result, nil
 }
 
-func (r *ec2Repository) ListAllRouteTables() ([]*ec2.RouteTable, error) {
+func (r *ec2Repository) ListAllRouteTables(ctx context.Context) ([]*ec2.RouteTable, error) {
 	if v := r.cache.Get("ec2ListAllRouteTables"); v != nil {
 		return v.([]*ec2.RouteTable), nil
 	}
 
 	var routeTables []*ec2.RouteTable
 	input := ec2.DescribeRouteTablesInput{}
-	err := r.client.DescribeRouteTablesPages(&input,
+	err := r.client.DescribeRouteTablesPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeRouteTablesOutput, lastPage bool) bool {
 			routeTables = append(routeTables, resp.RouteTables...)
 			return !lastPage
@@ -258,7 +259,7 @@ func (r *ec2Repository) ListAllRouteTables() ([]*ec2.RouteTable, error) {
 	return routeTables, nil
 }
 
-func (r *ec2Repository) ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error) {
+func (r *ec2Repository) ListAllVPCs(ctx context.Context) ([]*ec2.Vpc, []*ec2.Vpc, error) {
 	cacheVPCs := r.cache.Get("ec2ListAllVPCs")
 	cacheDefaultVPCs := r.cache.Get("ec2ListAllDefaultVPCs")
 	if cacheVPCs != nil && cacheDefaultVPCs != nil {
@@ -268,7 +269,7 @@ func (r *ec2Repository) ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error) {
 	input := ec2.DescribeVpcsInput{}
 	var VPCs []*ec2.Vpc
 	var defaultVPCs []*ec2.Vpc
-	err := r.client.DescribeVpcsPages(&input,
+	err := r.client.DescribeVpcsPagesWithContext(ctx, &input,
 		func(resp *ec2.DescribeVpcsOutput, lastPage bool) bool {
 			for _, vpc := range resp.Vpcs {
 				if vpc.IsDefault != nil && *vpc.IsDefault {
@@ -289,7 +290,7 @@ func (r *ec2Repository) ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error) {
 	return VPCs, defaultVPCs, nil
 }
 
-func (r *ec2Repository) ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error) {
+func (r *ec2Repository) ListAllSecurityGroups(ctx context.Context) ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error) {
 	cacheSecurityGroups := r.cache.Get("ec2ListAllSecurityGroups")
 	cacheDefaultSecurityGroups := r.cache.Get("ec2ListAllDefaultSecurityGroups")
 	if cacheSecurityGroups != nil && cacheDefaultSecurityGroups != nil {
@@ -299,7 +300,7 @@ func (r *ec2Repository) ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*ec2.Se
 	var securityGroups []*ec2.SecurityGroup
 	var defaultSecurityGroups []*ec2.SecurityGroup
 	input := &ec2.DescribeSecurityGroupsInput{}
-	err := r.client.DescribeSecurityGroupsPages(input, func(res *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+	err := r.client.DescribeSecurityGroupsPagesWithContext(ctx, input, func(res *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
 		for _, securityGroup := range res.SecurityGroups {
 			if securityGroup.GroupName != nil && *securityGroup.GroupName == "default" {
 				defaultSecurityGroups = append(defaultSecurityGroups, securityGroup)
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -26,7 +27,7 @@ func Test_ec2Repository_ListAllImages(t *testing.T) {
 		{
 			name: "List all images",
 			mocks: func(client *MockEC2Client) {
-				client.On("DescribeImages",
+				client.On("DescribeImagesWithContext", mock.Anything,
 					&ec2.DescribeImagesInput{
 						Owners: []*string{
 							aws.String("self"),
@@ -57,12 +58,12 @@ func Test_ec2Repository_ListAllImages(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllImages()
+			got, err := r.ListAllImages(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllImages()
+				cachedData, err := r.ListAllImages(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.Image{}, store.Get("ec2ListAllImages"))
@@ -89,7 +90,7 @@ func Test_ec2Repository_ListAllSnapshots(t *testing.T) {
 	}{
 		{name: "List with 2 pages",
 			mocks: func(client *MockEC2Client) {
-				client.On("DescribeSnapshotsPages",
+				client.On("DescribeSnapshotsPagesWithContext", mock.Anything,
 					&ec2.DescribeSnapshotsInput{
 						OwnerIds: []*string{
 							aws.String("self"),
@@ -136,12 +137,12 @@ func Test_ec2Repository_ListAllSnapshots(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllSnapshots()
+			got, err := r.ListAllSnapshots(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllSnapshots()
+				cachedData, err := r.ListAllSnapshots(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.Snapshot{}, store.Get("ec2ListAllSnapshots"))
@@ -168,7 +169,7 @@ func Test_ec2Repository_ListAllVolumes(t *testing.T) {
 	}{
 		{name: "List with 2 pages",
 			mocks: func(client *MockEC2Client) {
-				client.On("DescribeVolumesPages",
+				client.On("DescribeVolumesPagesWithContext", mock.Anything,
 					&ec2.DescribeVolumesInput{},
 					mock.MatchedBy(func(callback func(res *ec2.DescribeVolumesOutput, lastPage bool) bool) bool {
 						callback(&ec2.DescribeVolumesOutput{
@@ -211,12 +212,12 @@ func Test_ec2Repository_ListAllVolumes(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllVolumes()
+			got, err := r.ListAllVolumes(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllVolumes()
+				cachedData, err := r.ListAllVolumes(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.Volume{}, store.Get("ec2ListAllVolumes"))
@@ -244,7 +245,7 @@ func Test_ec2Repository_ListAllAddresses(t *testing.T) {
 		{
 			name: "List address",
 			mocks: func(client *MockEC2Client) {
-				client.On("DescribeAddresses", &ec2.DescribeAddressesInput{}).
+				client.On("DescribeAddressesWithContext", mock.Anything, &ec2.DescribeAddressesInput{}).
 					Return(&ec2.DescribeAddressesOutput{
 						Addresses: []*ec2.Address{
 							{AssociationId: aws.String("1")},
@@ -271,12 +272,12 @@ func Test_ec2Repository_ListAllAddresses(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllAddresses()
+			got, err := r.ListAllAddresses(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllAddresses()
+				cachedData, err := r.ListAllAddresses(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.Address{}, store.Get("ec2ListAllAddresses"))
@@ -304,7 +305,7 @@ func Test_ec2Repository_ListAllAddressesAssociation(t *testing.T) {
 		{
 			name: "List address",
 			mocks: func(client *MockEC2Client) {
-				client.On("DescribeAddresses", &ec2.DescribeAddressesInput{}).
+				client.On("DescribeAddressesWithContext", mock.Anything, &ec2.DescribeAddressesInput{}).
 					Return(&ec2.DescribeAddressesOutput{
 						Addresses: []*ec2.Address{
 							{AssociationId: aws.String("1")},
@@ -331,12 +332,12 @@ func Test_ec2Repository_ListAllAddressesAssociation(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllAddressesAssociation()
+			got, err := r.ListAllAddressesAssociation(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllAddressesAssociation()
+				cachedData, err := r.ListAllAddressesAssociation(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []string{}, store.Get("ec2ListAllAddressesAssociation"))
@@ -363,7 +364,7 @@ func Test_ec2Repository_ListAllInstances(t *testing.T) {
 	}{
 		{name: "List with 2 pages",
 			mocks: func(client *MockEC2Client) {
-				client.On("DescribeInstancesPages",
+				client.On("DescribeInstancesPagesWithContext", mock.Anything,
 					&ec2.DescribeInstancesInput{},
 					mock.MatchedBy(func(callback func(res *ec2.DescribeInstancesOutput, lastPage bool) bool) bool {
 						callback(&ec2.DescribeInstancesOutput{
@@ -430,12 +431,12 @@ func Test_ec2Repository_ListAllInstances(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllInstances()
+			got, err := r.ListAllInstances(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllInstances()
+				cachedData, err := r.ListAllInstances(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.Instance{}, store.Get("ec2ListAllInstances"))
@@ -462,7 +463,7 @@ func Test_ec2Repository_ListAllKeyPairs(t *testing.T) {
 		{
 			name: "List address",
 			mocks: func(client *MockEC2Client) {
-				client.On("DescribeKeyPairs", &ec2.DescribeKeyPairsInput{}).
+				client.On("DescribeKeyPairsWithContext", mock.Anything, &ec2.DescribeKeyPairsInput{}).
 					Return(&ec2.DescribeKeyPairsOutput{
 						KeyPairs: []*ec2.KeyPairInfo{
 							{KeyPairId: aws.String("1")},
@@ -489,12 +490,12 @@ func Test_ec2Repository_ListAllKeyPairs(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllKeyPairs()
+			got, err := r.ListAllKeyPairs(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllKeyPairs()
+				cachedData, err := r.ListAllKeyPairs(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.KeyPairInfo{}, store.Get("ec2ListAllKeyPairs"))
@@ -522,7 +523,7 @@ func Test_ec2Repository_ListAllInternetGateways(t *testing.T) {
 		{
 			name: "List only gateways with multiple pages",
 			mocks: func(client *MockEC2Client) {
-				client.On("DescribeInternetGatewaysPages",
+				client.On("DescribeInternetGatewaysPagesWithContext", mock.Anything,
 					&ec2.DescribeInternetGatewaysInput{},
 					mock.MatchedBy(func(callback func(res *ec2.DescribeInternetGatewaysOutput, lastPage bool) bool) bool {
 						callback(&ec2.DescribeInternetGatewaysOutput{
@@ -573,12 +574,12 @@ func Test_ec2Repository_ListAllInternetGateways(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllInternetGateways()
+			got, err := r.ListAllInternetGateways(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllInternetGateways()
+				cachedData, err := r.ListAllInternetGateways(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.InternetGateway{}, store.Get("ec2ListAllInternetGateways"))
@@ -607,7 +608,7 @@ func Test_ec2Repository_ListAllSubnets(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *MockEC2Client) {
-				client.On("DescribeSubnetsPages",
+				client.On("DescribeSubnetsPagesWithContext", mock.Anything,
 					&ec2.DescribeSubnetsInput{},
 					mock.MatchedBy(func(callback func(res *ec2.DescribeSubnetsOutput, lastPage bool) bool) bool {
 						callback(&ec2.DescribeSubnetsOutput{
@@ -684,12 +685,12 @@ func Test_ec2Repository_ListAllSubnets(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			gotSubnet, gotDefaultSubnet, err := r.ListAllSubnets()
+			gotSubnet, gotDefaultSubnet, err := r.ListAllSubnets(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, cachedDefaultData, err := r.ListAllSubnets()
+				cachedData, cachedDefaultData, err := r.ListAllSubnets(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, gotSubnet, cachedData)
 				assert.Equal(t, gotDefaultSubnet, cachedDefaultData)
@@ -727,7 +728,7 @@ func Test_ec2Repository_ListAllNatGateways(t *testing.T) {
 		{
 			name: "List only gateways with multiple pages",
 			mocks: func(client *MockEC2Client) {
-				client.On("DescribeNatGatewaysPages",
+				client.On("DescribeNatGatewaysPagesWithContext", mock.Anything,
 					&ec2.DescribeNatGatewaysInput{},
 					mock.MatchedBy(func(callback func(res *ec2.DescribeNatGatewaysOutput, lastPage bool) bool) bool {
 						callback(&ec2.DescribeNatGatewaysOutput{
@@ -778,12 +779,12 @@ func Test_ec2Repository_ListAllNatGateways(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllNatGateways()
+			got, err := r.ListAllNatGateways(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllNatGateways()
+				cachedData, err := r.ListAllNatGateways(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.NatGateway{}, store.Get("ec2ListAllNatGateways"))
@@ -811,7 +812,7 @@ func Test_ec2Repository_ListAllRouteTables(t *testing.T) {
 		{
 			name: "List only route with multiple pages",
 			mocks: func(client *MockEC2Client) {
-				client.On("DescribeRouteTablesPages",
+				client.On("DescribeRouteTablesPagesWithContext", mock.Anything,
 					&ec2.DescribeRouteTablesInput{},
 					mock.MatchedBy(func(callback func(res *ec2.DescribeRouteTablesOutput, lastPage bool) bool) bool {
 						callback(&ec2.DescribeRouteTablesOutput{
@@ -970,12 +971,12 @@ func Test_ec2Repository_ListAllRouteTables(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllRouteTables()
+			got, err := r.ListAllRouteTables(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllRouteTables()
+				cachedData, err := r.ListAllRouteTables(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ec2.RouteTable{}, store.Get("ec2ListAllRouteTables"))
@@ -1004,7 +1005,7 @@ func Test_ec2Repository_ListAllVPCs(t *testing.T) {
 		{
 			name: "mixed default VPC and VPC",
 			mocks: func(client *MockEC2Client) {
-				client.On("DescribeVpcsPages",
+				client.On("DescribeVpcsPagesWithContext", mock.Anything,
 					&ec2.DescribeVpcsInput{},
 					mock.MatchedBy(func(callback func(res *ec2.DescribeVpcsOutput, lastPage bool) bool) bool {
 						callback(&ec2.DescribeVpcsOutput{
@@ -1063,12 +1064,12 @@ func Test_ec2Repository_ListAllVPCs(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			gotVPCs, gotDefaultVPCs, err := r.ListAllVPCs()
+			gotVPCs, gotDefaultVPCs, err := r.ListAllVPCs(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, cachedDefaultData, err := r.ListAllVPCs()
+				cachedData, cachedDefaultData, err := r.ListAllVPCs(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, gotVPCs, cachedData)
 				assert.Equal(t, gotDefaultVPCs, cachedDefaultData)
@@ -1107,7 +1108,7 @@ func Test_ec2Repository_ListAllSecurityGroups(t *testing.T) {
 		{
 			name: "List with 1 pages",
 			mocks: func(client *MockEC2Client) {
-				client.On("DescribeSecurityGroupsPages",
+				client.On("DescribeSecurityGroupsPagesWithContext", mock.Anything,
 					&ec2.DescribeSecurityGroupsInput{},
 					mock.MatchedBy(func(callback func(res *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool) bool {
 						callback(&ec2.DescribeSecurityGroupsOutput{
@@ -1148,12 +1149,12 @@ func Test_ec2Repository_ListAllSecurityGroups(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			gotSecurityGroups, gotDefaultSecurityGroups, err := r.ListAllSecurityGroups()
+			gotSecurityGroups, gotDefaultSecurityGroups, err := r.ListAllSecurityGroups(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, cachedDefaultData, err := r.ListAllSecurityGroups()
+				cachedData, cachedDefaultData, err := r.ListAllSecurityGroups(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, gotSecurityGroups, cachedData)
 				assert.Equal(t, gotDefaultSecurityGroups, cachedDefaultData)
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
@@ -8,7 +9,7 @@ import (
 )
 
 type ECRRepository interface {
-	ListAllRepositories() ([]*ecr.Repository, error)
+	ListAllRepositories(ctx context.Context) ([]*ecr.Repository, error)
 }
 
 type ecrRepository struct {
@@ -23,14 +24,14 @@ func NewECRRepository(session *session.Session, c cache.Cache) *ecrRepository {
 	}
 }
 
-func (r *ecrRepository) ListAllRepositories() ([]*ecr.Repository, error) {
+func (r *ecrRepository) ListAllRepositories(ctx context.Context) ([]*ecr.Repository, error) {
 	if v := r.cache.Get("ecrListAllRepositories"); v != nil {
 		return v.([]*ecr.Repository), nil
 	}
 
 	var repositories []*ecr.Repository
 	input := &ecr.DescribeRepositoriesInput{}
-	err := r.client.DescribeRepositoriesPages(input, func(res *ecr.DescribeRepositoriesOutput, lastPage bool) bool {
+	err := r.client.DescribeRepositoriesPagesWithContext(ctx, input, func(res *ecr.DescribeRepositoriesOutput, lastPage bool) bool {
 		repositories = append(repositories, res.Repositories...)
 		return !lastPage
 	})
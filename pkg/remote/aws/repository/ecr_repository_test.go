@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -27,7 +28,7 @@ func Test_ecrRepository_ListAllRepositories(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *awstest.MockFakeECR) {
-				client.On("DescribeRepositoriesPages",
+				client.On("DescribeRepositoriesPagesWithContext", mock.Anything,
 					&ecr.DescribeRepositoriesInput{},
 					mock.MatchedBy(func(callback func(res *ecr.DescribeRepositoriesOutput, lastPage bool) bool) bool {
 						callback(&ecr.DescribeRepositoriesOutput{
@@ -66,12 +67,12 @@ func Test_ecrRepository_ListAllRepositories(t *testing.T) {
 				client: &client,
 				cache:  store,
 			}
-			got, err := r.ListAllRepositories()
+			got, err := r.ListAllRepositories(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllRepositories()
+				cachedData, err := r.ListAllRepositories(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*ecr.Repository{}, store.Get("ecrListAllRepositories"))
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,14 +12,14 @@ import (
 )
 
 type IAMRepository interface {
-	ListAllAccessKeys([]*iam.User) ([]*iam.AccessKeyMetadata, error)
-	ListAllUsers() ([]*iam.User, error)
-	ListAllPolicies() ([]*iam.Policy, error)
-	ListAllRoles() ([]*iam.Role, error)
-	ListAllRolePolicyAttachments([]*iam.Role) ([]*AttachedRolePolicy, error)
-	ListAllRolePolicies([]*iam.Role) ([]string, error)
-	ListAllUserPolicyAttachments([]*iam.User) ([]*AttachedUserPolicy, error)
-	ListAllUserPolicies([]*iam.User) ([]string, error)
+	ListAllAccessKeys(ctx context.Context, users []*iam.User) ([]*iam.AccessKeyMetadata, error)
+	ListAllUsers(ctx context.Context) ([]*iam.User, error)
+	ListAllPolicies(ctx context.Context) ([]*iam.Policy, error)
+	ListAllRoles(ctx context.Context) ([]*iam.Role, error)
+	ListAllRolePolicyAttachments(ctx context.Context, roles []*iam.Role) ([]*AttachedRolePolicy, error)
+	ListAllRolePolicies(ctx context.Context, roles []*iam.Role) ([]string, error)
+	ListAllUserPolicyAttachments(ctx context.Context, users []*iam.User) ([]*AttachedUserPolicy, error)
+	ListAllUserPolicies(ctx context.Context, users []*iam.User) ([]string, error)
 }
 
 type iamRepository struct {
@@ -33,7 +34,7 @@ func NewIAMRepository(session *session.Session, c cache.Cache) *iamRepository {
 	}
 }
 
-func (r *iamRepository) ListAllAccessKeys(users []*iam.User) ([]*iam.AccessKeyMetadata, error) {
+func (r *iamRepository) ListAllAccessKeys(ctx context.Context, users []*iam.User) ([]*iam.AccessKeyMetadata, error) {
 	var resources []*iam.AccessKeyMetadata
 	for _, user := range users {
 		cacheKey := fmt.Sprintf("iamListAllAccessKeys_user_%s", *user.UserName)
@@ -46,7 +47,7 @@ func (r *iamRepository) ListAllAccessKeys(users []*iam.User) ([]*iam.AccessKeyMe
 		input := &iam.ListAccessKeysInput{
 			UserName: user.UserName,
 		}
-		err := r.client.ListAccessKeysPages(input, func(res *iam.ListAccessKeysOutput, lastPage bool) bool {
+		err := r.client.ListAccessKeysPagesWithContext(ctx, input, func(res *iam.ListAccessKeysOutput, lastPage bool) bool {
 			userResources = append(userResources, res.AccessKeyMetadata...)
 			return !lastPage
 		})
@@ -61,14 +62,14 @@ func (r *iamRepository) ListAllAccessKeys(users []*iam.User) ([]*iam.AccessKeyMe
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllUsers() ([]*iam.User, error) {
+func (r *iamRepository) ListAllUsers(ctx context.Context) ([]*iam.User, error) {
 	if v := r.cache.Get("iamListAllUsers"); v != nil {
 		return v.([]*iam.User), nil
 	}
 
 	var resources []*iam.User
 	input := &iam.ListUsersInput{}
-	err := r.client.ListUsersPages(input, func(res *iam.ListUsersOutput, lastPage bool) bool {
+	err := r.client.ListUsersPagesWithContext(ctx, input, func(res *iam.ListUsersOutput, lastPage bool) bool {
 		resources = append(resources, res.Users...)
 		return !lastPage
 	})
@@ -80,7 +81,7 @@ func (r *iamRepository) ListAllUsers() ([]*iam.User, error) {
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllPolicies() ([]*iam.Policy, error) {
+func (r *iamRepository) ListAllPolicies(ctx context.Context) ([]*iam.Policy, error) {
 	if v := r.cache.Get("iamListAllPolicies"); v != nil {
 		return v.([]*iam.Policy), nil
 	}
@@ -89,7 +90,7 @@ func (r *iamRepository) ListAllPolicies() ([]*iam.Policy, error) {
 	input := &iam.ListPoliciesInput{
 		Scope: aws.String(iam.PolicyScopeTypeLocal),
 	}
-	err := r.client.ListPoliciesPages(input, func(res *iam.ListPoliciesOutput, lastPage bool) bool {
+	err := r.client.ListPoliciesPagesWithContext(ctx, input, func(res *iam.ListPoliciesOutput, lastPage bool) bool {
 		resources = append(resources, res.Policies...)
 		return !lastPage
 	})
@@ -101,14 +102,14 @@ func (r *iamRepository) ListAllPolicies() ([]*iam.Policy, error) {
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllRoles() ([]*iam.Role, error) {
+func (r *iamRepository) ListAllRoles(ctx context.Context) ([]*iam.Role, error) {
 	if v := r.cache.Get("iamListAllRoles"); v != nil {
 		return v.([]*iam.Role), nil
 	}
 
 	var resources []*iam.Role
 	input := &iam.ListRolesInput{}
-	err := r.client.ListRolesPages(input, func(res *iam.ListRolesOutput, lastPage bool) bool {
+	err := r.client.ListRolesPagesWithContext(ctx, input, func(res *iam.ListRolesOutput, lastPage bool) bool {
 		resources = append(resources, res.Roles...)
 		return !lastPage
 	})
@@ -120,7 +121,7 @@ func (r *iamRepository) ListAllRoles() ([]*iam.Role, error) {
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllRolePolicyAttachments(roles []*iam.Role) ([]*AttachedRolePolicy, error) {
+func (r *iamRepository) ListAllRolePolicyAttachments(ctx context.Context, roles []*iam.Role) ([]*AttachedRolePolicy, error) {
 	var resources []*AttachedRolePolicy
 	for _, role := range roles {
 		cacheKey := fmt.Sprintf("iamListAllRolePolicyAttachments_role_%s", *role.RoleName)
@@ -133,7 +134,7 @@ func (r *iamRepository) ListAllRolePolicyAttachments(roles []*iam.Role) ([]*Atta
 		input := &iam.ListAttachedRolePoliciesInput{
 			RoleName: role.RoleName,
 		}
-		err := r.client.ListAttachedRolePoliciesPages(input, func(res *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+		err := r.client.ListAttachedRolePoliciesPagesWithContext(ctx, input, func(res *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
 			for _, policy := range res.AttachedPolicies {
 				p := *policy
 				roleResources = append(roleResources, &AttachedRolePolicy{
@@ -154,7 +155,7 @@ func (r *iamRepository) ListAllRolePolicyAttachments(roles []*iam.Role) ([]*Atta
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllRolePolicies(roles []*iam.Role) ([]string, error) {
+func (r *iamRepository) ListAllRolePolicies(ctx context.Context, roles []*iam.Role) ([]string, error) {
 	var resources []string
 	for _, role := range roles {
 		cacheKey := fmt.Sprintf("iamListAllRolePolicies_role_%s", *role.RoleName)
@@ -167,7 +168,7 @@ func (r *iamRepository) ListAllRolePolicies(roles []*iam.Role) ([]string, error)
 		input := &iam.ListRolePoliciesInput{
 			RoleName: role.RoleName,
 		}
-		err := r.client.ListRolePoliciesPages(input, func(res *iam.ListRolePoliciesOutput, lastPage bool) bool {
+		err := r.client.ListRolePoliciesPagesWithContext(ctx, input, func(res *iam.ListRolePoliciesOutput, lastPage bool) bool {
 			for _, policy := range res.PolicyNames {
 				roleResources = append(roleResources, fmt.Sprintf("%s:%s", *input.RoleName, *policy))
 			}
@@ -184,7 +185,7 @@ func (r *iamRepository) ListAllRolePolicies(roles []*iam.Role) ([]string, error)
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllUserPolicyAttachments(users []*iam.User) ([]*AttachedUserPolicy, error) {
+func (r *iamRepository) ListAllUserPolicyAttachments(ctx context.Context, users []*iam.User) ([]*AttachedUserPolicy, error) {
 	var resources []*AttachedUserPolicy
 	for _, user := range users {
 		cacheKey := fmt.Sprintf("iamListAllUserPolicyAttachments_user_%s", *user.UserName)
@@ -197,7 +198,7 @@ func (r *iamRepository) ListAllUserPolicyAttachments(users []*iam.User) ([]*Atta
 		input := &iam.ListAttachedUserPoliciesInput{
 			UserName: user.UserName,
 		}
-		err := r.client.ListAttachedUserPoliciesPages(input, func(res *iam.ListAttachedUserPoliciesOutput, lastPage bool) bool {
+		err := r.client.ListAttachedUserPoliciesPagesWithContext(ctx, input, func(res *iam.ListAttachedUserPoliciesOutput, lastPage bool) bool {
 			for _, policy := range res.AttachedPolicies {
 				p := *policy
 				userResources = append(userResources, &AttachedUserPolicy{
@@ -218,7 +219,7 @@ func (r *iamRepository) ListAllUserPolicyAttachments(users []*iam.User) ([]*Atta
 	return resources, nil
 }
 
-func (r *iamRepository) ListAllUserPolicies(users []*iam.User) ([]string, error) {
+func (r *iamRepository) ListAllUserPolicies(ctx context.Context, users []*iam.User) ([]string, error) {
 	var resources []string
 	for _, user := range users {
 		cacheKey := fmt.Sprintf("iamListAllUserPolicies_user_%s", *user.UserName)
@@ -231,7 +232,7 @@ func (r *iamRepository) ListAllUserPolicies(users []*iam.User) ([]string, error)
 		input := &iam.ListUserPoliciesInput{
 			UserName: user.UserName,
 		}
-		err := r.client.ListUserPoliciesPages(input, func(res *iam.ListUserPoliciesOutput, lastPage bool) bool {
+		err := r.client.ListUserPoliciesPagesWithContext(ctx, input, func(res *iam.ListUserPoliciesOutput, lastPage bool) bool {
 			for _, polName := range res.PolicyNames {
 				userResources = append(userResources, fmt.Sprintf("%s:%s", *input.UserName, *polName))
 			}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -10,8 +11,8 @@ import (
 )
 
 type KMSRepository interface {
-	ListAllKeys() ([]*kms.KeyListEntry, error)
-	ListAllAliases() ([]*kms.AliasListEntry, error)
+	ListAllKeys(ctx context.Context) ([]*kms.KeyListEntry, error)
+	ListAllAliases(ctx context.Context) ([]*kms.AliasListEntry, error)
 }
 
 type kmsRepository struct {
@@ -26,14 +27,14 @@ func NewKMSRepository(session *session.Session, c cache.Cache) *kmsRepository {
 	}
 }
 
-func (r *kmsRepository) ListAllKeys() ([]*kms.KeyListEntry, error) {
+func (r *kmsRepository) ListAllKeys(ctx context.Context) ([]*kms.KeyListEntry, error) {
 	if v := r.cache.Get("kmsListAllKeys"); v != nil {
 		return v.([]*kms.KeyListEntry), nil
 	}
 
 	var keys []*kms.KeyListEntry
 	input := kms.ListKeysInput{}
-	err := r.client.ListKeysPages(&input,
+	err := r.client.ListKeysPagesWithContext(ctx, &input,
 		func(resp *kms.ListKeysOutput, lastPage bool) bool {
 			keys = append(keys, resp.Keys...)
 			return !lastPage
@@ -42,7 +43,7 @@ func (r *kmsRepository) ListAllKeys() ([]*kms.KeyListEntry, error) {
 	if err != nil {
 		return nil, err
 	}
-	customerKeys, err := r.filterKeys(keys)
+	customerKeys, err := r.filterKeys(ctx, keys)
 	if err != nil {
 		return nil, err
 	}
@@ -51,14 +52,14 @@ func (r *kmsRepository) ListAllKeys() ([]*kms.KeyListEntry, error) {
 	return customerKeys, nil
 }
 
-func (r *kmsRepository) ListAllAliases() ([]*kms.AliasListEntry, error) {
+func (r *kmsRepository) ListAllAliases(ctx context.Context) ([]*kms.AliasListEntry, error) {
 	if v := r.cache.Get("kmsListAllAliases"); v != nil {
 		return v.([]*kms.AliasListEntry), nil
 	}
 
 	var aliases []*kms.AliasListEntry
 	input := kms.ListAliasesInput{}
-	err := r.client.ListAliasesPages(&input,
+	err := r.client.ListAliasesPagesWithContext(ctx, &input,
 		func(resp *kms.ListAliasesOutput, lastPage bool) bool {
 			aliases = append(aliases, resp.Aliases...)
 			return !lastPage
@@ -73,10 +74,10 @@ func (r *kmsRepository) ListAllAliases() ([]*kms.AliasListEntry, error) {
 	return result, nil
 }
 
-func (r *kmsRepository) filterKeys(keys []*kms.KeyListEntry) ([]*kms.KeyListEntry, error) {
+func (r *kmsRepository) filterKeys(ctx context.Context, keys []*kms.KeyListEntry) ([]*kms.KeyListEntry, error) {
 	var customerKeys []*kms.KeyListEntry
 	for _, key := range keys {
-		k, err := r.client.DescribeKey(&kms.DescribeKeyInput{
+		k, err := r.client.DescribeKeyWithContext(ctx, &kms.DescribeKeyInput{
 			KeyId: key.KeyId,
 		})
 		if err != nil {
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -24,7 +25,7 @@ func Test_KMSRepository_ListAllKeys(t *testing.T) {
 		{
 			name: "List only customer keys",
 			mocks: func(client *awstest.MockFakeKMS) {
-				client.On("ListKeysPages",
+				client.On("ListKeysPagesWithContext", mock.Anything,
 					&kms.ListKeysInput{},
 					mock.MatchedBy(func(callback func(res *kms.ListKeysOutput, lastPage bool) bool) bool {
 						callback(&kms.ListKeysOutput{
@@ -36,7 +37,7 @@ func Test_KMSRepository_ListAllKeys(t *testing.T) {
 						}, true)
 						return true
 					})).Return(nil).Once()
-				client.On("DescribeKey",
+				client.On("DescribeKeyWithContext", mock.Anything,
 					&kms.DescribeKeyInput{
 						KeyId: aws.String("1"),
 					}).Return(&kms.DescribeKeyOutput{
@@ -45,7 +46,7 @@ func Test_KMSRepository_ListAllKeys(t *testing.T) {
 						KeyManager: aws.String("CUSTOMER"),
 					},
 				}, nil).Once()
-				client.On("DescribeKey",
+				client.On("DescribeKeyWithContext", mock.Anything,
 					&kms.DescribeKeyInput{
 						KeyId: aws.String("2"),
 					}).Return(&kms.DescribeKeyOutput{
@@ -54,7 +55,7 @@ func Test_KMSRepository_ListAllKeys(t *testing.T) {
 						KeyManager: aws.String("AWS"),
 					},
 				}, nil).Once()
-				client.On("DescribeKey",
+				client.On("DescribeKeyWithContext", mock.Anything,
 					&kms.DescribeKeyInput{
 						KeyId: aws.String("3"),
 					}).Return(&kms.DescribeKeyOutput{
@@ -78,12 +79,12 @@ func Test_KMSRepository_ListAllKeys(t *testing.T) {
 				client: &client,
 				cache:  store,
 			}
-			got, err := r.ListAllKeys()
+			got, err := r.ListAllKeys(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllKeys()
+				cachedData, err := r.ListAllKeys(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*kms.KeyListEntry{}, store.Get("kmsListAllKeys"))
@@ -111,7 +112,7 @@ func Test_KMSRepository_ListAllAliases(t *testing.T) {
 		{
 			name: "List only customer aliases",
 			mocks: func(client *awstest.MockFakeKMS) {
-				client.On("ListAliasesPages",
+				client.On("ListAliasesPagesWithContext", mock.Anything,
 					&kms.ListAliasesInput{},
 					mock.MatchedBy(func(callback func(res *kms.ListAliasesOutput, lastPage bool) bool) bool {
 						callback(&kms.ListAliasesOutput{
@@ -146,12 +147,12 @@ func Test_KMSRepository_ListAllAliases(t *testing.T) {
 				client: &client,
 				cache:  store,
 			}
-			got, err := r.ListAllAliases()
+			got, err := r.ListAllAliases(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllAliases()
+				cachedData, err := r.ListAllAliases(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*kms.AliasListEntry{}, store.Get("kmsListAllAliases"))
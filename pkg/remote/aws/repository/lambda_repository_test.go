@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -23,7 +24,7 @@ func Test_lambdaRepository_ListAllLambdaFunctions(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *MockLambdaClient) {
-				client.On("ListFunctionsPages",
+				client.On("ListFunctionsPagesWithContext", mock.Anything,
 					&lambda.ListFunctionsInput{},
 					mock.MatchedBy(func(callback func(res *lambda.ListFunctionsOutput, lastPage bool) bool) bool {
 						callback(&lambda.ListFunctionsOutput{
@@ -67,12 +68,12 @@ func Test_lambdaRepository_ListAllLambdaFunctions(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllLambdaFunctions()
+			got, err := r.ListAllLambdaFunctions(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllLambdaFunctions()
+				cachedData, err := r.ListAllLambdaFunctions(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*lambda.FunctionConfiguration{}, store.Get("lambdaListAllLambdaFunctions"))
@@ -100,7 +101,7 @@ func Test_lambdaRepository_ListAllLambdaEventSourceMappings(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *MockLambdaClient) {
-				client.On("ListEventSourceMappingsPages",
+				client.On("ListEventSourceMappingsPagesWithContext", mock.Anything,
 					&lambda.ListEventSourceMappingsInput{},
 					mock.MatchedBy(func(callback func(res *lambda.ListEventSourceMappingsOutput, lastPage bool) bool) bool {
 						callback(&lambda.ListEventSourceMappingsOutput{
@@ -144,12 +145,12 @@ func Test_lambdaRepository_ListAllLambdaEventSourceMappings(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllLambdaEventSourceMappings()
+			got, err := r.ListAllLambdaEventSourceMappings(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllLambdaEventSourceMappings()
+				cachedData, err := r.ListAllLambdaEventSourceMappings(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*lambda.EventSourceMappingConfiguration{}, store.Get("lambdaListAllLambdaEventSourceMappings"))
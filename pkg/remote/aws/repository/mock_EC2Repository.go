@@ -3,6 +3,7 @@
 package repository
 
 import (
+	context "context"
 	ec2 "github.com/aws/aws-sdk-go/service/ec2"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +13,13 @@ type MockEC2Repository struct {
 	mock.Mock
 }
 
-// ListAllAddresses provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllAddresses() ([]*ec2.Address, error) {
-	ret := _m.Called()
+// ListAllAddresses provides a mock function with given fields: _a0
+func (_m *MockEC2Repository) ListAllAddresses(_a0 context.Context) ([]*ec2.Address, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*ec2.Address
-	if rf, ok := ret.Get(0).(func() []*ec2.Address); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.Address); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.Address)
@@ -26,8 +27,8 @@ func (_m *MockEC2Repository) ListAllAddresses() ([]*ec2.Address, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +36,13 @@ func (_m *MockEC2Repository) ListAllAddresses() ([]*ec2.Address, error) {
 	return r0, r1
 }
 
-// ListAllAddressesAssociation provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllAddressesAssociation() ([]string, error) {
-	ret := _m.Called()
+// ListAllAddressesAssociation provides a mock function with given fields: _a0
+func (_m *MockEC2Repository) ListAllAddressesAssociation(_a0 context.Context) ([]string, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func() []string); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -49,8 +50,8 @@ func (_m *MockEC2Repository) ListAllAddressesAssociation() ([]string, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,13 +59,13 @@ func (_m *MockEC2Repository) ListAllAddressesAssociation() ([]string, error) {
 	return r0, r1
 }
 
-// ListAllImages provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllImages() ([]*ec2.Image, error) {
-	ret := _m.Called()
+// ListAllImages provides a mock function with given fields: _a0
+func (_m *MockEC2Repository) ListAllImages(_a0 context.Context) ([]*ec2.Image, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*ec2.Image
-	if rf, ok := ret.Get(0).(func() []*ec2.Image); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.Image); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.Image)
@@ -72,8 +73,8 @@ func (_m *MockEC2Repository) ListAllImages() ([]*ec2.Image, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -81,13 +82,13 @@ func (_m *MockEC2Repository) ListAllImages() ([]*ec2.Image, error) {
 	return r0, r1
 }
 
-// ListAllInstances provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllInstances() ([]*ec2.Instance, error) {
-	ret := _m.Called()
+// ListAllInstances provides a mock function with given fields: _a0
+func (_m *MockEC2Repository) ListAllInstances(_a0 context.Context) ([]*ec2.Instance, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*ec2.Instance
-	if rf, ok := ret.Get(0).(func() []*ec2.Instance); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.Instance); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.Instance)
@@ -95,8 +96,8 @@ func (_m *MockEC2Repository) ListAllInstances() ([]*ec2.Instance, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -104,13 +105,13 @@ func (_m *MockEC2Repository) ListAllInstances() ([]*ec2.Instance, error) {
 	return r0, r1
 }
 
-// ListAllInternetGateways provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllInternetGateways() ([]*ec2.InternetGateway, error) {
-	ret := _m.Called()
+// ListAllInternetGateways provides a mock function with given fields: _a0
+func (_m *MockEC2Repository) ListAllInternetGateways(_a0 context.Context) ([]*ec2.InternetGateway, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*ec2.InternetGateway
-	if rf, ok := ret.Get(0).(func() []*ec2.InternetGateway); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.InternetGateway); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.InternetGateway)
@@ -118,8 +119,8 @@ func (_m *MockEC2Repository) ListAllInternetGateways() ([]*ec2.InternetGateway,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -127,13 +128,13 @@ func (_m *MockEC2Repository) ListAllInternetGateways() ([]*ec2.InternetGateway,
 	return r0, r1
 }
 
-// ListAllKeyPairs provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllKeyPairs() ([]*ec2.KeyPairInfo, error) {
-	ret := _m.Called()
+// ListAllKeyPairs provides a mock function with given fields: _a0
+func (_m *MockEC2Repository) ListAllKeyPairs(_a0 context.Context) ([]*ec2.KeyPairInfo, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*ec2.KeyPairInfo
-	if rf, ok := ret.Get(0).(func() []*ec2.KeyPairInfo); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.KeyPairInfo); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.KeyPairInfo)
@@ -141,8 +142,8 @@ func (_m *MockEC2Repository) ListAllKeyPairs() ([]*ec2.KeyPairInfo, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -150,13 +151,13 @@ func (_m *MockEC2Repository) ListAllKeyPairs() ([]*ec2.KeyPairInfo, error) {
 	return r0, r1
 }
 
-// ListAllNatGateways provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllNatGateways() ([]*ec2.NatGateway, error) {
-	ret := _m.Called()
+// ListAllNatGateways provides a mock function with given fields: _a0
+func (_m *MockEC2Repository) ListAllNatGateways(_a0 context.Context) ([]*ec2.NatGateway, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*ec2.NatGateway
-	if rf, ok := ret.Get(0).(func() []*ec2.NatGateway); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.NatGateway); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.NatGateway)
@@ -164,8 +165,8 @@ func (_m *MockEC2Repository) ListAllNatGateways() ([]*ec2.NatGateway, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -173,13 +174,13 @@ func (_m *MockEC2Repository) ListAllNatGateways() ([]*ec2.NatGateway, error) {
 	return r0, r1
 }
 
-// ListAllRouteTables provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllRouteTables() ([]*ec2.RouteTable, error) {
-	ret := _m.Called()
+// ListAllRouteTables provides a mock function with given fields: _a0
+func (_m *MockEC2Repository) ListAllRouteTables(_a0 context.Context) ([]*ec2.RouteTable, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*ec2.RouteTable
-	if rf, ok := ret.Get(0).(func() []*ec2.RouteTable); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.RouteTable); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.RouteTable)
@@ -187,8 +188,8 @@ func (_m *MockEC2Repository) ListAllRouteTables() ([]*ec2.RouteTable, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -196,13 +197,13 @@ func (_m *MockEC2Repository) ListAllRouteTables() ([]*ec2.RouteTable, error) {
 	return r0, r1
 }
 
-// ListAllSecurityGroups provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error) {
-	ret := _m.Called()
+// ListAllSecurityGroups provides a mock function with given fields: _a0
+func (_m *MockEC2Repository) ListAllSecurityGroups(_a0 context.Context) ([]*ec2.SecurityGroup, []*ec2.SecurityGroup, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*ec2.SecurityGroup
-	if rf, ok := ret.Get(0).(func() []*ec2.SecurityGroup); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.SecurityGroup); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.SecurityGroup)
@@ -210,8 +211,8 @@ func (_m *MockEC2Repository) ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*e
 	}
 
 	var r1 []*ec2.SecurityGroup
-	if rf, ok := ret.Get(1).(func() []*ec2.SecurityGroup); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) []*ec2.SecurityGroup); ok {
+		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]*ec2.SecurityGroup)
@@ -219,8 +220,8 @@ func (_m *MockEC2Repository) ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*e
 	}
 
 	var r2 error
-	if rf, ok := ret.Get(2).(func() error); ok {
-		r2 = rf()
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(_a0)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -228,13 +229,13 @@ func (_m *MockEC2Repository) ListAllSecurityGroups() ([]*ec2.SecurityGroup, []*e
 	return r0, r1, r2
 }
 
-// ListAllSnapshots provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllSnapshots() ([]*ec2.Snapshot, error) {
-	ret := _m.Called()
+// ListAllSnapshots provides a mock function with given fields: _a0
+func (_m *MockEC2Repository) ListAllSnapshots(_a0 context.Context) ([]*ec2.Snapshot, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*ec2.Snapshot
-	if rf, ok := ret.Get(0).(func() []*ec2.Snapshot); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.Snapshot); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.Snapshot)
@@ -242,8 +243,8 @@ func (_m *MockEC2Repository) ListAllSnapshots() ([]*ec2.Snapshot, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -251,13 +252,13 @@ func (_m *MockEC2Repository) ListAllSnapshots() ([]*ec2.Snapshot, error) {
 	return r0, r1
 }
 
-// ListAllSubnets provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, error) {
-	ret := _m.Called()
+// ListAllSubnets provides a mock function with given fields: _a0
+func (_m *MockEC2Repository) ListAllSubnets(_a0 context.Context) ([]*ec2.Subnet, []*ec2.Subnet, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*ec2.Subnet
-	if rf, ok := ret.Get(0).(func() []*ec2.Subnet); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.Subnet); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.Subnet)
@@ -265,8 +266,8 @@ func (_m *MockEC2Repository) ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, err
 	}
 
 	var r1 []*ec2.Subnet
-	if rf, ok := ret.Get(1).(func() []*ec2.Subnet); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) []*ec2.Subnet); ok {
+		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]*ec2.Subnet)
@@ -274,8 +275,8 @@ func (_m *MockEC2Repository) ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, err
 	}
 
 	var r2 error
-	if rf, ok := ret.Get(2).(func() error); ok {
-		r2 = rf()
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(_a0)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -283,13 +284,13 @@ func (_m *MockEC2Repository) ListAllSubnets() ([]*ec2.Subnet, []*ec2.Subnet, err
 	return r0, r1, r2
 }
 
-// ListAllVPCs provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error) {
-	ret := _m.Called()
+// ListAllVPCs provides a mock function with given fields: _a0
+func (_m *MockEC2Repository) ListAllVPCs(_a0 context.Context) ([]*ec2.Vpc, []*ec2.Vpc, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*ec2.Vpc
-	if rf, ok := ret.Get(0).(func() []*ec2.Vpc); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.Vpc); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.Vpc)
@@ -297,8 +298,8 @@ func (_m *MockEC2Repository) ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error) {
 	}
 
 	var r1 []*ec2.Vpc
-	if rf, ok := ret.Get(1).(func() []*ec2.Vpc); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) []*ec2.Vpc); ok {
+		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]*ec2.Vpc)
@@ -306,8 +307,8 @@ func (_m *MockEC2Repository) ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error) {
 	}
 
 	var r2 error
-	if rf, ok := ret.Get(2).(func() error); ok {
-		r2 = rf()
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(_a0)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -315,13 +316,13 @@ func (_m *MockEC2Repository) ListAllVPCs() ([]*ec2.Vpc, []*ec2.Vpc, error) {
 	return r0, r1, r2
 }
 
-// ListAllVolumes provides a mock function with given fields:
-func (_m *MockEC2Repository) ListAllVolumes() ([]*ec2.Volume, error) {
-	ret := _m.Called()
+// ListAllVolumes provides a mock function with given fields: _a0
+func (_m *MockEC2Repository) ListAllVolumes(_a0 context.Context) ([]*ec2.Volume, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*ec2.Volume
-	if rf, ok := ret.Get(0).(func() []*ec2.Volume); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ec2.Volume); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ec2.Volume)
@@ -329,8 +330,8 @@ func (_m *MockEC2Repository) ListAllVolumes() ([]*ec2.Volume, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,7 @@
 package repository
 
 import (
+	context "context"
 	ecr "github.com/aws/aws-sdk-go/service/ecr"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +13,13 @@ type MockECRRepository struct {
 	mock.Mock
 }
 
-// ListAllRepositories provides a mock function with given fields:
-func (_m *MockECRRepository) ListAllRepositories() ([]*ecr.Repository, error) {
-	ret := _m.Called()
+// ListAllRepositories provides a mock function with given fields: _a0
+func (_m *MockECRRepository) ListAllRepositories(_a0 context.Context) ([]*ecr.Repository, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*ecr.Repository
-	if rf, ok := ret.Get(0).(func() []*ecr.Repository); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ecr.Repository); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ecr.Repository)
@@ -26,8 +27,8 @@ func (_m *MockECRRepository) ListAllRepositories() ([]*ecr.Repository, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,7 @@
 package repository
 
 import (
+	context "context"
 	iam "github.com/aws/aws-sdk-go/service/iam"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +13,13 @@ type MockIAMRepository struct {
 	mock.Mock
 }
 
-// ListAllAccessKeys provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllAccessKeys(_a0 []*iam.User) ([]*iam.AccessKeyMetadata, error) {
-	ret := _m.Called(_a0)
+// ListAllAccessKeys provides a mock function with given fields: _a0, _a1
+func (_m *MockIAMRepository) ListAllAccessKeys(_a0 context.Context, _a1 []*iam.User) ([]*iam.AccessKeyMetadata, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*iam.AccessKeyMetadata
-	if rf, ok := ret.Get(0).(func([]*iam.User) []*iam.AccessKeyMetadata); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.User) []*iam.AccessKeyMetadata); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*iam.AccessKeyMetadata)
@@ -26,8 +27,8 @@ func (_m *MockIAMRepository) ListAllAccessKeys(_a0 []*iam.User) ([]*iam.AccessKe
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]*iam.User) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.User) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +36,13 @@ func (_m *MockIAMRepository) ListAllAccessKeys(_a0 []*iam.User) ([]*iam.AccessKe
 	return r0, r1
 }
 
-// ListAllPolicies provides a mock function with given fields:
-func (_m *MockIAMRepository) ListAllPolicies() ([]*iam.Policy, error) {
-	ret := _m.Called()
+// ListAllPolicies provides a mock function with given fields: _a0
+func (_m *MockIAMRepository) ListAllPolicies(_a0 context.Context) ([]*iam.Policy, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*iam.Policy
-	if rf, ok := ret.Get(0).(func() []*iam.Policy); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*iam.Policy); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*iam.Policy)
@@ -49,8 +50,8 @@ func (_m *MockIAMRepository) ListAllPolicies() ([]*iam.Policy, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,13 +59,13 @@ func (_m *MockIAMRepository) ListAllPolicies() ([]*iam.Policy, error) {
 	return r0, r1
 }
 
-// ListAllRolePolicies provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllRolePolicies(_a0 []*iam.Role) ([]string, error) {
-	ret := _m.Called(_a0)
+// ListAllRolePolicies provides a mock function with given fields: _a0, _a1
+func (_m *MockIAMRepository) ListAllRolePolicies(_a0 context.Context, _a1 []*iam.Role) ([]string, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func([]*iam.Role) []string); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.Role) []string); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -72,8 +73,8 @@ func (_m *MockIAMRepository) ListAllRolePolicies(_a0 []*iam.Role) ([]string, err
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]*iam.Role) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.Role) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -81,13 +82,13 @@ func (_m *MockIAMRepository) ListAllRolePolicies(_a0 []*iam.Role) ([]string, err
 	return r0, r1
 }
 
-// ListAllRolePolicyAttachments provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllRolePolicyAttachments(_a0 []*iam.Role) ([]*AttachedRolePolicy, error) {
-	ret := _m.Called(_a0)
+// ListAllRolePolicyAttachments provides a mock function with given fields: _a0, _a1
+func (_m *MockIAMRepository) ListAllRolePolicyAttachments(_a0 context.Context, _a1 []*iam.Role) ([]*AttachedRolePolicy, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*AttachedRolePolicy
-	if rf, ok := ret.Get(0).(func([]*iam.Role) []*AttachedRolePolicy); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.Role) []*AttachedRolePolicy); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*AttachedRolePolicy)
@@ -95,8 +96,8 @@ func (_m *MockIAMRepository) ListAllRolePolicyAttachments(_a0 []*iam.Role) ([]*A
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]*iam.Role) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.Role) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -104,13 +105,13 @@ func (_m *MockIAMRepository) ListAllRolePolicyAttachments(_a0 []*iam.Role) ([]*A
 	return r0, r1
 }
 
-// ListAllRoles provides a mock function with given fields:
-func (_m *MockIAMRepository) ListAllRoles() ([]*iam.Role, error) {
-	ret := _m.Called()
+// ListAllRoles provides a mock function with given fields: _a0
+func (_m *MockIAMRepository) ListAllRoles(_a0 context.Context) ([]*iam.Role, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*iam.Role
-	if rf, ok := ret.Get(0).(func() []*iam.Role); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*iam.Role); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*iam.Role)
@@ -118,8 +119,8 @@ func (_m *MockIAMRepository) ListAllRoles() ([]*iam.Role, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -127,13 +128,13 @@ func (_m *MockIAMRepository) ListAllRoles() ([]*iam.Role, error) {
 	return r0, r1
 }
 
-// ListAllUserPolicies provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllUserPolicies(_a0 []*iam.User) ([]string, error) {
-	ret := _m.Called(_a0)
+// ListAllUserPolicies provides a mock function with given fields: _a0, _a1
+func (_m *MockIAMRepository) ListAllUserPolicies(_a0 context.Context, _a1 []*iam.User) ([]string, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func([]*iam.User) []string); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.User) []string); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -141,8 +142,8 @@ func (_m *MockIAMRepository) ListAllUserPolicies(_a0 []*iam.User) ([]string, err
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]*iam.User) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.User) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -150,13 +151,13 @@ func (_m *MockIAMRepository) ListAllUserPolicies(_a0 []*iam.User) ([]string, err
 	return r0, r1
 }
 
-// ListAllUserPolicyAttachments provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllUserPolicyAttachments(_a0 []*iam.User) ([]*AttachedUserPolicy, error) {
-	ret := _m.Called(_a0)
+// ListAllUserPolicyAttachments provides a mock function with given fields: _a0, _a1
+func (_m *MockIAMRepository) ListAllUserPolicyAttachments(_a0 context.Context, _a1 []*iam.User) ([]*AttachedUserPolicy, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*AttachedUserPolicy
-	if rf, ok := ret.Get(0).(func([]*iam.User) []*AttachedUserPolicy); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.User) []*AttachedUserPolicy); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*AttachedUserPolicy)
@@ -164,8 +165,8 @@ func (_m *MockIAMRepository) ListAllUserPolicyAttachments(_a0 []*iam.User) ([]*A
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]*iam.User) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.User) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -173,13 +174,13 @@ func (_m *MockIAMRepository) ListAllUserPolicyAttachments(_a0 []*iam.User) ([]*A
 	return r0, r1
 }
 
-// ListAllUsers provides a mock function with given fields:
-func (_m *MockIAMRepository) ListAllUsers() ([]*iam.User, error) {
-	ret := _m.Called()
+// ListAllUsers provides a mock function with given fields: _a0
+func (_m *MockIAMRepository) ListAllUsers(_a0 context.Context) ([]*iam.User, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*iam.User
-	if rf, ok := ret.Get(0).(func() []*iam.User); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*iam.User); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*iam.User)
@@ -187,8 +188,8 @@ func (_m *MockIAMRepository) ListAllUsers() ([]*iam.User, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,7 @@
 package repository
 
 import (
+	context "context"
 	kms "github.com/aws/aws-sdk-go/service/kms"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +13,13 @@ type MockKMSRepository struct {
 	mock.Mock
 }
 
-// ListAllAliases provides a mock function with given fields:
-func (_m *MockKMSRepository) ListAllAliases() ([]*kms.AliasListEntry, error) {
-	ret := _m.Called()
+// ListAllAliases provides a mock function with given fields: _a0
+func (_m *MockKMSRepository) ListAllAliases(_a0 context.Context) ([]*kms.AliasListEntry, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*kms.AliasListEntry
-	if rf, ok := ret.Get(0).(func() []*kms.AliasListEntry); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*kms.AliasListEntry); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*kms.AliasListEntry)
@@ -26,8 +27,8 @@ func (_m *MockKMSRepository) ListAllAliases() ([]*kms.AliasListEntry, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +36,13 @@ func (_m *MockKMSRepository) ListAllAliases() ([]*kms.AliasListEntry, error) {
 	return r0, r1
 }
 
-// ListAllKeys provides a mock function with given fields:
-func (_m *MockKMSRepository) ListAllKeys() ([]*kms.KeyListEntry, error) {
-	ret := _m.Called()
+// ListAllKeys provides a mock function with given fields: _a0
+func (_m *MockKMSRepository) ListAllKeys(_a0 context.Context) ([]*kms.KeyListEntry, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*kms.KeyListEntry
-	if rf, ok := ret.Get(0).(func() []*kms.KeyListEntry); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*kms.KeyListEntry); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*kms.KeyListEntry)
@@ -49,8 +50,8 @@ func (_m *MockKMSRepository) ListAllKeys() ([]*kms.KeyListEntry, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,7 @@
 package repository
 
 import (
+	context "context"
 	lambda "github.com/aws/aws-sdk-go/service/lambda"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +13,13 @@ type MockLambdaRepository struct {
 	mock.Mock
 }
 
-// ListAllLambdaEventSourceMappings provides a mock function with given fields:
-func (_m *MockLambdaRepository) ListAllLambdaEventSourceMappings() ([]*lambda.EventSourceMappingConfiguration, error) {
-	ret := _m.Called()
+// ListAllLambdaEventSourceMappings provides a mock function with given fields: _a0
+func (_m *MockLambdaRepository) ListAllLambdaEventSourceMappings(_a0 context.Context) ([]*lambda.EventSourceMappingConfiguration, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*lambda.EventSourceMappingConfiguration
-	if rf, ok := ret.Get(0).(func() []*lambda.EventSourceMappingConfiguration); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*lambda.EventSourceMappingConfiguration); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*lambda.EventSourceMappingConfiguration)
@@ -26,8 +27,8 @@ func (_m *MockLambdaRepository) ListAllLambdaEventSourceMappings() ([]*lambda.Ev
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +36,13 @@ func (_m *MockLambdaRepository) ListAllLambdaEventSourceMappings() ([]*lambda.Ev
 	return r0, r1
 }
 
-// ListAllLambdaFunctions provides a mock function with given fields:
-func (_m *MockLambdaRepository) ListAllLambdaFunctions() ([]*lambda.FunctionConfiguration, error) {
-	ret := _m.Called()
+// ListAllLambdaFunctions provides a mock function with given fields: _a0
+func (_m *MockLambdaRepository) ListAllLambdaFunctions(_a0 context.Context) ([]*lambda.FunctionConfiguration, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*lambda.FunctionConfiguration
-	if rf, ok := ret.Get(0).(func() []*lambda.FunctionConfiguration); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*lambda.FunctionConfiguration); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*lambda.FunctionConfiguration)
@@ -49,8 +50,8 @@ func (_m *MockLambdaRepository) ListAllLambdaFunctions() ([]*lambda.FunctionConf
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
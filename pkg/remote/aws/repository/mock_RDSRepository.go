@@ -3,6 +3,7 @@
 package repository
 
 import (
+	context "context"
 	rds "github.com/aws/aws-sdk-go/service/rds"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +13,13 @@ type MockRDSRepository struct {
 	mock.Mock
 }
 
-// ListAllDBInstances provides a mock function with given fields:
-func (_m *MockRDSRepository) ListAllDBInstances() ([]*rds.DBInstance, error) {
-	ret := _m.Called()
+// ListAllDBInstances provides a mock function with given fields: _a0
+func (_m *MockRDSRepository) ListAllDBInstances(_a0 context.Context) ([]*rds.DBInstance, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*rds.DBInstance
-	if rf, ok := ret.Get(0).(func() []*rds.DBInstance); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*rds.DBInstance); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*rds.DBInstance)
@@ -26,8 +27,8 @@ func (_m *MockRDSRepository) ListAllDBInstances() ([]*rds.DBInstance, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +36,13 @@ func (_m *MockRDSRepository) ListAllDBInstances() ([]*rds.DBInstance, error) {
 	return r0, r1
 }
 
-// ListAllDbSubnetGroups provides a mock function with given fields:
-func (_m *MockRDSRepository) ListAllDbSubnetGroups() ([]*rds.DBSubnetGroup, error) {
-	ret := _m.Called()
+// ListAllDbSubnetGroups provides a mock function with given fields: _a0
+func (_m *MockRDSRepository) ListAllDbSubnetGroups(_a0 context.Context) ([]*rds.DBSubnetGroup, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*rds.DBSubnetGroup
-	if rf, ok := ret.Get(0).(func() []*rds.DBSubnetGroup); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*rds.DBSubnetGroup); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*rds.DBSubnetGroup)
@@ -49,8 +50,8 @@ func (_m *MockRDSRepository) ListAllDbSubnetGroups() ([]*rds.DBSubnetGroup, erro
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,7 @@
 package repository
 
 import (
+	context "context"
 	route53 "github.com/aws/aws-sdk-go/service/route53"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +13,13 @@ type MockRoute53Repository struct {
 	mock.Mock
 }
 
-// ListAllHealthChecks provides a mock function with given fields:
-func (_m *MockRoute53Repository) ListAllHealthChecks() ([]*route53.HealthCheck, error) {
-	ret := _m.Called()
+// ListAllHealthChecks provides a mock function with given fields: _a0
+func (_m *MockRoute53Repository) ListAllHealthChecks(_a0 context.Context) ([]*route53.HealthCheck, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*route53.HealthCheck
-	if rf, ok := ret.Get(0).(func() []*route53.HealthCheck); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*route53.HealthCheck); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*route53.HealthCheck)
@@ -26,8 +27,8 @@ func (_m *MockRoute53Repository) ListAllHealthChecks() ([]*route53.HealthCheck,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +36,13 @@ func (_m *MockRoute53Repository) ListAllHealthChecks() ([]*route53.HealthCheck,
 	return r0, r1
 }
 
-// ListAllZones provides a mock function with given fields:
-func (_m *MockRoute53Repository) ListAllZones() ([]*route53.HostedZone, error) {
-	ret := _m.Called()
+// ListAllZones provides a mock function with given fields: _a0
+func (_m *MockRoute53Repository) ListAllZones(_a0 context.Context) ([]*route53.HostedZone, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*route53.HostedZone
-	if rf, ok := ret.Get(0).(func() []*route53.HostedZone); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*route53.HostedZone); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*route53.HostedZone)
@@ -49,8 +50,8 @@ func (_m *MockRoute53Repository) ListAllZones() ([]*route53.HostedZone, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,13 +59,13 @@ func (_m *MockRoute53Repository) ListAllZones() ([]*route53.HostedZone, error) {
 	return r0, r1
 }
 
-// ListRecordsForZone provides a mock function with given fields: zoneId
-func (_m *MockRoute53Repository) ListRecordsForZone(zoneId string) ([]*route53.ResourceRecordSet, error) {
-	ret := _m.Called(zoneId)
+// ListRecordsForZone provides a mock function with given fields: ctx, zoneId
+func (_m *MockRoute53Repository) ListRecordsForZone(ctx context.Context, zoneId string) ([]*route53.ResourceRecordSet, error) {
+	ret := _m.Called(ctx, zoneId)
 
 	var r0 []*route53.ResourceRecordSet
-	if rf, ok := ret.Get(0).(func(string) []*route53.ResourceRecordSet); ok {
-		r0 = rf(zoneId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*route53.ResourceRecordSet); ok {
+		r0 = rf(ctx, zoneId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*route53.ResourceRecordSet)
@@ -72,8 +73,8 @@ func (_m *MockRoute53Repository) ListRecordsForZone(zoneId string) ([]*route53.R
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(zoneId)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, zoneId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,7 @@
 package repository
 
 import (
+	context "context"
 	s3 "github.com/aws/aws-sdk-go/service/s3"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,20 +13,20 @@ type MockS3Repository struct {
 	mock.Mock
 }
 
-// GetBucketLocation provides a mock function with given fields: bucket
-func (_m *MockS3Repository) GetBucketLocation(bucket *s3.Bucket) (string, error) {
-	ret := _m.Called(bucket)
+// GetBucketLocation provides a mock function with given fields: ctx, bucket
+func (_m *MockS3Repository) GetBucketLocation(ctx context.Context, bucket *s3.Bucket) (string, error) {
+	ret := _m.Called(ctx, bucket)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(*s3.Bucket) string); ok {
-		r0 = rf(bucket)
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.Bucket) string); ok {
+		r0 = rf(ctx, bucket)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*s3.Bucket) error); ok {
-		r1 = rf(bucket)
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.Bucket) error); ok {
+		r1 = rf(ctx, bucket)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -33,13 +34,13 @@ func (_m *MockS3Repository) GetBucketLocation(bucket *s3.Bucket) (string, error)
 	return r0, r1
 }
 
-// ListAllBuckets provides a mock function with given fields:
-func (_m *MockS3Repository) ListAllBuckets() ([]*s3.Bucket, error) {
-	ret := _m.Called()
+// ListAllBuckets provides a mock function with given fields: _a0
+func (_m *MockS3Repository) ListAllBuckets(_a0 context.Context) ([]*s3.Bucket, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []*s3.Bucket
-	if rf, ok := ret.Get(0).(func() []*s3.Bucket); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*s3.Bucket); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*s3.Bucket)
@@ -47,8 +48,8 @@ func (_m *MockS3Repository) ListAllBuckets() ([]*s3.Bucket, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -56,13 +57,13 @@ func (_m *MockS3Repository) ListAllBuckets() ([]*s3.Bucket, error) {
 	return r0, r1
 }
 
-// ListBucketAnalyticsConfigurations provides a mock function with given fields: bucket, region
-func (_m *MockS3Repository) ListBucketAnalyticsConfigurations(bucket *s3.Bucket, region string) ([]*s3.AnalyticsConfiguration, error) {
-	ret := _m.Called(bucket, region)
+// ListBucketAnalyticsConfigurations provides a mock function with given fields: ctx, bucket, region
+func (_m *MockS3Repository) ListBucketAnalyticsConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.AnalyticsConfiguration, error) {
+	ret := _m.Called(ctx, bucket, region)
 
 	var r0 []*s3.AnalyticsConfiguration
-	if rf, ok := ret.Get(0).(func(*s3.Bucket, string) []*s3.AnalyticsConfiguration); ok {
-		r0 = rf(bucket, region)
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.Bucket, string) []*s3.AnalyticsConfiguration); ok {
+		r0 = rf(ctx, bucket, region)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*s3.AnalyticsConfiguration)
@@ -70,8 +71,8 @@ func (_m *MockS3Repository) ListBucketAnalyticsConfigurations(bucket *s3.Bucket,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*s3.Bucket, string) error); ok {
-		r1 = rf(bucket, region)
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.Bucket, string) error); ok {
+		r1 = rf(ctx, bucket, region)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -79,13 +80,13 @@ func (_m *MockS3Repository) ListBucketAnalyticsConfigurations(bucket *s3.Bucket,
 	return r0, r1
 }
 
-// ListBucketInventoryConfigurations provides a mock function with given fields: bucket, region
-func (_m *MockS3Repository) ListBucketInventoryConfigurations(bucket *s3.Bucket, region string) ([]*s3.InventoryConfiguration, error) {
-	ret := _m.Called(bucket, region)
+// ListBucketInventoryConfigurations provides a mock function with given fields: ctx, bucket, region
+func (_m *MockS3Repository) ListBucketInventoryConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.InventoryConfiguration, error) {
+	ret := _m.Called(ctx, bucket, region)
 
 	var r0 []*s3.InventoryConfiguration
-	if rf, ok := ret.Get(0).(func(*s3.Bucket, string) []*s3.InventoryConfiguration); ok {
-		r0 = rf(bucket, region)
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.Bucket, string) []*s3.InventoryConfiguration); ok {
+		r0 = rf(ctx, bucket, region)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*s3.InventoryConfiguration)
@@ -93,8 +94,8 @@ func (_m *MockS3Repository) ListBucketInventoryConfigurations(bucket *s3.Bucket,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*s3.Bucket, string) error); ok {
-		r1 = rf(bucket, region)
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.Bucket, string) error); ok {
+		r1 = rf(ctx, bucket, region)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -102,13 +103,13 @@ func (_m *MockS3Repository) ListBucketInventoryConfigurations(bucket *s3.Bucket,
 	return r0, r1
 }
 
-// ListBucketMetricsConfigurations provides a mock function with given fields: bucket, region
-func (_m *MockS3Repository) ListBucketMetricsConfigurations(bucket *s3.Bucket, region string) ([]*s3.MetricsConfiguration, error) {
-	ret := _m.Called(bucket, region)
+// ListBucketMetricsConfigurations provides a mock function with given fields: ctx, bucket, region
+func (_m *MockS3Repository) ListBucketMetricsConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.MetricsConfiguration, error) {
+	ret := _m.Called(ctx, bucket, region)
 
 	var r0 []*s3.MetricsConfiguration
-	if rf, ok := ret.Get(0).(func(*s3.Bucket, string) []*s3.MetricsConfiguration); ok {
-		r0 = rf(bucket, region)
+	if rf, ok := ret.Get(0).(func(context.Context, *s3.Bucket, string) []*s3.MetricsConfiguration); ok {
+		r0 = rf(ctx, bucket, region)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*s3.MetricsConfiguration)
@@ -116,8 +117,8 @@ func (_m *MockS3Repository) ListBucketMetricsConfigurations(bucket *s3.Bucket, r
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*s3.Bucket, string) error); ok {
-		r1 = rf(bucket, region)
+	if rf, ok := ret.Get(1).(func(context.Context, *s3.Bucket, string) error); ok {
+		r1 = rf(ctx, bucket, region)
 	} else {
 		r1 = ret.Error(1)
 	}
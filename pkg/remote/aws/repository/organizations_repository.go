@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
+	"github.com/cloudskiff/driftctl/pkg/remote/cache"
+)
+
+type OrganizationsRepository interface {
+	ListAllAccounts(ctx context.Context) ([]*organizations.Account, error)
+}
+
+type organizationsRepository struct {
+	client organizationsiface.OrganizationsAPI
+	cache  cache.Cache
+}
+
+func NewOrganizationsRepository(session *session.Session, c cache.Cache) *organizationsRepository {
+	return &organizationsRepository{
+		organizations.New(session),
+		c,
+	}
+}
+
+func (r *organizationsRepository) ListAllAccounts(ctx context.Context) ([]*organizations.Account, error) {
+	if v := r.cache.Get("organizationsListAllAccounts"); v != nil {
+		return v.([]*organizations.Account), nil
+	}
+
+	var accounts []*organizations.Account
+	input := &organizations.ListAccountsInput{}
+	err := r.client.ListAccountsPagesWithContext(ctx, input, func(res *organizations.ListAccountsOutput, lastPage bool) bool {
+		accounts = append(accounts, res.Accounts...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("organizationsListAllAccounts", accounts)
+	return accounts, nil
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
@@ -12,8 +13,8 @@ type RDSClient interface {
 }
 
 type RDSRepository interface {
-	ListAllDBInstances() ([]*rds.DBInstance, error)
-	ListAllDbSubnetGroups() ([]*rds.DBSubnetGroup, error)
+	ListAllDBInstances(ctx context.Context) ([]*rds.DBInstance, error)
+	ListAllDbSubnetGroups(ctx context.Context) ([]*rds.DBSubnetGroup, error)
 }
 
 type rdsRepository struct {
@@ -28,14 +29,14 @@ func NewRDSRepository(session *session.Session, c cache.Cache) *rdsRepository {
 	}
 }
 
-func (r *rdsRepository) ListAllDBInstances() ([]*rds.DBInstance, error) {
+func (r *rdsRepository) ListAllDBInstances(ctx context.Context) ([]*rds.DBInstance, error) {
 	if v := r.cache.Get("rdsListAllDBInstances"); v != nil {
 		return v.([]*rds.DBInstance), nil
 	}
 
 	var result []*rds.DBInstance
 	input := &rds.DescribeDBInstancesInput{}
-	err := r.client.DescribeDBInstancesPages(input, func(res *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+	err := r.client.DescribeDBInstancesPagesWithContext(ctx, input, func(res *rds.DescribeDBInstancesOutput, lastPage bool) bool {
 		result = append(result, res.DBInstances...)
 		return !lastPage
 	})
@@ -47,14 +48,14 @@ func (r *rdsRepository) ListAllDBInstances() ([]*rds.DBInstance, error) {
 	return result, nil
 }
 
-func (r *rdsRepository) ListAllDbSubnetGroups() ([]*rds.DBSubnetGroup, error) {
+func (r *rdsRepository) ListAllDbSubnetGroups(ctx context.Context) ([]*rds.DBSubnetGroup, error) {
 	if v := r.cache.Get("rdsListAllDbSubnetGroups"); v != nil {
 		return v.([]*rds.DBSubnetGroup), nil
 	}
 
 	var subnetGroups []*rds.DBSubnetGroup
 	input := rds.DescribeDBSubnetGroupsInput{}
-	err := r.client.DescribeDBSubnetGroupsPages(&input,
+	err := r.client.DescribeDBSubnetGroupsPagesWithContext(ctx, &input,
 		func(resp *rds.DescribeDBSubnetGroupsOutput, lastPage bool) bool {
 			subnetGroups = append(subnetGroups, resp.DBSubnetGroups...)
 			return !lastPage
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -22,7 +23,7 @@ func Test_rdsRepository_ListAllDBInstances(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *MockRDSClient) {
-				client.On("DescribeDBInstancesPages",
+				client.On("DescribeDBInstancesPagesWithContext", mock.Anything,
 					&rds.DescribeDBInstancesInput{},
 					mock.MatchedBy(func(callback func(res *rds.DescribeDBInstancesOutput, lastPage bool) bool) bool {
 						callback(&rds.DescribeDBInstancesOutput{
@@ -61,12 +62,12 @@ func Test_rdsRepository_ListAllDBInstances(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllDBInstances()
+			got, err := r.ListAllDBInstances(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllDBInstances()
+				cachedData, err := r.ListAllDBInstances(context.Background())
 				assert.Nil(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*rds.DBInstance{}, store.Get("rdsListAllDBInstances"))
@@ -94,7 +95,7 @@ func Test_rdsRepository_ListAllDbSubnetGroups(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *MockRDSClient) {
-				client.On("DescribeDBSubnetGroupsPages",
+				client.On("DescribeDBSubnetGroupsPagesWithContext", mock.Anything,
 					&rds.DescribeDBSubnetGroupsInput{},
 					mock.MatchedBy(func(callback func(res *rds.DescribeDBSubnetGroupsOutput, lastPage bool) bool) bool {
 						callback(&rds.DescribeDBSubnetGroupsOutput{
@@ -133,12 +134,12 @@ func Test_rdsRepository_ListAllDbSubnetGroups(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllDbSubnetGroups()
+			got, err := r.ListAllDbSubnetGroups(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllDbSubnetGroups()
+				cachedData, err := r.ListAllDbSubnetGroups(context.Background())
 				assert.Nil(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*rds.DBSubnetGroup{}, store.Get("rdsListAllDbSubnetGroups"))
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,9 +12,9 @@ import (
 )
 
 type Route53Repository interface {
-	ListAllHealthChecks() ([]*route53.HealthCheck, error)
-	ListAllZones() ([]*route53.HostedZone, error)
-	ListRecordsForZone(zoneId string) ([]*route53.ResourceRecordSet, error)
+	ListAllHealthChecks(ctx context.Context) ([]*route53.HealthCheck, error)
+	ListAllZones(ctx context.Context) ([]*route53.HostedZone, error)
+	ListRecordsForZone(ctx context.Context, zoneId string) ([]*route53.ResourceRecordSet, error)
 }
 
 type route53Repository struct {
@@ -28,14 +29,14 @@ func NewRoute53Repository(session *session.Session, c cache.Cache) *route53Repos
 	}
 }
 
-func (r *route53Repository) ListAllHealthChecks() ([]*route53.HealthCheck, error) {
+func (r *route53Repository) ListAllHealthChecks(ctx context.Context) ([]*route53.HealthCheck, error) {
 	if v := r.cache.Get("route53ListAllHealthChecks"); v != nil {
 		return v.([]*route53.HealthCheck), nil
 	}
 
 	var tables []*route53.HealthCheck
 	input := &route53.ListHealthChecksInput{}
-	err := r.client.ListHealthChecksPages(input, func(res *route53.ListHealthChecksOutput, lastPage bool) bool {
+	err := r.client.ListHealthChecksPagesWithContext(ctx, input, func(res *route53.ListHealthChecksOutput, lastPage bool) bool {
 		tables = append(tables, res.HealthChecks...)
 		return !lastPage
 	})
@@ -47,14 +48,14 @@ func (r *route53Repository) ListAllHealthChecks() ([]*route53.HealthCheck, error
 	return tables, nil
 }
 
-func (r *route53Repository) ListAllZones() ([]*route53.HostedZone, error) {
+func (r *route53Repository) ListAllZones(ctx context.Context) ([]*route53.HostedZone, error) {
 	if v := r.cache.Get("route53ListAllZones"); v != nil {
 		return v.([]*route53.HostedZone), nil
 	}
 
 	var result []*route53.HostedZone
 	input := &route53.ListHostedZonesInput{}
-	err := r.client.ListHostedZonesPages(input, func(res *route53.ListHostedZonesOutput, lastPage bool) bool {
+	err := r.client.ListHostedZonesPagesWithContext(ctx, input, func(res *route53.ListHostedZonesOutput, lastPage bool) bool {
 		result = append(result, res.HostedZones...)
 		return !lastPage
 	})
@@ -66,7 +67,7 @@ func (r *route53Repository) ListAllZones() ([]*route53.HostedZone, error) {
 	return result, nil
 }
 
-func (r *route53Repository) ListRecordsForZone(zoneId string) ([]*route53.ResourceRecordSet, error) {
+func (r *route53Repository) ListRecordsForZone(ctx context.Context, zoneId string) ([]*route53.ResourceRecordSet, error) {
 	cacheKey := fmt.Sprintf("route53ListRecordsForZone_%s", zoneId)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*route53.ResourceRecordSet), nil
@@ -76,7 +77,7 @@ func (r *route53Repository) ListRecordsForZone(zoneId string) ([]*route53.Resour
 	input := &route53.ListResourceRecordSetsInput{
 		HostedZoneId: aws.String(zoneId),
 	}
-	err := r.client.ListResourceRecordSetsPages(input, func(res *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+	err := r.client.ListResourceRecordSetsPagesWithContext(ctx, input, func(res *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
 		results = append(results, res.ResourceRecordSets...)
 		return !lastPage
 	})
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
@@ -13,11 +14,11 @@ import (
 )
 
 type S3Repository interface {
-	ListAllBuckets() ([]*s3.Bucket, error)
-	ListBucketInventoryConfigurations(bucket *s3.Bucket, region string) ([]*s3.InventoryConfiguration, error)
-	ListBucketMetricsConfigurations(bucket *s3.Bucket, region string) ([]*s3.MetricsConfiguration, error)
-	ListBucketAnalyticsConfigurations(bucket *s3.Bucket, region string) ([]*s3.AnalyticsConfiguration, error)
-	GetBucketLocation(bucket *s3.Bucket) (string, error)
+	ListAllBuckets(ctx context.Context) ([]*s3.Bucket, error)
+	ListBucketInventoryConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.InventoryConfiguration, error)
+	ListBucketMetricsConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.MetricsConfiguration, error)
+	ListBucketAnalyticsConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.AnalyticsConfiguration, error)
+	GetBucketLocation(ctx context.Context, bucket *s3.Bucket) (string, error)
 }
 
 type s3Repository struct {
@@ -32,12 +33,12 @@ func NewS3Repository(factory client.AwsClientFactoryInterface, c cache.Cache) *s
 	}
 }
 
-func (s *s3Repository) ListAllBuckets() ([]*s3.Bucket, error) {
+func (s *s3Repository) ListAllBuckets(ctx context.Context) ([]*s3.Bucket, error) {
 	if v := s.cache.Get("s3ListAllBuckets"); v != nil {
 		return v.([]*s3.Bucket), nil
 	}
 
-	out, err := s.clientFactory.GetS3Client(nil).ListBuckets(&s3.ListBucketsInput{})
+	out, err := s.clientFactory.GetS3Client(nil).ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +46,7 @@ func (s *s3Repository) ListAllBuckets() ([]*s3.Bucket, error) {
 	return out.Buckets, nil
 }
 
-func (s *s3Repository) ListBucketInventoryConfigurations(bucket *s3.Bucket, region string) ([]*s3.InventoryConfiguration, error) {
+func (s *s3Repository) ListBucketInventoryConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.InventoryConfiguration, error) {
 	cacheKey := fmt.Sprintf("s3ListBucketInventoryConfigurations_%s_%s", *bucket.Name, region)
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.([]*s3.InventoryConfiguration), nil
@@ -59,7 +60,7 @@ func (s *s3Repository) ListBucketInventoryConfigurations(bucket *s3.Bucket, regi
 	}
 
 	for {
-		configurations, err := s3client.ListBucketInventoryConfigurations(request)
+		configurations, err := s3client.ListBucketInventoryConfigurationsWithContext(ctx, request)
 		if err != nil {
 			return nil, errors.Wrapf(
 				err,
@@ -79,7 +80,7 @@ func (s *s3Repository) ListBucketInventoryConfigurations(bucket *s3.Bucket, regi
 	return inventoryConfigurations, nil
 }
 
-func (s *s3Repository) ListBucketMetricsConfigurations(bucket *s3.Bucket, region string) ([]*s3.MetricsConfiguration, error) {
+func (s *s3Repository) ListBucketMetricsConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.MetricsConfiguration, error) {
 	cacheKey := fmt.Sprintf("s3ListBucketMetricsConfigurations_%s_%s", *bucket.Name, region)
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.([]*s3.MetricsConfiguration), nil
@@ -93,7 +94,7 @@ func (s *s3Repository) ListBucketMetricsConfigurations(bucket *s3.Bucket, region
 	}
 
 	for {
-		configurations, err := s3client.ListBucketMetricsConfigurations(request)
+		configurations, err := s3client.ListBucketMetricsConfigurationsWithContext(ctx, request)
 		if err != nil {
 			return nil, errors.Wrapf(
 				err,
@@ -113,7 +114,7 @@ func (s *s3Repository) ListBucketMetricsConfigurations(bucket *s3.Bucket, region
 	return metricsConfigurationList, nil
 }
 
-func (s *s3Repository) ListBucketAnalyticsConfigurations(bucket *s3.Bucket, region string) ([]*s3.AnalyticsConfiguration, error) {
+func (s *s3Repository) ListBucketAnalyticsConfigurations(ctx context.Context, bucket *s3.Bucket, region string) ([]*s3.AnalyticsConfiguration, error) {
 	cacheKey := fmt.Sprintf("s3ListBucketAnalyticsConfigurations_%s_%s", *bucket.Name, region)
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.([]*s3.AnalyticsConfiguration), nil
@@ -127,7 +128,7 @@ func (s *s3Repository) ListBucketAnalyticsConfigurations(bucket *s3.Bucket, regi
 	}
 
 	for {
-		configurations, err := s3client.ListBucketAnalyticsConfigurations(request)
+		configurations, err := s3client.ListBucketAnalyticsConfigurationsWithContext(ctx, request)
 		if err != nil {
 			return nil, errors.Wrapf(
 				err,
@@ -148,14 +149,14 @@ func (s *s3Repository) ListBucketAnalyticsConfigurations(bucket *s3.Bucket, regi
 	return analyticsConfigurationList, nil
 }
 
-func (s *s3Repository) GetBucketLocation(bucket *s3.Bucket) (string, error) {
+func (s *s3Repository) GetBucketLocation(ctx context.Context, bucket *s3.Bucket) (string, error) {
 	cacheKey := fmt.Sprintf("s3GetBucketLocation_%s", *bucket.Name)
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.(string), nil
 	}
 
 	bucketLocationRequest := s3.GetBucketLocationInput{Bucket: bucket.Name}
-	bucketLocationResponse, err := s.clientFactory.GetS3Client(nil).GetBucketLocation(&bucketLocationRequest)
+	bucketLocationResponse, err := s.clientFactory.GetS3Client(nil).GetBucketLocationWithContext(ctx, &bucketLocationRequest)
 	if err != nil {
 		awsErr, ok := err.(awserr.Error)
 		if ok && awsErr.Code() == s3.ErrCodeNoSuchBucket {
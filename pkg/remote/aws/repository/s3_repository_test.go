@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -15,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/r3labs/diff/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func Test_s3Repository_ListAllBuckets(t *testing.T) {
@@ -28,7 +30,7 @@ func Test_s3Repository_ListAllBuckets(t *testing.T) {
 		{
 			name: "List buckets",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("ListBuckets", &s3.ListBucketsInput{}).Return(
+				client.On("ListBucketsWithContext", mock.Anything, &s3.ListBucketsInput{}).Return(
 					&s3.ListBucketsOutput{
 						Buckets: []*s3.Bucket{
 							{Name: aws.String("bucket1")},
@@ -48,7 +50,7 @@ func Test_s3Repository_ListAllBuckets(t *testing.T) {
 		{
 			name: "Error listing buckets",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("ListBuckets", &s3.ListBucketsInput{}).Return(
+				client.On("ListBucketsWithContext", mock.Anything, &s3.ListBucketsInput{}).Return(
 					nil,
 					awserr.NewRequestFailure(nil, 403, ""),
 				).Once()
@@ -65,13 +67,13 @@ func Test_s3Repository_ListAllBuckets(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", (*aws.Config)(nil)).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.ListAllBuckets()
+			got, err := r.ListAllBuckets(context.Background())
 			factory.AssertExpectations(t)
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllBuckets()
+				cachedData, err := r.ListAllBuckets(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*s3.Bucket{}, store.Get("s3ListAllBuckets"))
@@ -112,8 +114,7 @@ func Test_s3Repository_ListBucketInventoryConfigurations(t *testing.T) {
 				region: "us-east-1",
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On(
-					"ListBucketInventoryConfigurations",
+				client.On("ListBucketInventoryConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketInventoryConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: nil,
@@ -130,8 +131,7 @@ func Test_s3Repository_ListBucketInventoryConfigurations(t *testing.T) {
 					},
 					nil,
 				).Once()
-				client.On(
-					"ListBucketInventoryConfigurations",
+				client.On("ListBucketInventoryConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketInventoryConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: awssdk.String("nexttoken"),
@@ -169,8 +169,7 @@ func Test_s3Repository_ListBucketInventoryConfigurations(t *testing.T) {
 				region: "us-east-1",
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On(
-					"ListBucketInventoryConfigurations",
+				client.On("ListBucketInventoryConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketInventoryConfigurationsInput{
 						Bucket: awssdk.String("test-bucket"),
 					},
@@ -191,7 +190,7 @@ func Test_s3Repository_ListBucketInventoryConfigurations(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", &aws.Config{Region: awssdk.String(tt.input.region)}).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.ListBucketInventoryConfigurations(&tt.input.bucket, tt.input.region)
+			got, err := r.ListBucketInventoryConfigurations(context.Background(), &tt.input.bucket, tt.input.region)
 			factory.AssertExpectations(t)
 			if err != nil && tt.wantErr == "" {
 				t.Fatalf("Unexpected error %+v", err)
@@ -202,7 +201,7 @@ func Test_s3Repository_ListBucketInventoryConfigurations(t *testing.T) {
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListBucketInventoryConfigurations(&tt.input.bucket, tt.input.region)
+				cachedData, err := r.ListBucketInventoryConfigurations(context.Background(), &tt.input.bucket, tt.input.region)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*s3.InventoryConfiguration{}, store.Get(fmt.Sprintf("s3ListBucketInventoryConfigurations_%s_%s", *tt.input.bucket.Name, tt.input.region)))
@@ -243,8 +242,7 @@ func Test_s3Repository_ListBucketMetricsConfigurations(t *testing.T) {
 				region: "us-east-1",
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On(
-					"ListBucketMetricsConfigurations",
+				client.On("ListBucketMetricsConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketMetricsConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: nil,
@@ -261,8 +259,7 @@ func Test_s3Repository_ListBucketMetricsConfigurations(t *testing.T) {
 					},
 					nil,
 				).Once()
-				client.On(
-					"ListBucketMetricsConfigurations",
+				client.On("ListBucketMetricsConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketMetricsConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: awssdk.String("nexttoken"),
@@ -300,8 +297,7 @@ func Test_s3Repository_ListBucketMetricsConfigurations(t *testing.T) {
 				region: "us-east-1",
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On(
-					"ListBucketMetricsConfigurations",
+				client.On("ListBucketMetricsConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketMetricsConfigurationsInput{
 						Bucket: awssdk.String("test-bucket"),
 					},
@@ -322,7 +318,7 @@ func Test_s3Repository_ListBucketMetricsConfigurations(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", &aws.Config{Region: awssdk.String(tt.input.region)}).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.ListBucketMetricsConfigurations(&tt.input.bucket, tt.input.region)
+			got, err := r.ListBucketMetricsConfigurations(context.Background(), &tt.input.bucket, tt.input.region)
 			factory.AssertExpectations(t)
 			if err != nil && tt.wantErr == "" {
 				t.Fatalf("Unexpected error %+v", err)
@@ -333,7 +329,7 @@ func Test_s3Repository_ListBucketMetricsConfigurations(t *testing.T) {
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListBucketMetricsConfigurations(&tt.input.bucket, tt.input.region)
+				cachedData, err := r.ListBucketMetricsConfigurations(context.Background(), &tt.input.bucket, tt.input.region)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*s3.MetricsConfiguration{}, store.Get(fmt.Sprintf("s3ListBucketMetricsConfigurations_%s_%s", *tt.input.bucket.Name, tt.input.region)))
@@ -374,8 +370,7 @@ func Test_s3Repository_ListBucketAnalyticsConfigurations(t *testing.T) {
 				region: "us-east-1",
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On(
-					"ListBucketAnalyticsConfigurations",
+				client.On("ListBucketAnalyticsConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketAnalyticsConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: nil,
@@ -392,8 +387,7 @@ func Test_s3Repository_ListBucketAnalyticsConfigurations(t *testing.T) {
 					},
 					nil,
 				).Once()
-				client.On(
-					"ListBucketAnalyticsConfigurations",
+				client.On("ListBucketAnalyticsConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketAnalyticsConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: awssdk.String("nexttoken"),
@@ -431,8 +425,7 @@ func Test_s3Repository_ListBucketAnalyticsConfigurations(t *testing.T) {
 				region: "us-east-1",
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On(
-					"ListBucketAnalyticsConfigurations",
+				client.On("ListBucketAnalyticsConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketAnalyticsConfigurationsInput{
 						Bucket: awssdk.String("test-bucket"),
 					},
@@ -453,7 +446,7 @@ func Test_s3Repository_ListBucketAnalyticsConfigurations(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", &aws.Config{Region: awssdk.String(tt.input.region)}).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.ListBucketAnalyticsConfigurations(&tt.input.bucket, tt.input.region)
+			got, err := r.ListBucketAnalyticsConfigurations(context.Background(), &tt.input.bucket, tt.input.region)
 			factory.AssertExpectations(t)
 			if err != nil && tt.wantErr == "" {
 				t.Fatalf("Unexpected error %+v", err)
@@ -464,7 +457,7 @@ func Test_s3Repository_ListBucketAnalyticsConfigurations(t *testing.T) {
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListBucketAnalyticsConfigurations(&tt.input.bucket, tt.input.region)
+				cachedData, err := r.ListBucketAnalyticsConfigurations(context.Background(), &tt.input.bucket, tt.input.region)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*s3.AnalyticsConfiguration{}, store.Get(fmt.Sprintf("s3ListBucketAnalyticsConfigurations_%s_%s", *tt.input.bucket.Name, tt.input.region)))
@@ -497,7 +490,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 				Name: awssdk.String("test-bucket"),
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketLocation", &s3.GetBucketLocationInput{
+				client.On("GetBucketLocationWithContext", mock.Anything, &s3.GetBucketLocationInput{
 					Bucket: awssdk.String("test-bucket"),
 				}).Return(
 					&s3.GetBucketLocationOutput{
@@ -514,7 +507,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 				Name: awssdk.String("test-bucket"),
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketLocation", &s3.GetBucketLocationInput{
+				client.On("GetBucketLocationWithContext", mock.Anything, &s3.GetBucketLocationInput{
 					Bucket: awssdk.String("test-bucket"),
 				}).Return(
 					&s3.GetBucketLocationOutput{},
@@ -529,7 +522,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 				Name: awssdk.String("test-bucket"),
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketLocation", &s3.GetBucketLocationInput{
+				client.On("GetBucketLocationWithContext", mock.Anything, &s3.GetBucketLocationInput{
 					Bucket: awssdk.String("test-bucket"),
 				}).Return(
 					nil,
@@ -544,7 +537,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 				Name: awssdk.String("test-bucket"),
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketLocation", &s3.GetBucketLocationInput{
+				client.On("GetBucketLocationWithContext", mock.Anything, &s3.GetBucketLocationInput{
 					Bucket: awssdk.String("test-bucket"),
 				}).Return(
 					nil,
@@ -562,7 +555,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", (*aws.Config)(nil)).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.GetBucketLocation(tt.bucket)
+			got, err := r.GetBucketLocation(context.Background(), tt.bucket)
 			factory.AssertExpectations(t)
 			if err != nil && tt.wantErr == "" {
 				t.Fatalf("Unexpected error %+v", err)
@@ -573,7 +566,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 
 			if err == nil && tt.want != "" {
 				// Check that results were cached
-				cachedData, err := r.GetBucketLocation(tt.bucket)
+				cachedData, err := r.GetBucketLocation(context.Background(), tt.bucket)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, "", store.Get(fmt.Sprintf("s3GetBucketLocation_%s", *tt.bucket.Name)))
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
@@ -8,8 +9,8 @@ import (
 )
 
 type SNSRepository interface {
-	ListAllTopics() ([]*sns.Topic, error)
-	ListAllSubscriptions() ([]*sns.Subscription, error)
+	ListAllTopics(ctx context.Context) ([]*sns.Topic, error)
+	ListAllSubscriptions(ctx context.Context) ([]*sns.Subscription, error)
 }
 
 type snsRepository struct {
@@ -24,14 +25,14 @@ func NewSNSClient(session *session.Session, c cache.Cache) *snsRepository {
 	}
 }
 
-func (r *snsRepository) ListAllTopics() ([]*sns.Topic, error) {
+func (r *snsRepository) ListAllTopics(ctx context.Context) ([]*sns.Topic, error) {
 	if v := r.cache.Get("snsListAllTopics"); v != nil {
 		return v.([]*sns.Topic), nil
 	}
 
 	var topics []*sns.Topic
 	input := &sns.ListTopicsInput{}
-	err := r.client.ListTopicsPages(input, func(res *sns.ListTopicsOutput, lastPage bool) bool {
+	err := r.client.ListTopicsPagesWithContext(ctx, input, func(res *sns.ListTopicsOutput, lastPage bool) bool {
 		topics = append(topics, res.Topics...)
 		return !lastPage
 	})
@@ -43,14 +44,14 @@ func (r *snsRepository) ListAllTopics() ([]*sns.Topic, error) {
 	return topics, nil
 }
 
-func (r *snsRepository) ListAllSubscriptions() ([]*sns.Subscription, error) {
+func (r *snsRepository) ListAllSubscriptions(ctx context.Context) ([]*sns.Subscription, error) {
 	if v := r.cache.Get("snsListAllSubscriptions"); v != nil {
 		return v.([]*sns.Subscription), nil
 	}
 
 	var subscriptions []*sns.Subscription
 	input := &sns.ListSubscriptionsInput{}
-	err := r.client.ListSubscriptionsPages(input, func(res *sns.ListSubscriptionsOutput, lastPage bool) bool {
+	err := r.client.ListSubscriptionsPagesWithContext(ctx, input, func(res *sns.ListSubscriptionsOutput, lastPage bool) bool {
 		subscriptions = append(subscriptions, res.Subscriptions...)
 		return !lastPage
 	})
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
@@ -8,7 +9,7 @@ import (
 )
 
 type SQSRepository interface {
-	ListAllQueues() ([]*string, error)
+	ListAllQueues(ctx context.Context) ([]*string, error)
 }
 
 type sqsRepository struct {
@@ -23,14 +24,14 @@ func NewSQSClient(session *session.Session, c cache.Cache) *sqsRepository {
 	}
 }
 
-func (r *sqsRepository) ListAllQueues() ([]*string, error) {
+func (r *sqsRepository) ListAllQueues(ctx context.Context) ([]*string, error) {
 	if v := r.cache.Get("sqsListAllQueues"); v != nil {
 		return v.([]*string), nil
 	}
 
 	var queues []*string
 	input := sqs.ListQueuesInput{}
-	err := r.client.ListQueuesPages(&input,
+	err := r.client.ListQueuesPagesWithContext(ctx, &input,
 		func(resp *sqs.ListQueuesOutput, lastPage bool) bool {
 			queues = append(queues, resp.QueueUrls...)
 			return !lastPage
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -24,7 +25,7 @@ func Test_sqsRepository_ListAllQueues(t *testing.T) {
 		{
 			name: "list with multiple pages",
 			mocks: func(client *awstest.MockFakeSQS) {
-				client.On("ListQueuesPages",
+				client.On("ListQueuesPagesWithContext", mock.Anything,
 					&sqs.ListQueuesInput{},
 					mock.MatchedBy(func(callback func(res *sqs.ListQueuesOutput, lastPage bool) bool) bool {
 						callback(&sqs.ListQueuesOutput{
@@ -57,12 +58,12 @@ func Test_sqsRepository_ListAllQueues(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllQueues()
+			got, err := r.ListAllQueues(context.Background())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllQueues()
+				cachedData, err := r.ListAllQueues(context.Background())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*string{}, store.Get("sqsListAllQueues"))
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
@@ -29,8 +30,8 @@ func NewRoute53HealthCheckSupplier(provider *AWSTerraformProvider, deserializer
 	}
 }
 
-func (s *Route53HealthCheckSupplier) Resources() ([]resource.Resource, error) {
-	healthChecks, err := s.client.ListAllHealthChecks()
+func (s *Route53HealthCheckSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	healthChecks, err := s.client.ListAllHealthChecks(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsRoute53HealthCheckResourceType)
 	}
@@ -38,11 +39,11 @@ func (s *Route53HealthCheckSupplier) Resources() ([]resource.Resource, error) {
 	for _, healthCheck := range healthChecks {
 		healthCheck := healthCheck
 		s.runner.Run(func() (cty.Value, error) {
-			return s.readHealthCheck(healthCheck)
+			return s.readHealthCheck(ctx, healthCheck)
 		})
 	}
 
-	retrieve, err := s.runner.Wait()
+	retrieve, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -50,10 +51,11 @@ func (s *Route53HealthCheckSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(aws.AwsRoute53HealthCheckResourceType, retrieve)
 }
 
-func (s *Route53HealthCheckSupplier) readHealthCheck(healthCheck *route53.HealthCheck) (cty.Value, error) {
+func (s *Route53HealthCheckSupplier) readHealthCheck(ctx context.Context, healthCheck *route53.HealthCheck) (cty.Value, error) {
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		ID: *healthCheck.Id,
-		Ty: aws.AwsRoute53HealthCheckResourceType,
+		Ctx: ctx,
+		ID:  *healthCheck.Id,
+		Ty:  aws.AwsRoute53HealthCheckResourceType,
 	})
 	if err != nil {
 		logrus.Error(err)
@@ -37,7 +37,7 @@ func TestRoute53HealthCheckSupplier_Resources(t *testing.T) {
 			test:    "no health check",
 			dirName: "route53_health_check_empty",
 			mocks: func(client *repository.MockRoute53Repository) {
-				client.On("ListAllHealthChecks").Return([]*route53.HealthCheck{}, nil)
+				client.On("ListAllHealthChecks", mock.Anything).Return([]*route53.HealthCheck{}, nil)
 			},
 			err: nil,
 		},
@@ -45,7 +45,7 @@ func TestRoute53HealthCheckSupplier_Resources(t *testing.T) {
 			test:    "Multiple health check",
 			dirName: "route53_health_check_multiple",
 			mocks: func(client *repository.MockRoute53Repository) {
-				client.On("ListAllHealthChecks").Return([]*route53.HealthCheck{
+				client.On("ListAllHealthChecks", mock.Anything).Return([]*route53.HealthCheck{
 					{Id: aws.String("7001a9df-ded4-4802-9909-668eb80b972b")},
 					{Id: aws.String("84fc318a-2e0d-41d6-b638-280e2f0f4e26")},
 				}, nil)
@@ -56,7 +56,7 @@ func TestRoute53HealthCheckSupplier_Resources(t *testing.T) {
 			test:    "cannot list health check",
 			dirName: "route53_health_check_empty",
 			mocks: func(client *repository.MockRoute53Repository) {
-				client.On("ListAllHealthChecks").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllHealthChecks", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsRoute53HealthCheckResourceType),
 		},
@@ -90,7 +90,7 @@ func TestRoute53HealthCheckSupplier_Resources(t *testing.T) {
 				&fakeClient,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 			mock.AssertExpectationsForObjects(tt)
 			test.CtyTestDiff(got, c.dirName, provider, deserializer, shouldUpdate, tt)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"strings"
 
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
@@ -29,29 +30,29 @@ func NewRoute53RecordSupplier(provider *AWSTerraformProvider, deserializer *reso
 		terraform.NewParallelResourceReader(provider.Runner().SubRunner())}
 }
 
-func (s *Route53RecordSupplier) Resources() ([]resource.Resource, error) {
+func (s *Route53RecordSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
 
-	zones, err := s.listZones()
+	zones, err := s.listZones(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, resourceaws.AwsRoute53RecordResourceType, resourceaws.AwsRoute53ZoneResourceType)
 	}
 
 	for _, zone := range zones {
-		if err := s.listRecordsForZone(zone[0], zone[1]); err != nil {
+		if err := s.listRecordsForZone(ctx, zone[0], zone[1]); err != nil {
 			return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsRoute53RecordResourceType)
 		}
 	}
 
-	results, err := s.runner.Wait()
+	results, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
 	return s.deserializer.Deserialize(resourceaws.AwsRoute53RecordResourceType, results)
 }
 
-func (s *Route53RecordSupplier) listZones() ([][2]string, error) {
+func (s *Route53RecordSupplier) listZones(ctx context.Context) ([][2]string, error) {
 	results := make([][2]string, 0)
-	zones, err := s.client.ListAllZones()
+	zones, err := s.client.ListAllZones(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -63,9 +64,9 @@ func (s *Route53RecordSupplier) listZones() ([][2]string, error) {
 	return results, nil
 }
 
-func (s *Route53RecordSupplier) listRecordsForZone(zoneId string, zoneName string) error {
+func (s *Route53RecordSupplier) listRecordsForZone(ctx context.Context, zoneId string, zoneName string) error {
 
-	records, err := s.client.ListRecordsForZone(zoneId)
+	records, err := s.client.ListRecordsForZone(ctx, zoneId)
 
 	if err != nil {
 		return err
@@ -87,8 +88,9 @@ func (s *Route53RecordSupplier) listRecordsForZone(zoneId string, zoneName strin
 
 			record, err := s.reader.ReadResource(
 				terraform.ReadResourceArgs{
-					Ty: resourceaws.AwsRoute53RecordResourceType,
-					ID: strings.Join(vars, "_"),
+					Ctx: ctx,
+					Ty:  resourceaws.AwsRoute53RecordResourceType,
+					ID:  strings.Join(vars, "_"),
 				},
 			)
 			if err != nil {
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/cloudskiff/driftctl/pkg/parallel"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/cloudskiff/driftctl/test/goldenfile"
 
@@ -39,7 +40,7 @@ func TestRoute53RecordSupplier_Resources(t *testing.T) {
 			test:    "no records",
 			dirName: "route53_zone_with_no_record",
 			mocks: func(client *repository.MockRoute53Repository) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z1035360GLIB82T1EH2G"),
@@ -48,7 +49,7 @@ func TestRoute53RecordSupplier_Resources(t *testing.T) {
 					},
 					nil,
 				)
-				client.On("ListRecordsForZone", "Z1035360GLIB82T1EH2G").Return([]*route53.ResourceRecordSet{}, nil)
+				client.On("ListRecordsForZone", mock.Anything, "Z1035360GLIB82T1EH2G").Return([]*route53.ResourceRecordSet{}, nil)
 			},
 			err: nil,
 		},
@@ -56,7 +57,7 @@ func TestRoute53RecordSupplier_Resources(t *testing.T) {
 			test:    "multiples records in multiples zones",
 			dirName: "route53_record_multiples",
 			mocks: func(client *repository.MockRoute53Repository) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z1035360GLIB82T1EH2G"),
@@ -69,7 +70,7 @@ func TestRoute53RecordSupplier_Resources(t *testing.T) {
 					},
 					nil,
 				)
-				client.On("ListRecordsForZone", "Z1035360GLIB82T1EH2G").Return([]*route53.ResourceRecordSet{
+				client.On("ListRecordsForZone", mock.Anything, "Z1035360GLIB82T1EH2G").Return([]*route53.ResourceRecordSet{
 					{
 						Name: awssdk.String("foo-0.com"),
 						Type: awssdk.String("NS"),
@@ -91,7 +92,7 @@ func TestRoute53RecordSupplier_Resources(t *testing.T) {
 						Type: awssdk.String("A"),
 					},
 				}, nil)
-				client.On("ListRecordsForZone", "Z10347383HV75H96J919W").Return([]*route53.ResourceRecordSet{
+				client.On("ListRecordsForZone", mock.Anything, "Z10347383HV75H96J919W").Return([]*route53.ResourceRecordSet{
 					{
 						Name: awssdk.String("test2"),
 						Type: awssdk.String("A"),
@@ -104,7 +105,7 @@ func TestRoute53RecordSupplier_Resources(t *testing.T) {
 			test:    "explicit subdomain records",
 			dirName: "route53_record_explicit_subdomain",
 			mocks: func(client *repository.MockRoute53Repository) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z06486383UC8WYSBZTWFM"),
@@ -113,7 +114,7 @@ func TestRoute53RecordSupplier_Resources(t *testing.T) {
 					},
 					nil,
 				)
-				client.On("ListRecordsForZone", "Z06486383UC8WYSBZTWFM").Return([]*route53.ResourceRecordSet{
+				client.On("ListRecordsForZone", mock.Anything, "Z06486383UC8WYSBZTWFM").Return([]*route53.ResourceRecordSet{
 					{
 						Name: awssdk.String("test0"),
 						Type: awssdk.String("TXT"),
@@ -146,7 +147,7 @@ func TestRoute53RecordSupplier_Resources(t *testing.T) {
 			test:    "cannot list zones",
 			dirName: "route53_zone_with_no_record",
 			mocks: func(client *repository.MockRoute53Repository) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{},
 					awserr.NewRequestFailure(nil, 403, ""))
 			},
@@ -156,7 +157,7 @@ func TestRoute53RecordSupplier_Resources(t *testing.T) {
 			test:    "cannot list records",
 			dirName: "route53_zone_with_no_record",
 			mocks: func(client *repository.MockRoute53Repository) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z06486383UC8WYSBZTWFM"),
@@ -164,7 +165,7 @@ func TestRoute53RecordSupplier_Resources(t *testing.T) {
 						},
 					},
 					nil)
-				client.On("ListRecordsForZone", "Z06486383UC8WYSBZTWFM").Return(
+				client.On("ListRecordsForZone", mock.Anything, "Z06486383UC8WYSBZTWFM").Return(
 					[]*route53.ResourceRecordSet{},
 					awserr.NewRequestFailure(nil, 403, ""))
 
@@ -201,7 +202,7 @@ func TestRoute53RecordSupplier_Resources(t *testing.T) {
 				client,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, tt.err, err)
 
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"strings"
 
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
@@ -33,9 +34,9 @@ func NewRoute53ZoneSupplier(provider *AWSTerraformProvider, deserializer *resour
 	}
 }
 
-func (s *Route53ZoneSupplier) Resources() ([]resource.Resource, error) {
+func (s *Route53ZoneSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
 
-	zones, err := s.client.ListAllZones()
+	zones, err := s.client.ListAllZones(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsRoute53ZoneResourceType)
 	}
@@ -43,10 +44,10 @@ func (s *Route53ZoneSupplier) Resources() ([]resource.Resource, error) {
 	for _, hostedZone := range zones {
 		zone := *hostedZone
 		s.runner.Run(func() (cty.Value, error) {
-			return s.readZone(zone)
+			return s.readZone(ctx, zone)
 		})
 	}
-	results, err := s.runner.Wait()
+	results, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -54,12 +55,13 @@ func (s *Route53ZoneSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(resourceaws.AwsRoute53ZoneResourceType, results)
 }
 
-func (s *Route53ZoneSupplier) readZone(hostedZone route53.HostedZone) (cty.Value, error) {
+func (s *Route53ZoneSupplier) readZone(ctx context.Context, hostedZone route53.HostedZone) (cty.Value, error) {
 	name := *hostedZone.Name
 
 	zone, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		Ty: resourceaws.AwsRoute53ZoneResourceType,
-		ID: cleanZoneID(*hostedZone.Id),
+		Ctx: ctx,
+		Ty:  resourceaws.AwsRoute53ZoneResourceType,
+		ID:  cleanZoneID(*hostedZone.Id),
 	})
 	if err != nil {
 		logrus.Warnf("Error reading %s[%s]: %+v", name, resourceaws.AwsRoute53ZoneResourceType, err)
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/cloudskiff/driftctl/pkg/parallel"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/cloudskiff/driftctl/test/goldenfile"
 
@@ -39,7 +40,7 @@ func TestRoute53ZoneSupplier_Resources(t *testing.T) {
 			test:    "no zones",
 			dirName: "route53_zone_empty",
 			mocks: func(client *repository.MockRoute53Repository) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{},
 					nil,
 				)
@@ -50,7 +51,7 @@ func TestRoute53ZoneSupplier_Resources(t *testing.T) {
 			test:    "single zone",
 			dirName: "route53_zone_single",
 			mocks: func(client *repository.MockRoute53Repository) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z08068311RGDXPHF8KE62"),
@@ -66,7 +67,7 @@ func TestRoute53ZoneSupplier_Resources(t *testing.T) {
 			test:    "multiples zone (test pagination)",
 			dirName: "route53_zone_multiples",
 			mocks: func(client *repository.MockRoute53Repository) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z01809283VH9BBALZHO7B"),
@@ -90,7 +91,7 @@ func TestRoute53ZoneSupplier_Resources(t *testing.T) {
 			test:    "cannot list zones",
 			dirName: "route53_zone_empty",
 			mocks: func(client *repository.MockRoute53Repository) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{},
 					awserr.NewRequestFailure(nil, 403, ""),
 				)
@@ -127,7 +128,7 @@ func TestRoute53ZoneSupplier_Resources(t *testing.T) {
 				client,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, tt.err, err)
 
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
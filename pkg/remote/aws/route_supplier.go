@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
@@ -29,8 +30,8 @@ func NewRouteSupplier(provider *AWSTerraformProvider, deserializer *resource.Des
 	}
 }
 
-func (s *RouteSupplier) Resources() ([]resource.Resource, error) {
-	routeTables, err := s.repo.ListAllRouteTables()
+func (s *RouteSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	routeTables, err := s.repo.ListAllRouteTables(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, aws.AwsRouteResourceType, aws.AwsRouteTableResourceType)
 	}
@@ -40,12 +41,12 @@ func (s *RouteSupplier) Resources() ([]resource.Resource, error) {
 		for _, route := range table.Routes {
 			res := *route
 			s.routeRunner.Run(func() (cty.Value, error) {
-				return s.readRoute(*table.RouteTableId, res)
+				return s.readRoute(ctx, *table.RouteTableId, res)
 			})
 		}
 	}
 
-	routeResources, err := s.routeRunner.Wait()
+	routeResources, err := s.routeRunner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +59,7 @@ func (s *RouteSupplier) Resources() ([]resource.Resource, error) {
 	return deserializedRoutes, nil
 }
 
-func (s *RouteSupplier) readRoute(tableId string, route ec2.Route) (cty.Value, error) {
+func (s *RouteSupplier) readRoute(ctx context.Context, tableId string, route ec2.Route) (cty.Value, error) {
 	var Ty resource.ResourceType = aws.AwsRouteResourceType
 
 	attributes := map[string]interface{}{
@@ -75,6 +76,7 @@ func (s *RouteSupplier) readRoute(tableId string, route ec2.Route) (cty.Value, e
 	// We can ignore error there as remote will always return us a valid route
 	routeId, _ := aws.CalculateRouteID(&tableId, route.DestinationCidrBlock, route.DestinationIpv6CidrBlock)
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		Ctx:        ctx,
 		ID:         routeId,
 		Ty:         Ty,
 		Attributes: flatmap.Flatten(attributes),
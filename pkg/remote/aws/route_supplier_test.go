@@ -39,7 +39,7 @@ func TestRouteSupplier_Resources(t *testing.T) {
 			test:    "no route",
 			dirName: "route_empty",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllRouteTables").Once().Return([]*ec2.RouteTable{}, nil)
+				client.On("ListAllRouteTables", mock.Anything).Once().Return([]*ec2.RouteTable{}, nil)
 			},
 			err: nil,
 		},
@@ -47,7 +47,7 @@ func TestRouteSupplier_Resources(t *testing.T) {
 			test:    "mixed default_route_table and route_table",
 			dirName: "route",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllRouteTables").Once().Return([]*ec2.RouteTable{
+				client.On("ListAllRouteTables", mock.Anything).Once().Return([]*ec2.RouteTable{
 					{
 						RouteTableId: awssdk.String("rtb-096bdfb69309c54c3"), // table1
 						Routes: []*ec2.Route{
@@ -122,7 +122,7 @@ func TestRouteSupplier_Resources(t *testing.T) {
 			test:    "cannot list route table",
 			dirName: "route_empty",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllRouteTables").Once().Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllRouteTables", mock.Anything).Once().Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsRouteResourceType, resourceaws.AwsRouteTableResourceType),
 		},
@@ -156,7 +156,7 @@ func TestRouteSupplier_Resources(t *testing.T) {
 				&fakeEC2,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
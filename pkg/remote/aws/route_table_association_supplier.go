@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
@@ -29,8 +30,8 @@ func NewRouteTableAssociationSupplier(provider *AWSTerraformProvider, deserializ
 	}
 }
 
-func (s *RouteTableAssociationSupplier) Resources() ([]resource.Resource, error) {
-	tables, err := s.repo.ListAllRouteTables()
+func (s *RouteTableAssociationSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	tables, err := s.repo.ListAllRouteTables(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, aws.AwsRouteTableAssociationResourceType, aws.AwsRouteTableResourceType)
 	}
@@ -43,13 +44,13 @@ func (s *RouteTableAssociationSupplier) Resources() ([]resource.Resource, error)
 				continue
 			}
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readRouteTableAssociation(res)
+				return s.readRouteTableAssociation(ctx, res)
 			})
 		}
 	}
 
 	// Retrieve results from terraform provider
-	routeTableAssociationResources, err := s.runner.Wait()
+	routeTableAssociationResources, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -63,12 +64,13 @@ func (s *RouteTableAssociationSupplier) Resources() ([]resource.Resource, error)
 	return deserializedRouteTableAssociations, nil
 }
 
-func (s *RouteTableAssociationSupplier) readRouteTableAssociation(assoc ec2.RouteTableAssociation) (cty.Value, error) {
+func (s *RouteTableAssociationSupplier) readRouteTableAssociation(ctx context.Context, assoc ec2.RouteTableAssociation) (cty.Value, error) {
 	var Ty resource.ResourceType = aws.AwsRouteTableAssociationResourceType
 	attributes := map[string]interface{}{
 		"route_table_id": *assoc.RouteTableId,
 	}
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		Ctx:        ctx,
 		ID:         *assoc.RouteTableAssociationId,
 		Ty:         Ty,
 		Attributes: flatmap.Flatten(attributes),
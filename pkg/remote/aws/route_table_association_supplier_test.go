@@ -36,7 +36,7 @@ func TestRouteTableAssociationSupplier_Resources(t *testing.T) {
 			test:    "no route table associations (test for nil values)",
 			dirName: "route_table_assoc_empty",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllRouteTables").Once().Return([]*ec2.RouteTable{
+				client.On("ListAllRouteTables", mock.Anything).Once().Return([]*ec2.RouteTable{
 					{
 						RouteTableId: awssdk.String("assoc_with_nil"),
 						Associations: []*ec2.RouteTableAssociation{
@@ -61,7 +61,7 @@ func TestRouteTableAssociationSupplier_Resources(t *testing.T) {
 			test:    "route_table_association (mixed subnet and gateway associations)",
 			dirName: "route_table_assoc",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllRouteTables").Once().Return([]*ec2.RouteTable{
+				client.On("ListAllRouteTables", mock.Anything).Once().Return([]*ec2.RouteTable{
 					{
 						RouteTableId: aws.String("rtb-05aa6c5673311a17b"), // route
 						Associations: []*ec2.RouteTableAssociation{
@@ -126,7 +126,7 @@ func TestRouteTableAssociationSupplier_Resources(t *testing.T) {
 			test:    "Cannot list route table",
 			dirName: "route_table_assoc_empty",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllRouteTables").Once().Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllRouteTables", mock.Anything).Once().Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsRouteTableAssociationResourceType, resourceaws.AwsRouteTableResourceType),
 		},
@@ -160,7 +160,7 @@ func TestRouteTableAssociationSupplier_Resources(t *testing.T) {
 				&fakeEC2,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
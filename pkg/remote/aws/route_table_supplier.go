@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"errors"
 
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
@@ -34,8 +35,8 @@ func NewRouteTableSupplier(provider *AWSTerraformProvider, deserializer *resourc
 	}
 }
 
-func (s *RouteTableSupplier) Resources() ([]resource.Resource, error) {
-	results, err := s.repo.ListAllRouteTables()
+func (s *RouteTableSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	results, err := s.repo.ListAllRouteTables(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsRouteTableResourceType)
 	}
@@ -62,12 +63,12 @@ func (s *RouteTableSupplier) Resources() ([]resource.Resource, error) {
 	for _, routeTable := range retrievedDefaultRouteTables {
 		res := *routeTable
 		s.defaultRouteTableRunner.Run(func() (cty.Value, error) {
-			return s.readRouteTable(res, true)
+			return s.readRouteTable(ctx, res, true)
 		})
 	}
 
 	// Retrieve results from terraform provider
-	defaultRouteTableResources, err := s.defaultRouteTableRunner.Wait()
+	defaultRouteTableResources, err := s.defaultRouteTableRunner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -75,11 +76,11 @@ func (s *RouteTableSupplier) Resources() ([]resource.Resource, error) {
 	for _, routeTable := range retrievedRouteTables {
 		res := *routeTable
 		s.routeTableRunner.Run(func() (cty.Value, error) {
-			return s.readRouteTable(res, false)
+			return s.readRouteTable(ctx, res, false)
 		})
 	}
 
-	routeTableResources, err := s.routeTableRunner.Wait()
+	routeTableResources, err := s.routeTableRunner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -101,7 +102,7 @@ func (s *RouteTableSupplier) Resources() ([]resource.Resource, error) {
 	return resources, nil
 }
 
-func (s *RouteTableSupplier) readRouteTable(routeTable ec2.RouteTable, isMain bool) (cty.Value, error) {
+func (s *RouteTableSupplier) readRouteTable(ctx context.Context, routeTable ec2.RouteTable, isMain bool) (cty.Value, error) {
 	var Ty resource.ResourceType = aws.AwsRouteTableResourceType
 	attributes := map[string]interface{}{}
 	if isMain {
@@ -112,6 +113,7 @@ func (s *RouteTableSupplier) readRouteTable(routeTable ec2.RouteTable, isMain bo
 		attributes["vpc_id"] = *routeTable.VpcId
 	}
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
+		Ctx:        ctx,
 		ID:         *routeTable.RouteTableId,
 		Ty:         Ty,
 		Attributes: flatmap.Flatten(attributes),
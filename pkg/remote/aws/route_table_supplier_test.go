@@ -38,7 +38,7 @@ func TestRouteTableSupplier_Resources(t *testing.T) {
 			test:    "no route table",
 			dirName: "route_table_empty",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllRouteTables").Once().Return([]*ec2.RouteTable{}, nil)
+				client.On("ListAllRouteTables", mock.Anything).Once().Return([]*ec2.RouteTable{}, nil)
 			},
 			err: nil,
 		},
@@ -46,7 +46,7 @@ func TestRouteTableSupplier_Resources(t *testing.T) {
 			test:    "mixed default_route_table and route_table",
 			dirName: "route_table",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllRouteTables").Once().Return([]*ec2.RouteTable{
+				client.On("ListAllRouteTables", mock.Anything).Once().Return([]*ec2.RouteTable{
 					{
 						RouteTableId: aws.String("rtb-08b7b71af15e183ce"), // table1
 					},
@@ -73,7 +73,7 @@ func TestRouteTableSupplier_Resources(t *testing.T) {
 			test:    "cannot list route table",
 			dirName: "route_table_empty",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllRouteTables").Once().Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllRouteTables", mock.Anything).Once().Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsRouteTableResourceType),
 		},
@@ -108,7 +108,7 @@ func TestRouteTableSupplier_Resources(t *testing.T) {
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
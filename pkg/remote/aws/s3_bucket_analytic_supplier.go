@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -32,26 +33,26 @@ func NewS3BucketAnalyticSupplier(provider *AWSTerraformProvider, repository repo
 	}
 }
 
-func (s *S3BucketAnalyticSupplier) Resources() ([]resource.Resource, error) {
-	buckets, err := s.repository.ListAllBuckets()
+func (s *S3BucketAnalyticSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	buckets, err := s.repository.ListAllBuckets(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, aws.AwsS3BucketAnalyticsConfigurationResourceType, aws.AwsS3BucketResourceType)
 	}
 
 	for _, bucket := range buckets {
 		bucket := *bucket
-		region, err := s.repository.GetBucketLocation(&bucket)
+		region, err := s.repository.GetBucketLocation(ctx, &bucket)
 		if err != nil {
 			return nil, err
 		}
 		if region == "" || region != s.providerConfig.DefaultAlias {
 			continue
 		}
-		if err := s.listBucketAnalyticConfiguration(&bucket, region); err != nil {
+		if err := s.listBucketAnalyticConfiguration(ctx, &bucket, region); err != nil {
 			return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsS3BucketAnalyticsConfigurationResourceType)
 		}
 	}
-	ctyVals, err := s.runner.Wait()
+	ctyVals, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -59,9 +60,9 @@ func (s *S3BucketAnalyticSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(aws.AwsS3BucketAnalyticsConfigurationResourceType, ctyVals)
 }
 
-func (s *S3BucketAnalyticSupplier) listBucketAnalyticConfiguration(bucket *s3.Bucket, region string) error {
+func (s *S3BucketAnalyticSupplier) listBucketAnalyticConfiguration(ctx context.Context, bucket *s3.Bucket, region string) error {
 
-	analyticsConfigurationList, err := s.repository.ListBucketAnalyticsConfigurations(bucket, region)
+	analyticsConfigurationList, err := s.repository.ListBucketAnalyticsConfigurations(ctx, bucket, region)
 	if err != nil {
 		return err
 	}
@@ -71,8 +72,9 @@ func (s *S3BucketAnalyticSupplier) listBucketAnalyticConfiguration(bucket *s3.Bu
 		s.runner.Run(func() (cty.Value, error) {
 			s3BucketAnalytic, err := s.reader.ReadResource(
 				terraform.ReadResourceArgs{
-					Ty: aws.AwsS3BucketAnalyticsConfigurationResourceType,
-					ID: id,
+					Ctx: ctx,
+					Ty:  aws.AwsS3BucketAnalyticsConfigurationResourceType,
+					ID:  id,
 					Attributes: map[string]string{
 						"alias": region,
 					},
@@ -22,6 +22,7 @@ import (
 	"github.com/cloudskiff/driftctl/test/goldenfile"
 	"github.com/cloudskiff/driftctl/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestS3BucketAnalyticSupplier_Resources(t *testing.T) {
@@ -39,6 +40,7 @@ func TestS3BucketAnalyticSupplier_Resources(t *testing.T) {
 			mocks: func(repository *repository.MockS3Repository) {
 				repository.On(
 					"ListAllBuckets",
+					mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -47,6 +49,7 @@ func TestS3BucketAnalyticSupplier_Resources(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 				).Return(
 					"eu-west-1",
@@ -55,6 +58,7 @@ func TestS3BucketAnalyticSupplier_Resources(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift2")},
 				).Return(
 					"eu-west-3",
@@ -63,6 +67,7 @@ func TestS3BucketAnalyticSupplier_Resources(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift3")},
 				).Return(
 					"ap-northeast-1",
@@ -71,6 +76,7 @@ func TestS3BucketAnalyticSupplier_Resources(t *testing.T) {
 
 				repository.On(
 					"ListBucketAnalyticsConfigurations",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift2")},
 					"eu-west-3",
 				).Return(
@@ -86,14 +92,14 @@ func TestS3BucketAnalyticSupplier_Resources(t *testing.T) {
 		{
 			test: "cannot list bucket", dirName: "s3_bucket_analytics_list_bucket",
 			mocks: func(repository *repository.MockS3Repository) {
-				repository.On("ListAllBuckets").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			wantErr: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsS3BucketAnalyticsConfigurationResourceType, resourceaws.AwsS3BucketResourceType),
 		},
 		{
 			test: "cannot list Analytics", dirName: "s3_bucket_analytics_list_analytics",
 			mocks: func(repository *repository.MockS3Repository) {
-				repository.On("ListAllBuckets").Return(
+				repository.On("ListAllBuckets", mock.Anything).Return(
 					[]*s3.Bucket{
 						{Name: awssdk.String("bucket-martin-test-drift")},
 					},
@@ -101,6 +107,7 @@ func TestS3BucketAnalyticSupplier_Resources(t *testing.T) {
 				)
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 				).Return(
 					"eu-west-3",
@@ -109,6 +116,7 @@ func TestS3BucketAnalyticSupplier_Resources(t *testing.T) {
 
 				repository.On(
 					"ListBucketAnalyticsConfigurations",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 					"eu-west-3",
 				).Return(
@@ -157,7 +165,7 @@ func TestS3BucketAnalyticSupplier_Resources(t *testing.T) {
 					DefaultAlias: "eu-west-3",
 				},
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, err, tt.wantErr)
 
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
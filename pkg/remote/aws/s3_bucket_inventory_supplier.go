@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -32,26 +33,26 @@ func NewS3BucketInventorySupplier(provider *AWSTerraformProvider, repository rep
 	}
 }
 
-func (s *S3BucketInventorySupplier) Resources() ([]resource.Resource, error) {
-	buckets, err := s.repository.ListAllBuckets()
+func (s *S3BucketInventorySupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	buckets, err := s.repository.ListAllBuckets(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, aws.AwsS3BucketInventoryResourceType, aws.AwsS3BucketResourceType)
 	}
 
 	for _, bucket := range buckets {
 		bucket := *bucket
-		region, err := s.repository.GetBucketLocation(&bucket)
+		region, err := s.repository.GetBucketLocation(ctx, &bucket)
 		if err != nil {
 			return nil, err
 		}
 		if region == "" || region != s.providerConfig.DefaultAlias {
 			continue
 		}
-		if err := s.listBucketInventoryConfiguration(&bucket, region); err != nil {
+		if err := s.listBucketInventoryConfiguration(ctx, &bucket, region); err != nil {
 			return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsS3BucketInventoryResourceType)
 		}
 	}
-	ctyVals, err := s.runner.Wait()
+	ctyVals, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -59,8 +60,8 @@ func (s *S3BucketInventorySupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(aws.AwsS3BucketInventoryResourceType, ctyVals)
 }
 
-func (s *S3BucketInventorySupplier) listBucketInventoryConfiguration(bucket *s3.Bucket, region string) error {
-	inventoryConfigurations, err := s.repository.ListBucketInventoryConfigurations(bucket, region)
+func (s *S3BucketInventorySupplier) listBucketInventoryConfiguration(ctx context.Context, bucket *s3.Bucket, region string) error {
+	inventoryConfigurations, err := s.repository.ListBucketInventoryConfigurations(ctx, bucket, region)
 	if err != nil {
 		return err
 	}
@@ -69,8 +70,9 @@ func (s *S3BucketInventorySupplier) listBucketInventoryConfiguration(bucket *s3.
 		s.runner.Run(func() (cty.Value, error) {
 			s3BucketInventory, err := s.reader.ReadResource(
 				terraform.ReadResourceArgs{
-					Ty: aws.AwsS3BucketInventoryResourceType,
-					ID: id,
+					Ctx: ctx,
+					Ty:  aws.AwsS3BucketInventoryResourceType,
+					ID:  id,
 					Attributes: map[string]string{
 						"alias": region,
 					},
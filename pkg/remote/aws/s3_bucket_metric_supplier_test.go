@@ -22,6 +22,7 @@ import (
 	"github.com/cloudskiff/driftctl/test/goldenfile"
 	"github.com/cloudskiff/driftctl/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestS3BucketMetricSupplier_Resources(t *testing.T) {
@@ -37,6 +38,7 @@ func TestS3BucketMetricSupplier_Resources(t *testing.T) {
 			mocks: func(repository *repository.MockS3Repository) {
 				repository.On(
 					"ListAllBuckets",
+					mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -45,6 +47,7 @@ func TestS3BucketMetricSupplier_Resources(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 				).Return(
 					"eu-west-1",
@@ -53,6 +56,7 @@ func TestS3BucketMetricSupplier_Resources(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift2")},
 				).Return(
 					"eu-west-3",
@@ -61,6 +65,7 @@ func TestS3BucketMetricSupplier_Resources(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift3")},
 				).Return(
 					"ap-northeast-1",
@@ -69,6 +74,7 @@ func TestS3BucketMetricSupplier_Resources(t *testing.T) {
 
 				repository.On(
 					"ListBucketMetricsConfigurations",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift2")},
 					"eu-west-3",
 				).Return(
@@ -83,14 +89,14 @@ func TestS3BucketMetricSupplier_Resources(t *testing.T) {
 		{
 			test: "cannot list bucket", dirName: "s3_bucket_metrics_list_bucket",
 			mocks: func(repository *repository.MockS3Repository) {
-				repository.On("ListAllBuckets").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			wantErr: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsS3BucketMetricResourceType, resourceaws.AwsS3BucketResourceType),
 		},
 		{
 			test: "cannot list metrics", dirName: "s3_bucket_metrics_list_metrics",
 			mocks: func(repository *repository.MockS3Repository) {
-				repository.On("ListAllBuckets").Return(
+				repository.On("ListAllBuckets", mock.Anything).Return(
 					[]*s3.Bucket{
 						{Name: awssdk.String("bucket-martin-test-drift")},
 					},
@@ -98,6 +104,7 @@ func TestS3BucketMetricSupplier_Resources(t *testing.T) {
 				)
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 				).Return(
 					"eu-west-3",
@@ -106,6 +113,7 @@ func TestS3BucketMetricSupplier_Resources(t *testing.T) {
 
 				repository.On(
 					"ListBucketMetricsConfigurations",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 					"eu-west-3",
 				).Return(
@@ -153,7 +161,7 @@ func TestS3BucketMetricSupplier_Resources(t *testing.T) {
 					DefaultAlias: "eu-west-3",
 				},
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, err, tt.wantErr)
 
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 	tf "github.com/cloudskiff/driftctl/pkg/remote/terraform"
@@ -29,15 +30,15 @@ func NewS3BucketNotificationSupplier(provider *AWSTerraformProvider, repository
 	}
 }
 
-func (s *S3BucketNotificationSupplier) Resources() ([]resource.Resource, error) {
-	buckets, err := s.repository.ListAllBuckets()
+func (s *S3BucketNotificationSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	buckets, err := s.repository.ListAllBuckets(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, aws.AwsS3BucketNotificationResourceType, aws.AwsS3BucketResourceType)
 	}
 
 	for _, bucket := range buckets {
 		bucket := *bucket
-		region, err := s.repository.GetBucketLocation(&bucket)
+		region, err := s.repository.GetBucketLocation(ctx, &bucket)
 		if err != nil {
 			return nil, err
 		}
@@ -46,8 +47,9 @@ func (s *S3BucketNotificationSupplier) Resources() ([]resource.Resource, error)
 		}
 		s.runner.Run(func() (cty.Value, error) {
 			s3BucketPolicy, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-				Ty: aws.AwsS3BucketNotificationResourceType,
-				ID: *bucket.Name,
+				Ctx: ctx,
+				Ty:  aws.AwsS3BucketNotificationResourceType,
+				ID:  *bucket.Name,
 				Attributes: map[string]string{
 					"alias": region,
 				},
@@ -58,7 +60,7 @@ func (s *S3BucketNotificationSupplier) Resources() ([]resource.Resource, error)
 			return *s3BucketPolicy, err
 		})
 	}
-	ctyVals, err := s.runner.Wait()
+	ctyVals, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
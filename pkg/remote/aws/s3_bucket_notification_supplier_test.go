@@ -22,6 +22,7 @@ import (
 	"github.com/cloudskiff/driftctl/test/goldenfile"
 	"github.com/cloudskiff/driftctl/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestS3BucketNotificationSupplier_Resources(t *testing.T) {
@@ -38,12 +39,14 @@ func TestS3BucketNotificationSupplier_Resources(t *testing.T) {
 			mocks: func(repository *repository.MockS3Repository) {
 				repository.On(
 					"ListAllBuckets",
+					mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("dritftctl-test-no-notifications")},
 				}, nil)
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("dritftctl-test-no-notifications")},
 				).Return(
 					"eu-west-3",
@@ -56,6 +59,7 @@ func TestS3BucketNotificationSupplier_Resources(t *testing.T) {
 			mocks: func(repository *repository.MockS3Repository) {
 				repository.On(
 					"ListAllBuckets",
+					mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -64,6 +68,7 @@ func TestS3BucketNotificationSupplier_Resources(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 				).Return(
 					"eu-west-1",
@@ -72,6 +77,7 @@ func TestS3BucketNotificationSupplier_Resources(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift2")},
 				).Return(
 					"eu-west-3",
@@ -80,6 +86,7 @@ func TestS3BucketNotificationSupplier_Resources(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift3")},
 				).Return(
 					"ap-northeast-1",
@@ -90,7 +97,7 @@ func TestS3BucketNotificationSupplier_Resources(t *testing.T) {
 		{
 			test: "Cannot list bucket", dirName: "s3_bucket_notifications_list_bucket",
 			mocks: func(repository *repository.MockS3Repository) {
-				repository.On("ListAllBuckets").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			wantErr: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsS3BucketNotificationResourceType, resourceaws.AwsS3BucketResourceType),
 		},
@@ -131,7 +138,7 @@ func TestS3BucketNotificationSupplier_Resources(t *testing.T) {
 					DefaultAlias: "eu-west-3",
 				},
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, err, tt.wantErr)
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
 		})
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 	tf "github.com/cloudskiff/driftctl/pkg/remote/terraform"
@@ -29,15 +30,15 @@ func NewS3BucketPolicySupplier(provider *AWSTerraformProvider, repository reposi
 	}
 }
 
-func (s *S3BucketPolicySupplier) Resources() ([]resource.Resource, error) {
-	buckets, err := s.repository.ListAllBuckets()
+func (s *S3BucketPolicySupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	buckets, err := s.repository.ListAllBuckets(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, aws.AwsS3BucketPolicyResourceType, aws.AwsS3BucketResourceType)
 	}
 
 	for _, bucket := range buckets {
 		bucket := *bucket
-		region, err := s.repository.GetBucketLocation(&bucket)
+		region, err := s.repository.GetBucketLocation(ctx, &bucket)
 		if err != nil {
 			return nil, err
 		}
@@ -47,8 +48,9 @@ func (s *S3BucketPolicySupplier) Resources() ([]resource.Resource, error) {
 		s.runner.Run(func() (cty.Value, error) {
 			s3BucketNotification, err := s.reader.ReadResource(
 				terraform.ReadResourceArgs{
-					Ty: aws.AwsS3BucketPolicyResourceType,
-					ID: *bucket.Name,
+					Ctx: ctx,
+					Ty:  aws.AwsS3BucketPolicyResourceType,
+					ID:  *bucket.Name,
 					Attributes: map[string]string{
 						"alias": region,
 					},
@@ -61,7 +63,7 @@ func (s *S3BucketPolicySupplier) Resources() ([]resource.Resource, error) {
 			return *s3BucketNotification, err
 		})
 	}
-	ctyVals, err := s.runner.Wait()
+	ctyVals, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
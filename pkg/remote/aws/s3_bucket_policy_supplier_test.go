@@ -22,6 +22,7 @@ import (
 	"github.com/cloudskiff/driftctl/test/goldenfile"
 	"github.com/cloudskiff/driftctl/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestS3BucketPolicySupplier_Resources(t *testing.T) {
@@ -38,12 +39,14 @@ func TestS3BucketPolicySupplier_Resources(t *testing.T) {
 			mocks: func(repository *repository.MockS3Repository) {
 				repository.On(
 					"ListAllBuckets",
+					mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("dritftctl-test-no-policy")},
 				}, nil)
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("dritftctl-test-no-policy")},
 				).Return(
 					"eu-west-3",
@@ -56,6 +59,7 @@ func TestS3BucketPolicySupplier_Resources(t *testing.T) {
 			mocks: func(repository *repository.MockS3Repository) {
 				repository.On(
 					"ListAllBuckets",
+					mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -64,6 +68,7 @@ func TestS3BucketPolicySupplier_Resources(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 				).Return(
 					"eu-west-1",
@@ -72,6 +77,7 @@ func TestS3BucketPolicySupplier_Resources(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift2")},
 				).Return(
 					"eu-west-3",
@@ -80,6 +86,7 @@ func TestS3BucketPolicySupplier_Resources(t *testing.T) {
 
 				repository.On(
 					"GetBucketLocation",
+					mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift3")},
 				).Return(
 					"ap-northeast-1",
@@ -90,7 +97,7 @@ func TestS3BucketPolicySupplier_Resources(t *testing.T) {
 		{
 			test: "cannot list bucket", dirName: "s3_bucket_policies_list_bucket",
 			mocks: func(repository *repository.MockS3Repository) {
-				repository.On("ListAllBuckets").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			wantErr: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsS3BucketPolicyResourceType, resourceaws.AwsS3BucketResourceType),
 		},
@@ -132,7 +139,7 @@ func TestS3BucketPolicySupplier_Resources(t *testing.T) {
 					DefaultAlias: "eu-west-3",
 				},
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, err, tt.wantErr)
 
 			test.CtyTestDiff(got, tt.dirName, provider, deserializer, shouldUpdate, t)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/zclconf/go-cty/cty"
 
@@ -31,8 +32,8 @@ func NewS3BucketSupplier(provider *AWSTerraformProvider, repository repository.S
 	}
 }
 
-func (s *S3BucketSupplier) Resources() ([]resource.Resource, error) {
-	buckets, err := s.repository.ListAllBuckets()
+func (s *S3BucketSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	buckets, err := s.repository.ListAllBuckets(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsS3BucketResourceType)
 	}
@@ -40,10 +41,10 @@ func (s *S3BucketSupplier) Resources() ([]resource.Resource, error) {
 	for _, bucket := range buckets {
 		b := *bucket
 		s.runner.Run(func() (cty.Value, error) {
-			return s.readBucket(b)
+			return s.readBucket(ctx, b)
 		})
 	}
-	values, err := s.runner.Wait()
+	values, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -51,8 +52,8 @@ func (s *S3BucketSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(aws.AwsS3BucketResourceType, values)
 }
 
-func (s *S3BucketSupplier) readBucket(bucket s3.Bucket) (cty.Value, error) {
-	region, err := s.repository.GetBucketLocation(&bucket)
+func (s *S3BucketSupplier) readBucket(ctx context.Context, bucket s3.Bucket) (cty.Value, error) {
+	region, err := s.repository.GetBucketLocation(ctx, &bucket)
 	if err != nil {
 		return cty.NilVal, err
 	}
@@ -61,8 +62,9 @@ func (s *S3BucketSupplier) readBucket(bucket s3.Bucket) (cty.Value, error) {
 	}
 
 	s3Bucket, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		Ty: aws.AwsS3BucketResourceType,
-		ID: *bucket.Name,
+		Ctx: ctx,
+		Ty:  aws.AwsS3BucketResourceType,
+		ID:  *bucket.Name,
 		Attributes: map[string]string{
 			"alias": region,
 		},
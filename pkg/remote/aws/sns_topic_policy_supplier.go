@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
@@ -29,8 +30,8 @@ func NewSNSTopicPolicySupplier(provider *AWSTerraformProvider, deserializer *res
 	}
 }
 
-func (s *SNSTopicPolicySupplier) Resources() ([]resource.Resource, error) {
-	topics, err := s.client.ListAllTopics()
+func (s *SNSTopicPolicySupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	topics, err := s.client.ListAllTopics(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, aws.AwsSnsTopicPolicyResourceType, aws.AwsSnsTopicResourceType)
 	}
@@ -38,11 +39,11 @@ func (s *SNSTopicPolicySupplier) Resources() ([]resource.Resource, error) {
 	for _, topic := range topics {
 		topic := *topic
 		s.runner.Run(func() (cty.Value, error) {
-			return s.readTopicPolicy(topic)
+			return s.readTopicPolicy(ctx, topic)
 		})
 	}
 
-	retrieve, err := s.runner.Wait()
+	retrieve, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -50,10 +51,11 @@ func (s *SNSTopicPolicySupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(aws.AwsSnsTopicPolicyResourceType, retrieve)
 }
 
-func (s *SNSTopicPolicySupplier) readTopicPolicy(topic sns.Topic) (cty.Value, error) {
+func (s *SNSTopicPolicySupplier) readTopicPolicy(ctx context.Context, topic sns.Topic) (cty.Value, error) {
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		ID: *topic.TopicArn,
-		Ty: aws.AwsSnsTopicPolicyResourceType,
+		Ctx: ctx,
+		ID:  *topic.TopicArn,
+		Ty:  aws.AwsSnsTopicPolicyResourceType,
 		Attributes: map[string]string{
 			"topic_arn": *topic.TopicArn,
 		},
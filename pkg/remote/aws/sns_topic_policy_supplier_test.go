@@ -42,7 +42,7 @@ func TestSNSTopicPolicySupplier_Resources(t *testing.T) {
 			test:    "no SNS Topic policy",
 			dirName: "sns_topic_policy_empty",
 			mocks: func(client *mocks.SNSRepository) {
-				client.On("ListAllTopics").Return([]*sns.Topic{}, nil)
+				client.On("ListAllTopics", mock.Anything).Return([]*sns.Topic{}, nil)
 			},
 			err: nil,
 		},
@@ -50,7 +50,7 @@ func TestSNSTopicPolicySupplier_Resources(t *testing.T) {
 			test:    "Multiple SNSTopicPolicy",
 			dirName: "sns_topic_policy_multiple",
 			mocks: func(client *mocks.SNSRepository) {
-				client.On("ListAllTopics").Return([]*sns.Topic{
+				client.On("ListAllTopics", mock.Anything).Return([]*sns.Topic{
 					{TopicArn: aws.String("arn:aws:sns:us-east-1:526954929923:my-topic-with-policy")},
 					{TopicArn: aws.String("arn:aws:sns:us-east-1:526954929923:my-topic-with-policy2")},
 				}, nil)
@@ -61,7 +61,7 @@ func TestSNSTopicPolicySupplier_Resources(t *testing.T) {
 			test:    "cannot list SNSTopic",
 			dirName: "sns_topic_policy_topic_list",
 			mocks: func(client *mocks.SNSRepository) {
-				client.On("ListAllTopics").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllTopics", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsSnsTopicPolicyResourceType, resourceaws.AwsSnsTopicResourceType),
 		},
@@ -95,7 +95,7 @@ func TestSNSTopicPolicySupplier_Resources(t *testing.T) {
 				&fakeClient,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
@@ -53,19 +54,19 @@ func NewSNSTopicSubscriptionSupplier(provider *AWSTerraformProvider, a alerter.A
 	}
 }
 
-func (s *SNSTopicSubscriptionSupplier) Resources() ([]resource.Resource, error) {
-	subscriptions, err := s.client.ListAllSubscriptions()
+func (s *SNSTopicSubscriptionSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	subscriptions, err := s.client.ListAllSubscriptions(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsSnsTopicSubscriptionResourceType)
 	}
 	for _, subscription := range subscriptions {
 		subscription := subscription
 		s.runner.Run(func() (cty.Value, error) {
-			return s.readTopicSubscription(subscription, s.alerter)
+			return s.readTopicSubscription(ctx, subscription, s.alerter)
 		})
 	}
 
-	retrieve, err := s.runner.Wait()
+	retrieve, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +74,7 @@ func (s *SNSTopicSubscriptionSupplier) Resources() ([]resource.Resource, error)
 	return s.deserializer.Deserialize(aws.AwsSnsTopicSubscriptionResourceType, retrieve)
 }
 
-func (s *SNSTopicSubscriptionSupplier) readTopicSubscription(subscription *sns.Subscription, alertr alerter.AlerterInterface) (cty.Value, error) {
+func (s *SNSTopicSubscriptionSupplier) readTopicSubscription(ctx context.Context, subscription *sns.Subscription, alertr alerter.AlerterInterface) (cty.Value, error) {
 	if subscription.SubscriptionArn != nil && !arn.IsARN(*subscription.SubscriptionArn) {
 		alertr.SendAlert(
 			fmt.Sprintf("%s.%s", aws.AwsSnsTopicSubscriptionResourceType, *subscription.SubscriptionArn),
@@ -83,8 +84,9 @@ func (s *SNSTopicSubscriptionSupplier) readTopicSubscription(subscription *sns.S
 	}
 
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		ID: *subscription.SubscriptionArn,
-		Ty: aws.AwsSnsTopicSubscriptionResourceType,
+		Ctx: ctx,
+		ID:  *subscription.SubscriptionArn,
+		Ty:  aws.AwsSnsTopicSubscriptionResourceType,
 		Attributes: map[string]string{
 			"SubscriptionId": *subscription.SubscriptionArn,
 		},
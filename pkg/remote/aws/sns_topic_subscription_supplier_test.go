@@ -44,7 +44,7 @@ func TestSNSTopicSubscriptionSupplier_Resources(t *testing.T) {
 			test:    "no SNS Topic Subscription",
 			dirName: "sns_topic_subscription_empty",
 			mocks: func(client *mocks.SNSRepository) {
-				client.On("ListAllSubscriptions").Return([]*sns.Subscription{}, nil)
+				client.On("ListAllSubscriptions", mock.Anything).Return([]*sns.Subscription{}, nil)
 			},
 			err: nil,
 		},
@@ -52,7 +52,7 @@ func TestSNSTopicSubscriptionSupplier_Resources(t *testing.T) {
 			test:    "Multiple SNSTopic Subscription",
 			dirName: "sns_topic_subscription_multiple",
 			mocks: func(client *mocks.SNSRepository) {
-				client.On("ListAllSubscriptions").Return([]*sns.Subscription{
+				client.On("ListAllSubscriptions", mock.Anything).Return([]*sns.Subscription{
 					{SubscriptionArn: aws.String("arn:aws:sns:us-east-1:526954929923:user-updates-topic2:c0f794c5-a009-4db4-9147-4c55959787fa")},
 					{SubscriptionArn: aws.String("arn:aws:sns:us-east-1:526954929923:user-updates-topic:b6e66147-2b31-4486-8d4b-2a2272264c8e")},
 				}, nil)
@@ -63,7 +63,7 @@ func TestSNSTopicSubscriptionSupplier_Resources(t *testing.T) {
 			test:    "Multiple SNSTopic Subscription with one pending and one incorrect",
 			dirName: "sns_topic_subscription_multiple",
 			mocks: func(client *mocks.SNSRepository) {
-				client.On("ListAllSubscriptions").Return([]*sns.Subscription{
+				client.On("ListAllSubscriptions", mock.Anything).Return([]*sns.Subscription{
 					{SubscriptionArn: aws.String("PendingConfirmation"), Endpoint: aws.String("TEST")},
 					{SubscriptionArn: aws.String("Incorrect"), Endpoint: aws.String("INCORRECT")},
 					{SubscriptionArn: aws.String("arn:aws:sns:us-east-1:526954929923:user-updates-topic2:c0f794c5-a009-4db4-9147-4c55959787fa")},
@@ -84,7 +84,7 @@ func TestSNSTopicSubscriptionSupplier_Resources(t *testing.T) {
 			test:    "cannot list SNSTopic subscription",
 			dirName: "sns_topic_subscription_list",
 			mocks: func(client *mocks.SNSRepository) {
-				client.On("ListAllSubscriptions").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllSubscriptions", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsSnsTopicSubscriptionResourceType),
 		},
@@ -121,7 +121,7 @@ func TestSNSTopicSubscriptionSupplier_Resources(t *testing.T) {
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 				a,
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			if c.alerts == nil {
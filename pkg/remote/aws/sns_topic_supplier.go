@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
@@ -29,8 +30,8 @@ func NewSNSTopicSupplier(provider *AWSTerraformProvider, deserializer *resource.
 	}
 }
 
-func (s *SNSTopicSupplier) Resources() ([]resource.Resource, error) {
-	topics, err := s.client.ListAllTopics()
+func (s *SNSTopicSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	topics, err := s.client.ListAllTopics(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsSnsTopicResourceType)
 	}
@@ -38,11 +39,11 @@ func (s *SNSTopicSupplier) Resources() ([]resource.Resource, error) {
 	for _, topic := range topics {
 		t := *topic
 		s.runner.Run(func() (cty.Value, error) {
-			return s.readTopic(t)
+			return s.readTopic(ctx, t)
 		})
 	}
 
-	retrieve, err := s.runner.Wait()
+	retrieve, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -50,10 +51,11 @@ func (s *SNSTopicSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(aws.AwsSnsTopicResourceType, retrieve)
 }
 
-func (s *SNSTopicSupplier) readTopic(topic sns.Topic) (cty.Value, error) {
+func (s *SNSTopicSupplier) readTopic(ctx context.Context, topic sns.Topic) (cty.Value, error) {
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		ID: *topic.TopicArn,
-		Ty: aws.AwsSnsTopicResourceType,
+		Ctx: ctx,
+		ID:  *topic.TopicArn,
+		Ty:  aws.AwsSnsTopicResourceType,
 		Attributes: map[string]string{
 			"topic_arn": *topic.TopicArn,
 		},
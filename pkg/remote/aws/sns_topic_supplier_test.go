@@ -42,7 +42,7 @@ func TestSNSTopicSupplier_Resources(t *testing.T) {
 			test:    "no SNS Topic",
 			dirName: "sns_topic_empty",
 			mocks: func(client *mocks.SNSRepository) {
-				client.On("ListAllTopics").Return([]*sns.Topic{}, nil)
+				client.On("ListAllTopics", mock.Anything).Return([]*sns.Topic{}, nil)
 			},
 			err: nil,
 		},
@@ -50,7 +50,7 @@ func TestSNSTopicSupplier_Resources(t *testing.T) {
 			test:    "Multiple SNSTopic",
 			dirName: "sns_topic_multiple",
 			mocks: func(client *mocks.SNSRepository) {
-				client.On("ListAllTopics").Return([]*sns.Topic{
+				client.On("ListAllTopics", mock.Anything).Return([]*sns.Topic{
 					{TopicArn: aws.String("arn:aws:sns:eu-west-3:526954929923:user-updates-topic")},
 					{TopicArn: aws.String("arn:aws:sns:eu-west-3:526954929923:user-updates-topic2")},
 					{TopicArn: aws.String("arn:aws:sns:eu-west-3:526954929923:user-updates-topic3")},
@@ -62,7 +62,7 @@ func TestSNSTopicSupplier_Resources(t *testing.T) {
 			test:    "cannot list SNSTopic",
 			dirName: "sns_topic_empty",
 			mocks: func(client *mocks.SNSRepository) {
-				client.On("ListAllTopics").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllTopics", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsSnsTopicResourceType),
 		},
@@ -96,7 +96,7 @@ func TestSNSTopicSupplier_Resources(t *testing.T) {
 				&fakeClient,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 	"github.com/cloudskiff/driftctl/pkg/resource"
@@ -27,8 +28,8 @@ func NewSqsQueuePolicySupplier(provider *AWSTerraformProvider, deserializer *res
 	}
 }
 
-func (s *SqsQueuePolicySupplier) Resources() ([]resource.Resource, error) {
-	queues, err := s.client.ListAllQueues()
+func (s *SqsQueuePolicySupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	queues, err := s.client.ListAllQueues(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationErrorWithType(err, aws.AwsSqsQueuePolicyResourceType, aws.AwsSqsQueueResourceType)
 	}
@@ -36,11 +37,11 @@ func (s *SqsQueuePolicySupplier) Resources() ([]resource.Resource, error) {
 	for _, queue := range queues {
 		q := *queue
 		s.runner.Run(func() (cty.Value, error) {
-			return s.readSqsQueuePolicy(q)
+			return s.readSqsQueuePolicy(ctx, q)
 		})
 	}
 
-	resources, err := s.runner.Wait()
+	resources, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -48,11 +49,12 @@ func (s *SqsQueuePolicySupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(aws.AwsSqsQueuePolicyResourceType, resources)
 }
 
-func (s *SqsQueuePolicySupplier) readSqsQueuePolicy(queueURL string) (cty.Value, error) {
+func (s *SqsQueuePolicySupplier) readSqsQueuePolicy(ctx context.Context, queueURL string) (cty.Value, error) {
 	var Ty resource.ResourceType = aws.AwsSqsQueuePolicyResourceType
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		Ty: Ty,
-		ID: queueURL,
+		Ctx: ctx,
+		Ty:  Ty,
+		ID:  queueURL,
 	})
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
@@ -38,7 +38,7 @@ func TestSqsQueuePolicySupplier_Resources(t *testing.T) {
 			test:    "no sqs queue policies",
 			dirName: "sqs_queue_policy_empty",
 			mocks: func(client *mocks.SQSRepository) {
-				client.On("ListAllQueues").Return([]*string{}, nil)
+				client.On("ListAllQueues", mock.Anything).Return([]*string{}, nil)
 			},
 			err: nil,
 		},
@@ -46,7 +46,7 @@ func TestSqsQueuePolicySupplier_Resources(t *testing.T) {
 			test:    "multiple sqs queue policies (default or not)",
 			dirName: "sqs_queue_policy_multiple",
 			mocks: func(client *mocks.SQSRepository) {
-				client.On("ListAllQueues").Return([]*string{
+				client.On("ListAllQueues", mock.Anything).Return([]*string{
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/bar.fifo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/foo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/baz"),
@@ -58,7 +58,7 @@ func TestSqsQueuePolicySupplier_Resources(t *testing.T) {
 			test:    "cannot list sqs queues, thus sqs queue policies",
 			dirName: "sqs_queue_policy_empty",
 			mocks: func(client *mocks.SQSRepository) {
-				client.On("ListAllQueues").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllQueues", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationErrorWithType(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsSqsQueuePolicyResourceType, resourceaws.AwsSqsQueueResourceType),
 		},
@@ -91,7 +91,7 @@ func TestSqsQueuePolicySupplier_Resources(t *testing.T) {
 				&fakeSQS,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
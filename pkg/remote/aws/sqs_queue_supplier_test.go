@@ -36,7 +36,7 @@ func TestSqsQueueSupplier_Resources(t *testing.T) {
 			test:    "no sqs queues",
 			dirName: "sqs_queue_empty",
 			mocks: func(client *mocks.SQSRepository) {
-				client.On("ListAllQueues").Return([]*string{}, nil)
+				client.On("ListAllQueues", mock.Anything).Return([]*string{}, nil)
 			},
 			err: nil,
 		},
@@ -44,7 +44,7 @@ func TestSqsQueueSupplier_Resources(t *testing.T) {
 			test:    "multiple sqs queues",
 			dirName: "sqs_queue_multiple",
 			mocks: func(client *mocks.SQSRepository) {
-				client.On("ListAllQueues").Return([]*string{
+				client.On("ListAllQueues", mock.Anything).Return([]*string{
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/bar.fifo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/foo"),
 				}, nil)
@@ -55,7 +55,7 @@ func TestSqsQueueSupplier_Resources(t *testing.T) {
 			test:    "cannot list sqs queues",
 			dirName: "sqs_queue_empty",
 			mocks: func(client *mocks.SQSRepository) {
-				client.On("ListAllQueues").Return(nil, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllQueues", mock.Anything).Return(nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsSqsQueueResourceType),
 		},
@@ -88,7 +88,7 @@ func TestSqsQueueSupplier_Resources(t *testing.T) {
 				&fakeSQS,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
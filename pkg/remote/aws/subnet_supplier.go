@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
@@ -33,8 +34,8 @@ func NewSubnetSupplier(provider *AWSTerraformProvider, deserializer *resource.De
 	}
 }
 
-func (s *SubnetSupplier) Resources() ([]resource.Resource, error) {
-	subnets, defaultSubnets, err := s.repo.ListAllSubnets()
+func (s *SubnetSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	subnets, defaultSubnets, err := s.repo.ListAllSubnets(ctx)
 
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsSubnetResourceType)
@@ -43,11 +44,11 @@ func (s *SubnetSupplier) Resources() ([]resource.Resource, error) {
 	for _, item := range subnets {
 		res := *item
 		s.subnetRunner.Run(func() (cty.Value, error) {
-			return s.readSubnet(res)
+			return s.readSubnet(ctx, res)
 		})
 	}
 
-	subnetResources, err := s.subnetRunner.Wait()
+	subnetResources, err := s.subnetRunner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -55,12 +56,12 @@ func (s *SubnetSupplier) Resources() ([]resource.Resource, error) {
 	for _, item := range defaultSubnets {
 		res := *item
 		s.defaultSubnetRunner.Run(func() (cty.Value, error) {
-			return s.readSubnet(res)
+			return s.readSubnet(ctx, res)
 		})
 	}
 
 	// Retrieve results from terraform provider
-	defaultSubnetResources, err := s.defaultSubnetRunner.Wait()
+	defaultSubnetResources, err := s.defaultSubnetRunner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -82,14 +83,15 @@ func (s *SubnetSupplier) Resources() ([]resource.Resource, error) {
 	return resources, nil
 }
 
-func (s *SubnetSupplier) readSubnet(subnet ec2.Subnet) (cty.Value, error) {
+func (s *SubnetSupplier) readSubnet(ctx context.Context, subnet ec2.Subnet) (cty.Value, error) {
 	var Ty resource.ResourceType = aws.AwsSubnetResourceType
 	if subnet.DefaultForAz != nil && *subnet.DefaultForAz {
 		Ty = aws.AwsDefaultSubnetResourceType
 	}
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		ID: *subnet.SubnetId,
-		Ty: Ty,
+		Ctx: ctx,
+		ID:  *subnet.SubnetId,
+		Ty:  Ty,
 	})
 	if err != nil {
 		logrus.Error(err)
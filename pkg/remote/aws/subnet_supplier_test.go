@@ -40,7 +40,7 @@ func TestSubnetSupplier_Resources(t *testing.T) {
 			test:    "no Subnet",
 			dirName: "subnet_empty",
 			mocks: func(repo *repository.MockEC2Repository) {
-				repo.On("ListAllSubnets").Once().Return([]*ec2.Subnet{}, []*ec2.Subnet{}, nil)
+				repo.On("ListAllSubnets", mock.Anything).Once().Return([]*ec2.Subnet{}, []*ec2.Subnet{}, nil)
 			},
 			err: nil,
 		},
@@ -48,7 +48,7 @@ func TestSubnetSupplier_Resources(t *testing.T) {
 			test:    "mixed default Subnet and Subnet",
 			dirName: "subnet",
 			mocks: func(repo *repository.MockEC2Repository) {
-				repo.On("ListAllSubnets").Once().Return([]*ec2.Subnet{
+				repo.On("ListAllSubnets", mock.Anything).Once().Return([]*ec2.Subnet{
 					{
 						SubnetId:     aws.String("subnet-05810d3f933925f6d"), // subnet1
 						DefaultForAz: aws.Bool(false),
@@ -82,7 +82,7 @@ func TestSubnetSupplier_Resources(t *testing.T) {
 			test:    "cannot list Subnet",
 			dirName: "subnet_empty",
 			mocks: func(repo *repository.MockEC2Repository) {
-				repo.On("ListAllSubnets").Once().Return(nil, nil, awserr.NewRequestFailure(nil, 403, ""))
+				repo.On("ListAllSubnets", mock.Anything).Once().Return(nil, nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsSubnetResourceType),
 		},
@@ -117,7 +117,7 @@ func TestSubnetSupplier_Resources(t *testing.T) {
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
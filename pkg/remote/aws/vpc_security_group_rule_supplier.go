@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 
@@ -75,8 +76,8 @@ func NewVPCSecurityGroupRuleSupplier(provider *AWSTerraformProvider, deserialize
 	}
 }
 
-func (s *VPCSecurityGroupRuleSupplier) Resources() ([]resource.Resource, error) {
-	securityGroups, defaultSecurityGroups, err := s.repo.ListAllSecurityGroups()
+func (s *VPCSecurityGroupRuleSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	securityGroups, defaultSecurityGroups, err := s.repo.ListAllSecurityGroups(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsSecurityGroupRuleResourceType)
 	}
@@ -89,10 +90,10 @@ func (s *VPCSecurityGroupRuleSupplier) Resources() ([]resource.Resource, error)
 		for _, securityGroupsRule := range securityGroupsRules {
 			rule := securityGroupsRule
 			s.runner.Run(func() (cty.Value, error) {
-				return s.readSecurityGroupRule(rule)
+				return s.readSecurityGroupRule(ctx, rule)
 			})
 		}
-		results, err = s.runner.Wait()
+		results, err = s.runner.Wait(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -100,12 +101,13 @@ func (s *VPCSecurityGroupRuleSupplier) Resources() ([]resource.Resource, error)
 	return s.deserializer.Deserialize(resourceaws.AwsSecurityGroupRuleResourceType, results)
 }
 
-func (s *VPCSecurityGroupRuleSupplier) readSecurityGroupRule(rule securityGroupRule) (cty.Value, error) {
+func (s *VPCSecurityGroupRuleSupplier) readSecurityGroupRule(ctx context.Context, rule securityGroupRule) (cty.Value, error) {
 	id := rule.getId()
 
 	resSgRule, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		Ty: resourceaws.AwsSecurityGroupRuleResourceType,
-		ID: id,
+		Ctx: ctx,
+		Ty:  resourceaws.AwsSecurityGroupRuleResourceType,
+		ID:  id,
 		Attributes: flatmap.Flatten(map[string]interface{}{
 			"type":                     rule.Type,
 			"security_group_id":        rule.SecurityGroupId,
@@ -39,7 +39,7 @@ func TestVPCSecurityGroupRuleSupplier_Resources(t *testing.T) {
 			test:    "no security group rules",
 			dirName: "vpc_security_group_rule_empty",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{
 					{
 						GroupId:             aws.String("sg-0254c038e32f25530"),
 						IpPermissions:       []*ec2.IpPermission{},
@@ -53,7 +53,7 @@ func TestVPCSecurityGroupRuleSupplier_Resources(t *testing.T) {
 			test:    "with security group rules",
 			dirName: "vpc_security_group_rule_multiple",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{
 					{
 						GroupId: aws.String("sg-0254c038e32f25530"),
 						IpPermissions: []*ec2.IpPermission{
@@ -149,7 +149,7 @@ func TestVPCSecurityGroupRuleSupplier_Resources(t *testing.T) {
 			test:    "should ignore default security group default rules",
 			dirName: "vpc_security_group_default_rules",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{
 					{
 						GroupId:   aws.String("sg-a74815c8"),
 						GroupName: aws.String("default"),
@@ -198,7 +198,7 @@ func TestVPCSecurityGroupRuleSupplier_Resources(t *testing.T) {
 			test:    "cannot list security group rules",
 			dirName: "vpc_security_group_rule_empty",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllSecurityGroups").Once().Return(nil, nil, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return(nil, nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsSecurityGroupRuleResourceType),
 		},
@@ -232,7 +232,7 @@ func TestVPCSecurityGroupRuleSupplier_Resources(t *testing.T) {
 				&fakeEC2,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
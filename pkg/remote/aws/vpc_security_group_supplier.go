@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 
@@ -33,8 +34,8 @@ func NewVPCSecurityGroupSupplier(provider *AWSTerraformProvider, deserializer *r
 	}
 }
 
-func (s *VPCSecurityGroupSupplier) Resources() ([]resource.Resource, error) {
-	securityGroups, defaultSecurityGroups, err := s.repo.ListAllSecurityGroups()
+func (s *VPCSecurityGroupSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	securityGroups, defaultSecurityGroups, err := s.repo.ListAllSecurityGroups(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourceaws.AwsSecurityGroupResourceType)
 	}
@@ -42,10 +43,10 @@ func (s *VPCSecurityGroupSupplier) Resources() ([]resource.Resource, error) {
 	for _, item := range securityGroups {
 		res := *item
 		s.securityGroupRunner.Run(func() (cty.Value, error) {
-			return s.readSecurityGroup(res)
+			return s.readSecurityGroup(ctx, res)
 		})
 	}
-	securityGroupResources, err := s.securityGroupRunner.Wait()
+	securityGroupResources, err := s.securityGroupRunner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -53,10 +54,10 @@ func (s *VPCSecurityGroupSupplier) Resources() ([]resource.Resource, error) {
 	for _, item := range defaultSecurityGroups {
 		res := *item
 		s.defaultSecurityGroupRunner.Run(func() (cty.Value, error) {
-			return s.readSecurityGroup(res)
+			return s.readSecurityGroup(ctx, res)
 		})
 	}
-	defaultSecurityGroupResources, err := s.defaultSecurityGroupRunner.Wait()
+	defaultSecurityGroupResources, err := s.defaultSecurityGroupRunner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -78,14 +79,15 @@ func (s *VPCSecurityGroupSupplier) Resources() ([]resource.Resource, error) {
 	return resources, nil
 }
 
-func (s *VPCSecurityGroupSupplier) readSecurityGroup(securityGroup ec2.SecurityGroup) (cty.Value, error) {
+func (s *VPCSecurityGroupSupplier) readSecurityGroup(ctx context.Context, securityGroup ec2.SecurityGroup) (cty.Value, error) {
 	var Ty resource.ResourceType = resourceaws.AwsSecurityGroupResourceType
 	if isDefaultSecurityGroup(securityGroup) {
 		Ty = resourceaws.AwsDefaultSecurityGroupResourceType
 	}
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		ID: aws.StringValue(securityGroup.GroupId),
-		Ty: Ty,
+		Ctx: ctx,
+		ID:  aws.StringValue(securityGroup.GroupId),
+		Ty:  Ty,
 	})
 	if err != nil {
 		logrus.Error(err)
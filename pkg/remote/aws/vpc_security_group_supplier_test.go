@@ -37,7 +37,7 @@ func TestVPCSecurityGroupSupplier_Resources(t *testing.T) {
 			test:    "no security groups",
 			dirName: "vpc_security_group_empty",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{}, []*ec2.SecurityGroup{}, nil)
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{}, []*ec2.SecurityGroup{}, nil)
 			},
 			err: nil,
 		},
@@ -45,7 +45,7 @@ func TestVPCSecurityGroupSupplier_Resources(t *testing.T) {
 			test:    "with security groups",
 			dirName: "vpc_security_group_multiple",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{
 					{
 						GroupId:   aws.String("sg-0254c038e32f25530"),
 						GroupName: aws.String("foo"),
@@ -63,7 +63,7 @@ func TestVPCSecurityGroupSupplier_Resources(t *testing.T) {
 			test:    "cannot list security groups",
 			dirName: "vpc_security_group_empty",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllSecurityGroups").Return(nil, nil, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllSecurityGroups", mock.Anything).Return(nil, nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsSecurityGroupResourceType),
 		},
@@ -98,7 +98,7 @@ func TestVPCSecurityGroupSupplier_Resources(t *testing.T) {
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(t, tt.err, err)
 
 			mock.AssertExpectationsForObjects(t)
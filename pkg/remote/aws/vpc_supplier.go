@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws/repository"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
@@ -33,8 +34,8 @@ func NewVPCSupplier(provider *AWSTerraformProvider, deserializer *resource.Deser
 	}
 }
 
-func (s *VPCSupplier) Resources() ([]resource.Resource, error) {
-	VPCs, defaultVPCs, err := s.repo.ListAllVPCs()
+func (s *VPCSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	VPCs, defaultVPCs, err := s.repo.ListAllVPCs(ctx)
 
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, aws.AwsVpcResourceType)
@@ -43,11 +44,11 @@ func (s *VPCSupplier) Resources() ([]resource.Resource, error) {
 	for _, item := range VPCs {
 		res := *item
 		s.vpcRunner.Run(func() (cty.Value, error) {
-			return s.readVPC(res)
+			return s.readVPC(ctx, res)
 		})
 	}
 
-	VPCResources, err := s.vpcRunner.Wait()
+	VPCResources, err := s.vpcRunner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -55,12 +56,12 @@ func (s *VPCSupplier) Resources() ([]resource.Resource, error) {
 	for _, item := range defaultVPCs {
 		res := *item
 		s.defaultVPCRunner.Run(func() (cty.Value, error) {
-			return s.readVPC(res)
+			return s.readVPC(ctx, res)
 		})
 	}
 
 	// Retrieve results from terraform provider
-	defaultVPCResources, err := s.defaultVPCRunner.Wait()
+	defaultVPCResources, err := s.defaultVPCRunner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -82,14 +83,15 @@ func (s *VPCSupplier) Resources() ([]resource.Resource, error) {
 	return resources, nil
 }
 
-func (s *VPCSupplier) readVPC(vpc ec2.Vpc) (cty.Value, error) {
+func (s *VPCSupplier) readVPC(ctx context.Context, vpc ec2.Vpc) (cty.Value, error) {
 	var Ty resource.ResourceType = aws.AwsVpcResourceType
 	if vpc.IsDefault != nil && *vpc.IsDefault {
 		Ty = aws.AwsDefaultVpcResourceType
 	}
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		ID: *vpc.VpcId,
-		Ty: Ty,
+		Ctx: ctx,
+		ID:  *vpc.VpcId,
+		Ty:  Ty,
 	})
 	if err != nil {
 		logrus.Error(err)
@@ -39,7 +39,7 @@ func TestVPCSupplier_Resources(t *testing.T) {
 			test:    "no VPC",
 			dirName: "vpc_empty",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllVPCs").Once().Return([]*ec2.Vpc{}, []*ec2.Vpc{}, nil)
+				client.On("ListAllVPCs", mock.Anything).Once().Return([]*ec2.Vpc{}, []*ec2.Vpc{}, nil)
 			},
 			err: nil,
 		},
@@ -47,7 +47,7 @@ func TestVPCSupplier_Resources(t *testing.T) {
 			test:    "mixed default VPC and VPC",
 			dirName: "vpc",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllVPCs").Once().Return([]*ec2.Vpc{
+				client.On("ListAllVPCs", mock.Anything).Once().Return([]*ec2.Vpc{
 					{
 						VpcId: aws.String("vpc-0768e1fd0029e3fc3"),
 					},
@@ -72,7 +72,7 @@ func TestVPCSupplier_Resources(t *testing.T) {
 			test:    "cannot list VPC",
 			dirName: "vpc_empty",
 			mocks: func(client *repository.MockEC2Repository) {
-				client.On("ListAllVPCs").Once().Return(nil, nil, awserr.NewRequestFailure(nil, 403, ""))
+				client.On("ListAllVPCs", mock.Anything).Once().Return(nil, nil, awserr.NewRequestFailure(nil, 403, ""))
 			},
 			err: remoteerror.NewResourceEnumerationError(awserr.NewRequestFailure(nil, 403, ""), resourceaws.AwsVpcResourceType),
 		},
@@ -107,7 +107,7 @@ func TestVPCSupplier_Resources(t *testing.T) {
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
@@ -0,0 +1,191 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// DiskOptions tells whether cached values are saved on disk to be reused by next runs
+type DiskOptions struct {
+	// How long values saved on disk are reused, nothing is saved when zero
+	TTL time.Duration
+	// Ignore values saved by previous runs, values read during this run are saved anyway
+	Refresh bool
+}
+
+func (o DiskOptions) Enabled() bool {
+	return o.TTL > 0
+}
+
+var (
+	typesLock sync.RWMutex
+	types     = map[string]reflect.Type{}
+)
+
+// RegisterType allows values of the same type as the given ones to be saved on disk.
+// Values are decoded back to their registered type when read by another run.
+func RegisterType(values ...interface{}) {
+	typesLock.Lock()
+	defer typesLock.Unlock()
+	for _, value := range values {
+		t := reflect.TypeOf(value)
+		types[t.String()] = t
+	}
+}
+
+func registeredType(name string) (reflect.Type, bool) {
+	typesLock.RLock()
+	defer typesLock.RUnlock()
+	t, exist := types[name]
+	return t, exist
+}
+
+// Dir returns the directory of a disk cache, elem usually being a provider, its version, an account and a region
+func Dir(configDir string, elem ...string) string {
+	return filepath.Join(append([]string{configDir, ".driftctl", "cache"}, elem...)...)
+}
+
+type diskEntry struct {
+	Type    string          `json:"type"`
+	SavedAt time.Time       `json:"saved_at"`
+	Value   json.RawMessage `json:"value"`
+}
+
+// DiskCache keeps values in memory and saves them as JSON files in a directory
+type DiskCache struct {
+	memory Cache
+	dir    string
+	opts   DiskOptions
+}
+
+// NewDiskCache returns a cache saving values put in memory to dir as well.
+// The memory cache is returned as is when saving values on disk is disabled.
+func NewDiskCache(memory Cache, opts DiskOptions, dir string) Cache {
+	if !opts.Enabled() {
+		return memory
+	}
+	return &DiskCache{
+		memory: memory,
+		dir:    dir,
+		opts:   opts,
+	}
+}
+
+func (c *DiskCache) Get(key string) interface{} {
+	if v := c.memory.Get(key); v != nil {
+		return v
+	}
+	if c.opts.Refresh {
+		return nil
+	}
+
+	path := c.path(key)
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	entry := diskEntry{}
+	if err := json.Unmarshal(content, &entry); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"key":  key,
+			"path": path,
+		}).Debugf("Ignoring invalid cache entry: %s", err)
+		return nil
+	}
+	if time.Since(entry.SavedAt) > c.opts.TTL {
+		_ = os.Remove(path)
+		return nil
+	}
+	t, exist := registeredType(entry.Type)
+	if !exist {
+		return nil
+	}
+	value := reflect.New(t)
+	if err := json.Unmarshal(entry.Value, value.Interface()); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"key":  key,
+			"path": path,
+		}).Debugf("Ignoring invalid cache entry: %s", err)
+		return nil
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"key":      key,
+		"saved_at": entry.SavedAt,
+	}).Debug("Read value from disk cache")
+	c.memory.Put(key, value.Elem().Interface())
+	return value.Elem().Interface()
+}
+
+func (c *DiskCache) Put(key string, value interface{}) bool {
+	exist := c.memory.Put(key, value)
+
+	if err := c.save(key, value); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"key": key,
+			"dir": c.dir,
+		}).Debugf("Unable to save value to disk cache: %s", err)
+	}
+	return exist
+}
+
+func (c *DiskCache) Len() int {
+	return c.memory.Len()
+}
+
+func (c *DiskCache) save(key string, value interface{}) error {
+	name := reflect.TypeOf(value).String()
+	if _, exist := registeredType(name); !exist {
+		logrus.WithFields(logrus.Fields{
+			"key":  key,
+			"type": name,
+		}).Debug("Type not registered, value is only cached in memory")
+		return nil
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	content, err := json.Marshal(diskEntry{
+		Type:    name,
+		SavedAt: time.Now(),
+		Value:   raw,
+	})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return err
+	}
+
+	// Values are written to a temporary file first so that concurrent runs never read a partial entry
+	tmp, err := ioutil.TempFile(c.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), c.path(key))
+}
+
+func (c *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
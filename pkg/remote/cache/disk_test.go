@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/cloudskiff/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiskCache(t *testing.T) {
+	RegisterType([]*resource.FakeResource{})
+	opts := DiskOptions{TTL: time.Hour}
+
+	t.Run("should return memory cache when disabled", func(t *testing.T) {
+		memory := New(5)
+		assert.Equal(t, memory, NewDiskCache(memory, DiskOptions{}, t.TempDir()))
+	})
+
+	t.Run("should read values saved by another run", func(t *testing.T) {
+		dir := t.TempDir()
+		value := []*resource.FakeResource{{Id: "test", Type: "fake"}}
+
+		assert.Equal(t, false, NewDiskCache(New(5), opts, dir).Put("fakes", value))
+
+		cache := NewDiskCache(New(5), opts, dir)
+		assert.Equal(t, value, cache.Get("fakes"))
+		assert.Equal(t, 1, cache.Len())
+	})
+
+	t.Run("should read empty values", func(t *testing.T) {
+		dir := t.TempDir()
+		var value []*resource.FakeResource
+
+		NewDiskCache(New(5), opts, dir).Put("fakes", value)
+
+		got := NewDiskCache(New(5), opts, dir).Get("fakes")
+		// Repositories only check for a nil interface, a nil slice means nothing was found
+		assert.True(t, got != nil)
+		assert.Equal(t, value, got)
+	})
+
+	t.Run("should ignore expired values", func(t *testing.T) {
+		dir := t.TempDir()
+		NewDiskCache(New(5), DiskOptions{TTL: time.Nanosecond}, dir).Put("fakes", []*resource.FakeResource{})
+
+		time.Sleep(time.Millisecond)
+		assert.Nil(t, NewDiskCache(New(5), DiskOptions{TTL: time.Nanosecond}, dir).Get("fakes"))
+		files, _ := ioutil.ReadDir(dir)
+		assert.Len(t, files, 0)
+	})
+
+	t.Run("should ignore saved values on refresh", func(t *testing.T) {
+		dir := t.TempDir()
+		NewDiskCache(New(5), opts, dir).Put("fakes", []*resource.FakeResource{{Id: "old"}})
+
+		cache := NewDiskCache(New(5), DiskOptions{TTL: time.Hour, Refresh: true}, dir)
+		assert.Nil(t, cache.Get("fakes"))
+
+		value := []*resource.FakeResource{{Id: "new"}}
+		cache.Put("fakes", value)
+		assert.Equal(t, value, cache.Get("fakes"))
+		assert.Equal(t, value, NewDiskCache(New(5), opts, dir).Get("fakes"))
+	})
+
+	t.Run("should only keep values of unregistered types in memory", func(t *testing.T) {
+		dir := t.TempDir()
+		cache := NewDiskCache(New(5), opts, dir)
+		cache.Put("test", map[string]int{"test": 1})
+
+		assert.Equal(t, map[string]int{"test": 1}, cache.Get("test"))
+		assert.Nil(t, NewDiskCache(New(5), opts, dir).Get("test"))
+	})
+
+	t.Run("should save raw JSON values", func(t *testing.T) {
+		RegisterType(json.RawMessage{})
+		dir := t.TempDir()
+		NewDiskCache(New(0), opts, dir).Put("test", json.RawMessage(`{"test":1}`))
+
+		assert.Equal(t, json.RawMessage(`{"test":1}`), NewDiskCache(New(0), opts, dir).Get("test"))
+	})
+}
@@ -0,0 +1,11 @@
+package github
+
+import "github.com/cloudskiff/driftctl/pkg/remote/cache"
+
+// Types of values cached by the repository, they can be saved on disk and read by next runs
+func init() {
+	cache.RegisterType(
+		[]string{},
+		[]Team{},
+	)
+}
@@ -1,6 +1,7 @@
 package github
 
 import (
+	"context"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 	"github.com/cloudskiff/driftctl/pkg/resource"
 	resourcegithub "github.com/cloudskiff/driftctl/pkg/resource/github"
@@ -25,9 +26,9 @@ func NewGithubBranchProtectionSupplier(provider *GithubTerraformProvider, reposi
 	}
 }
 
-func (s GithubBranchProtectionSupplier) Resources() ([]resource.Resource, error) {
+func (s GithubBranchProtectionSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
 
-	resourceList, err := s.repository.ListBranchProtection()
+	resourceList, err := s.repository.ListBranchProtection(ctx)
 
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourcegithub.GithubBranchProtectionResourceType)
@@ -37,8 +38,9 @@ func (s GithubBranchProtectionSupplier) Resources() ([]resource.Resource, error)
 		id := id
 		s.runner.Run(func() (cty.Value, error) {
 			completeResource, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-				Ty: resourcegithub.GithubBranchProtectionResourceType,
-				ID: id,
+				Ctx: ctx,
+				Ty:  resourcegithub.GithubBranchProtectionResourceType,
+				ID:  id,
 			})
 			if err != nil {
 				logrus.Warnf("Error reading %s[%s]: %+v", id, resourcegithub.GithubBranchProtectionResourceType, err)
@@ -48,7 +50,7 @@ func (s GithubBranchProtectionSupplier) Resources() ([]resource.Resource, error)
 		})
 	}
 
-	results, err := s.runner.Wait()
+	results, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -27,7 +27,7 @@ func TestGithubBranchProtectionSupplier_Resources(t *testing.T) {
 			test:    "no branch protection",
 			dirName: "github_branch_protection_empty",
 			mocks: func(client *MockGithubRepository) {
-				client.On("ListBranchProtection").Return([]string{}, nil)
+				client.On("ListBranchProtection", mock.Anything).Return([]string{}, nil)
 			},
 			err: nil,
 		},
@@ -35,7 +35,7 @@ func TestGithubBranchProtectionSupplier_Resources(t *testing.T) {
 			test:    "Multiple branch protections",
 			dirName: "github_branch_protection_multiples",
 			mocks: func(client *MockGithubRepository) {
-				client.On("ListBranchProtection").Return([]string{
+				client.On("ListBranchProtection", mock.Anything).Return([]string{
 					"MDIwOkJyYW5jaFByb3RlY3Rpb25SdWxlMTk1NDg0NzI=", //"repo0:main"
 					"MDIwOkJyYW5jaFByb3RlY3Rpb25SdWxlMTk1NDg0Nzg=", //"repo0:toto"
 					"MDIwOkJyYW5jaFByb3RlY3Rpb25SdWxlMTk1NDg0NzQ=", //"repo1:main"
@@ -79,7 +79,7 @@ func TestGithubBranchProtectionSupplier_Resources(t *testing.T) {
 				&mockedRepo,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
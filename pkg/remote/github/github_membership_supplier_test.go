@@ -27,7 +27,7 @@ func TestGithubMembershipupplier_Resources(t *testing.T) {
 			test:    "no members",
 			dirName: "github_membership_empty",
 			mocks: func(client *MockGithubRepository) {
-				client.On("ListMembership").Return([]string{}, nil)
+				client.On("ListMembership", mock.Anything).Return([]string{}, nil)
 			},
 			err: nil,
 		},
@@ -35,7 +35,7 @@ func TestGithubMembershipupplier_Resources(t *testing.T) {
 			test:    "Multiple membership with admin and member roles",
 			dirName: "github_membership_multiple",
 			mocks: func(client *MockGithubRepository) {
-				client.On("ListMembership").Return([]string{
+				client.On("ListMembership", mock.Anything).Return([]string{
 					"driftctl-test:driftctl-acceptance-tester",
 					"driftctl-test:eliecharra",
 				}, nil)
@@ -75,7 +75,7 @@ func TestGithubMembershipupplier_Resources(t *testing.T) {
 				&mockedRepo,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
@@ -1,6 +1,7 @@
 package github
 
 import (
+	"context"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 	"github.com/cloudskiff/driftctl/pkg/resource"
 	resourcegithub "github.com/cloudskiff/driftctl/pkg/resource/github"
@@ -25,9 +26,9 @@ func NewGithubRepositorySupplier(provider *GithubTerraformProvider, repository G
 	}
 }
 
-func (s GithubRepositorySupplier) Resources() ([]resource.Resource, error) {
+func (s GithubRepositorySupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
 
-	resourceList, err := s.repository.ListRepositories()
+	resourceList, err := s.repository.ListRepositories(ctx)
 
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourcegithub.GithubRepositoryResourceType)
@@ -37,8 +38,9 @@ func (s GithubRepositorySupplier) Resources() ([]resource.Resource, error) {
 		id := id
 		s.runner.Run(func() (cty.Value, error) {
 			completeResource, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-				Ty: resourcegithub.GithubRepositoryResourceType,
-				ID: id,
+				Ctx: ctx,
+				Ty:  resourcegithub.GithubRepositoryResourceType,
+				ID:  id,
 			})
 			if err != nil {
 				logrus.Warnf("Error reading %s[%s]: %+v", id, resourcegithub.GithubRepositoryResourceType, err)
@@ -48,7 +50,7 @@ func (s GithubRepositorySupplier) Resources() ([]resource.Resource, error) {
 		})
 	}
 
-	results, err := s.runner.Wait()
+	results, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
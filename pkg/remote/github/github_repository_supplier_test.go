@@ -27,7 +27,7 @@ func TestGithubRepositorySupplier_Resources(t *testing.T) {
 			test:    "no github repos",
 			dirName: "github_repository_empty",
 			mocks: func(client *MockGithubRepository) {
-				client.On("ListRepositories").Return([]string{}, nil)
+				client.On("ListRepositories", mock.Anything).Return([]string{}, nil)
 			},
 			err: nil,
 		},
@@ -35,7 +35,7 @@ func TestGithubRepositorySupplier_Resources(t *testing.T) {
 			test:    "Multiple github repos Table",
 			dirName: "github_repository_multiple",
 			mocks: func(client *MockGithubRepository) {
-				client.On("ListRepositories").Return([]string{
+				client.On("ListRepositories", mock.Anything).Return([]string{
 					"driftctl",
 					"driftctl-demos",
 				}, nil)
@@ -75,7 +75,7 @@ func TestGithubRepositorySupplier_Resources(t *testing.T) {
 				&mockedRepo,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
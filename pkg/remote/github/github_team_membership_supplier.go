@@ -1,6 +1,7 @@
 package github
 
 import (
+	"context"
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
 	"github.com/cloudskiff/driftctl/pkg/resource"
 	resourcegithub "github.com/cloudskiff/driftctl/pkg/resource/github"
@@ -25,8 +26,8 @@ func NewGithubTeamMembershipSupplier(provider *GithubTerraformProvider, reposito
 	}
 }
 
-func (s GithubTeamMembershipSupplier) Resources() ([]resource.Resource, error) {
-	resourceList, err := s.repository.ListTeamMemberships()
+func (s GithubTeamMembershipSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	resourceList, err := s.repository.ListTeamMemberships(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourcegithub.GithubTeamMembershipResourceType)
 	}
@@ -34,11 +35,11 @@ func (s GithubTeamMembershipSupplier) Resources() ([]resource.Resource, error) {
 	for _, id := range resourceList {
 		id := id
 		s.runner.Run(func() (cty.Value, error) {
-			return s.readTeamMembership(id)
+			return s.readTeamMembership(ctx, id)
 		})
 	}
 
-	results, err := s.runner.Wait()
+	results, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -46,10 +47,11 @@ func (s GithubTeamMembershipSupplier) Resources() ([]resource.Resource, error) {
 	return s.deserializer.Deserialize(resourcegithub.GithubTeamMembershipResourceType, results)
 }
 
-func (s GithubTeamMembershipSupplier) readTeamMembership(id string) (cty.Value, error) {
+func (s GithubTeamMembershipSupplier) readTeamMembership(ctx context.Context, id string) (cty.Value, error) {
 	val, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-		ID: id,
-		Ty: resourcegithub.GithubTeamMembershipResourceType,
+		Ctx: ctx,
+		ID:  id,
+		Ty:  resourcegithub.GithubTeamMembershipResourceType,
 	})
 	if err != nil {
 		logrus.Warnf("Error reading %s[%s]: %+v", id, resourcegithub.GithubTeamMembershipResourceType, err)
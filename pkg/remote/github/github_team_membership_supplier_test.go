@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/cloudskiff/driftctl/pkg/parallel"
@@ -14,6 +15,7 @@ import (
 	testresource "github.com/cloudskiff/driftctl/test/resource"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/zclconf/go-cty/cty"
 )
 
 func TestGithubTeamMembershipSupplier_Resources(t *testing.T) {
@@ -27,7 +29,7 @@ func TestGithubTeamMembershipSupplier_Resources(t *testing.T) {
 			test:    "no github team memberships",
 			dirName: "github_team_membership_empty",
 			mocks: func(client *MockGithubRepository) {
-				client.On("ListTeamMemberships").Return([]string{}, nil)
+				client.On("ListTeamMemberships", mock.Anything).Return([]string{}, nil)
 			},
 			err: nil,
 		},
@@ -35,7 +37,7 @@ func TestGithubTeamMembershipSupplier_Resources(t *testing.T) {
 			test:    "multiple github team memberships",
 			dirName: "github_team_membership_multiple",
 			mocks: func(client *MockGithubRepository) {
-				client.On("ListTeamMemberships").Return([]string{
+				client.On("ListTeamMemberships", mock.Anything).Return([]string{
 					"4570529:driftctl-acceptance-tester",
 					"4570529:wbeuil",
 				}, nil)
@@ -75,7 +77,7 @@ func TestGithubTeamMembershipSupplier_Resources(t *testing.T) {
 				&mockedRepo,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
@@ -83,3 +85,32 @@ func TestGithubTeamMembershipSupplier_Resources(t *testing.T) {
 		})
 	}
 }
+
+type contextRecordingReader struct {
+	ctx context.Context
+}
+
+func (r *contextRecordingReader) ReadResource(args terraform.ReadResourceArgs) (*cty.Value, error) {
+	r.ctx = args.Ctx
+	return nil, errors.New("read failed")
+}
+
+func TestGithubTeamMembershipSupplier_ReadsWithScanContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockedRepo := MockGithubRepository{}
+	mockedRepo.On("ListTeamMemberships", mock.Anything).Return([]string{"4570529:wbeuil"}, nil)
+	reader := &contextRecordingReader{}
+	s := &GithubTeamMembershipSupplier{
+		reader,
+		nil,
+		&mockedRepo,
+		terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
+	}
+
+	_, err := s.Resources(ctx)
+	assert.EqualError(t, err, "read failed")
+	// Cancelling the scan interrupts reads and their retries
+	assert.Equal(t, ctx, reader.ctx)
+}
@@ -1,6 +1,7 @@
 package github
 
 import (
+	"context"
 	"fmt"
 
 	remoteerror "github.com/cloudskiff/driftctl/pkg/remote/error"
@@ -27,9 +28,9 @@ func NewGithubTeamSupplier(provider *GithubTerraformProvider, repository GithubR
 	}
 }
 
-func (s GithubTeamSupplier) Resources() ([]resource.Resource, error) {
+func (s GithubTeamSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
 
-	resourceList, err := s.repository.ListTeams()
+	resourceList, err := s.repository.ListTeams(ctx)
 
 	if err != nil {
 		return nil, remoteerror.NewResourceEnumerationError(err, resourcegithub.GithubTeamResourceType)
@@ -39,8 +40,9 @@ func (s GithubTeamSupplier) Resources() ([]resource.Resource, error) {
 		team := team
 		s.runner.Run(func() (cty.Value, error) {
 			completeResource, err := s.reader.ReadResource(terraform.ReadResourceArgs{
-				Ty: resourcegithub.GithubTeamResourceType,
-				ID: fmt.Sprintf("%d", team.DatabaseId),
+				Ctx: ctx,
+				Ty:  resourcegithub.GithubTeamResourceType,
+				ID:  fmt.Sprintf("%d", team.DatabaseId),
 			})
 			if err != nil {
 				logrus.Warnf("Error reading %d[%s]: %+v", team.DatabaseId, resourcegithub.GithubTeamResourceType, err)
@@ -50,7 +52,7 @@ func (s GithubTeamSupplier) Resources() ([]resource.Resource, error) {
 		})
 	}
 
-	results, err := s.runner.Wait()
+	results, err := s.runner.Wait(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -27,7 +27,7 @@ func TestGithubTeamSupplier_Resources(t *testing.T) {
 			test:    "no github teams",
 			dirName: "github_teams_empty",
 			mocks: func(client *MockGithubRepository) {
-				client.On("ListTeams").Return([]Team{}, nil)
+				client.On("ListTeams", mock.Anything).Return([]Team{}, nil)
 			},
 			err: nil,
 		},
@@ -35,7 +35,7 @@ func TestGithubTeamSupplier_Resources(t *testing.T) {
 			test:    "Multiple github teams with parent",
 			dirName: "github_teams_multiple",
 			mocks: func(client *MockGithubRepository) {
-				client.On("ListTeams").Return([]Team{
+				client.On("ListTeams", mock.Anything).Return([]Team{
 					{DatabaseId: 4556811}, // github_team.team1
 					{DatabaseId: 4556812}, // github_team.team2
 					{DatabaseId: 4556814}, // github_team.with_parent
@@ -76,7 +76,7 @@ func TestGithubTeamSupplier_Resources(t *testing.T) {
 				&mockedRepo,
 				terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10)),
 			}
-			got, err := s.Resources()
+			got, err := s.Resources(context.Background())
 			assert.Equal(tt, c.err, err)
 
 			mock.AssertExpectationsForObjects(tt)
@@ -1,58 +1,85 @@
 package github
 
 import (
+	"context"
+	"path/filepath"
+
 	"github.com/cloudskiff/driftctl/pkg/alerter"
 	"github.com/cloudskiff/driftctl/pkg/output"
 	"github.com/cloudskiff/driftctl/pkg/remote/cache"
+	"github.com/cloudskiff/driftctl/pkg/remote/retry"
 	"github.com/cloudskiff/driftctl/pkg/resource"
 	"github.com/cloudskiff/driftctl/pkg/resource/github"
+	"github.com/cloudskiff/driftctl/pkg/stats"
 	"github.com/cloudskiff/driftctl/pkg/terraform"
 )
 
 const RemoteGithubTerraform = "github+tf"
 
-/**
- * Initialize remote (configure credentials, launch tf providers and start gRPC clients)
- * Required to use Scanner
- */
+// Remote holds the Github provider, it is started once and reused by every scan
+type Remote struct {
+	provider *GithubTerraformProvider
+	opts     Options
+	recorder *stats.Recorder
+	cacheDir string
+}
 
-func Init(version string, alerter *alerter.Alerter,
+// NewRemote starts the Github provider, it is added to providerLibrary to be cleaned up
+func NewRemote(ctx context.Context, version string,
 	providerLibrary *terraform.ProviderLibrary,
-	supplierLibrary *resource.SupplierLibrary,
 	progress output.Progress,
 	resourceSchemaRepository *resource.SchemaRepository,
-	factory resource.ResourceFactory,
-	configDir string) error {
+	configDir string,
+	opts Options,
+	recorder *stats.Recorder) (*Remote, error) {
 	if version == "" {
 		version = "4.4.0"
 	}
 
-	provider, err := NewGithubTerraformProvider(version, progress, configDir)
+	provider, err := NewGithubTerraformProvider(ctx, version, progress, configDir, opts, recorder)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	err = provider.Init()
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	repositoryCache := cache.New(100)
-
-	repository := NewGithubRepository(provider.GetConfig(), repositoryCache)
-	deserializer := resource.NewDeserializer(factory)
 	providerLibrary.AddProvider(terraform.GITHUB, provider)
 
-	supplierLibrary.AddSupplier(NewGithubRepositorySupplier(provider, repository, deserializer))
-	supplierLibrary.AddSupplier(NewGithubTeamSupplier(provider, repository, deserializer))
-	supplierLibrary.AddSupplier(NewGithubMembershipSupplier(provider, repository, deserializer))
-	supplierLibrary.AddSupplier(NewGithubTeamMembershipSupplier(provider, repository, deserializer))
-	supplierLibrary.AddSupplier(NewGithubBranchProtectionSupplier(provider, repository, deserializer))
-
-	err = resourceSchemaRepository.Init(version, provider.Schema())
+	err = resourceSchemaRepository.Init(terraform.GITHUB, version, provider.Schema())
 	if err != nil {
-		return err
+		return nil, err
 	}
 	github.InitResourcesMetadata(resourceSchemaRepository)
 
+	return &Remote{
+		provider: provider,
+		opts:     opts,
+		recorder: recorder,
+		cacheDir: cache.Dir(configDir, terraform.GITHUB, version, provider.GetConfig().getDefaultOwner()),
+	}, nil
+}
+
+// AddSuppliers adds Github suppliers to the library. Suppliers and their repository
+// can only be used for a single scan, so they are added again before each scan.
+func (r *Remote) AddSuppliers(alerter *alerter.Alerter, supplierLibrary *resource.SupplierLibrary, factory resource.ResourceFactory) error {
+	provider := r.provider
+	if r.opts.Cache.Enabled() {
+		// Every resource is read once per run, they are only worth keeping on disk
+		provider = &GithubTerraformProvider{
+			TerraformProvider: provider.WithCache(r.recorder.Cache("terraform", cache.NewDiskCache(cache.New(0), r.opts.Cache, filepath.Join(r.cacheDir, "resources")))),
+		}
+	}
+	repositoryCache := cache.NewDiskCache(cache.New(100), r.opts.Cache, r.cacheDir)
+
+	repository := NewGithubRepository(provider.GetConfig(), r.recorder.Cache("github", repositoryCache), retry.NewRateLimiter(r.opts.Retry.RateLimit), r.recorder)
+	deserializer := resource.NewDeserializer(factory)
+
+	supplierLibrary.AddSupplier(NewGithubRepositorySupplier(provider, repository, deserializer), github.GithubRepositoryResourceType)
+	supplierLibrary.AddSupplier(NewGithubTeamSupplier(provider, repository, deserializer), github.GithubTeamResourceType)
+	supplierLibrary.AddSupplier(NewGithubMembershipSupplier(provider, repository, deserializer), github.GithubMembershipResourceType)
+	supplierLibrary.AddSupplier(NewGithubTeamMembershipSupplier(provider, repository, deserializer), github.GithubTeamMembershipResourceType)
+	supplierLibrary.AddSupplier(NewGithubBranchProtectionSupplier(provider, repository, deserializer), github.GithubBranchProtectionResourceType)
+
 	return nil
 }
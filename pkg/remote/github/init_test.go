@@ -1,12 +1,14 @@
 package github
 
 import (
+	"context"
+
 	"github.com/cloudskiff/driftctl/pkg/output"
 	"github.com/cloudskiff/driftctl/pkg/terraform"
 )
 
 func InitTestGithubProvider(providerLibrary *terraform.ProviderLibrary) (*GithubTerraformProvider, error) {
-	provider, err := NewGithubTerraformProvider("", &output.MockProgress{}, "")
+	provider, err := NewGithubTerraformProvider(context.Background(), "", &output.MockProgress{}, "", Options{}, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2,20 +2,24 @@
 
 package github
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockGithubRepository is an autogenerated mock type for the GithubRepository type
 type MockGithubRepository struct {
 	mock.Mock
 }
 
-// ListMembership provides a mock function with given fields:
-func (_m *MockGithubRepository) ListMembership() ([]string, error) {
-	ret := _m.Called()
+// ListMembership provides a mock function with given fields: _a0
+func (_m *MockGithubRepository) ListMembership(_a0 context.Context) ([]string, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func() []string); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -23,8 +27,8 @@ func (_m *MockGithubRepository) ListMembership() ([]string, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -32,13 +36,13 @@ func (_m *MockGithubRepository) ListMembership() ([]string, error) {
 	return r0, r1
 }
 
-// ListBranchProtection provides a mock function with given fields:
-func (_m *MockGithubRepository) ListBranchProtection() ([]string, error) {
-	ret := _m.Called()
+// ListBranchProtection provides a mock function with given fields: _a0
+func (_m *MockGithubRepository) ListBranchProtection(_a0 context.Context) ([]string, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func() []string); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -46,8 +50,8 @@ func (_m *MockGithubRepository) ListBranchProtection() ([]string, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -55,13 +59,13 @@ func (_m *MockGithubRepository) ListBranchProtection() ([]string, error) {
 	return r0, r1
 }
 
-// ListRepositories provides a mock function with given fields:
-func (_m *MockGithubRepository) ListRepositories() ([]string, error) {
-	ret := _m.Called()
+// ListRepositories provides a mock function with given fields: _a0
+func (_m *MockGithubRepository) ListRepositories(_a0 context.Context) ([]string, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func() []string); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -69,8 +73,8 @@ func (_m *MockGithubRepository) ListRepositories() ([]string, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -78,13 +82,13 @@ func (_m *MockGithubRepository) ListRepositories() ([]string, error) {
 	return r0, r1
 }
 
-// ListTeamMemberships provides a mock function with given fields:
-func (_m *MockGithubRepository) ListTeamMemberships() ([]string, error) {
-	ret := _m.Called()
+// ListTeamMemberships provides a mock function with given fields: _a0
+func (_m *MockGithubRepository) ListTeamMemberships(_a0 context.Context) ([]string, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func() []string); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -92,8 +96,8 @@ func (_m *MockGithubRepository) ListTeamMemberships() ([]string, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -101,13 +105,13 @@ func (_m *MockGithubRepository) ListTeamMemberships() ([]string, error) {
 	return r0, r1
 }
 
-// ListTeams provides a mock function with given fields:
-func (_m *MockGithubRepository) ListTeams() ([]Team, error) {
-	ret := _m.Called()
+// ListTeams provides a mock function with given fields: _a0
+func (_m *MockGithubRepository) ListTeams(_a0 context.Context) ([]Team, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []Team
-	if rf, ok := ret.Get(0).(func() []Team); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []Team); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]Team)
@@ -115,8 +119,8 @@ func (_m *MockGithubRepository) ListTeams() ([]Team, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1,14 +1,28 @@
 package github
 
 import (
+	"context"
+
 	"os"
 
 	"github.com/cloudskiff/driftctl/pkg/output"
 
+	"github.com/cloudskiff/driftctl/pkg/remote/cache"
+	"github.com/cloudskiff/driftctl/pkg/remote/retry"
 	"github.com/cloudskiff/driftctl/pkg/remote/terraform"
+	"github.com/cloudskiff/driftctl/pkg/stats"
 	tf "github.com/cloudskiff/driftctl/pkg/terraform"
 )
 
+// Options changes how resources are read from the Github API
+type Options struct {
+	// Maximum number of resources read at the same time
+	Concurrency int64
+	Retry       retry.Options
+	// Saves resources listed and read on disk, per owner
+	Cache cache.DiskOptions
+}
+
 type GithubTerraformProvider struct {
 	*terraform.TerraformProvider
 }
@@ -19,7 +33,7 @@ type githubConfig struct {
 	Organization string
 }
 
-func NewGithubTerraformProvider(version string, progress output.Progress, configDir string) (*GithubTerraformProvider, error) {
+func NewGithubTerraformProvider(ctx context.Context, version string, progress output.Progress, configDir string, opts Options, recorder *stats.Recorder) (*GithubTerraformProvider, error) {
 	p := &GithubTerraformProvider{}
 	providerKey := "github"
 	installer, err := tf.NewProviderInstaller(tf.ProviderConfig{
@@ -30,7 +44,7 @@ func NewGithubTerraformProvider(version string, progress output.Progress, config
 	if err != nil {
 		return nil, err
 	}
-	tfProvider, err := terraform.NewTerraformProvider(installer, terraform.TerraformProviderConfig{
+	tfProvider, err := terraform.NewTerraformProvider(ctx, installer, terraform.TerraformProviderConfig{
 		Name:         providerKey,
 		DefaultAlias: p.GetConfig().getDefaultOwner(),
 		GetProviderConfig: func(owner string) interface{} {
@@ -38,6 +52,9 @@ func NewGithubTerraformProvider(version string, progress output.Progress, config
 				Owner: p.GetConfig().getDefaultOwner(),
 			}
 		},
+		Concurrency: opts.Concurrency,
+		Retry:       opts.Retry,
+		Stats:       recorder,
 	}, progress)
 	if err != nil {
 		return nil, err
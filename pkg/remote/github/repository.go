@@ -5,16 +5,18 @@ import (
 	"fmt"
 
 	"github.com/cloudskiff/driftctl/pkg/remote/cache"
+	"github.com/cloudskiff/driftctl/pkg/remote/retry"
+	"github.com/cloudskiff/driftctl/pkg/stats"
 	"github.com/shurcooL/githubv4"
 	"golang.org/x/oauth2"
 )
 
 type GithubRepository interface {
-	ListRepositories() ([]string, error)
-	ListTeams() ([]Team, error)
-	ListMembership() ([]string, error)
-	ListTeamMemberships() ([]string, error)
-	ListBranchProtection() ([]string, error)
+	ListRepositories(ctx context.Context) ([]string, error)
+	ListTeams(ctx context.Context) ([]Team, error)
+	ListMembership(ctx context.Context) ([]string, error)
+	ListTeamMemberships(ctx context.Context) ([]string, error)
+	ListBranchProtection(ctx context.Context) ([]string, error)
 }
 
 type GithubGraphQLClient interface {
@@ -23,21 +25,21 @@ type GithubGraphQLClient interface {
 
 type githubRepository struct {
 	client GithubGraphQLClient
-	ctx    context.Context
 	config githubConfig
 	cache  cache.Cache
 }
 
-func NewGithubRepository(config githubConfig, c cache.Cache) *githubRepository {
+func NewGithubRepository(config githubConfig, c cache.Cache, limiter *retry.RateLimiter, recorder *stats.Recorder) *githubRepository {
 	ctx := context.Background()
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: config.Token},
 	)
 	oauthClient := oauth2.NewClient(ctx, ts)
+	oauthClient.Transport = retry.NewRateLimitedTransport(oauthClient.Transport, limiter, "github")
+	oauthClient.Transport = recorder.Transport(oauthClient.Transport, "github")
 
 	repo := &githubRepository{
 		client: githubv4.NewClient(oauthClient),
-		ctx:    context.Background(),
 		config: config,
 		cache:  c,
 	}
@@ -45,13 +47,13 @@ func NewGithubRepository(config githubConfig, c cache.Cache) *githubRepository {
 	return repo
 }
 
-func (r *githubRepository) ListRepositories() ([]string, error) {
+func (r *githubRepository) ListRepositories(ctx context.Context) ([]string, error) {
 	if v := r.cache.Get("githubListRepositories"); v != nil {
 		return v.([]string), nil
 	}
 
 	if r.config.Organization != "" {
-		results, err := r.listRepoForOrg()
+		results, err := r.listRepoForOrg(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -59,7 +61,7 @@ func (r *githubRepository) ListRepositories() ([]string, error) {
 		return results, nil
 	}
 
-	results, err := r.listRepoForOwner()
+	results, err := r.listRepoForOwner(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +85,7 @@ type listRepoForOrgQuery struct {
 	} `graphql:"organization(login: $org)"`
 }
 
-func (r *githubRepository) listRepoForOrg() ([]string, error) {
+func (r *githubRepository) listRepoForOrg(ctx context.Context) ([]string, error) {
 	query := listRepoForOrgQuery{}
 	variables := map[string]interface{}{
 		"org":    (githubv4.String)(r.config.Organization),
@@ -91,7 +93,7 @@ func (r *githubRepository) listRepoForOrg() ([]string, error) {
 	}
 	var results []string
 	for {
-		err := r.client.Query(r.ctx, &query, variables)
+		err := r.client.Query(ctx, &query, variables)
 		if err != nil {
 			return nil, err
 		}
@@ -120,14 +122,14 @@ type listRepoForOwnerQuery struct {
 	}
 }
 
-func (r githubRepository) listRepoForOwner() ([]string, error) {
+func (r githubRepository) listRepoForOwner(ctx context.Context) ([]string, error) {
 	query := listRepoForOwnerQuery{}
 	variables := map[string]interface{}{
 		"cursor": (*githubv4.String)(nil),
 	}
 	var results []string
 	for {
-		err := r.client.Query(r.ctx, &query, variables)
+		err := r.client.Query(ctx, &query, variables)
 		if err != nil {
 			return nil, err
 		}
@@ -162,7 +164,7 @@ type Team struct {
 	Slug       string
 }
 
-func (r githubRepository) ListTeams() ([]Team, error) {
+func (r githubRepository) ListTeams(ctx context.Context) ([]Team, error) {
 	if v := r.cache.Get("githubListTeams"); v != nil {
 		return v.([]Team), nil
 	}
@@ -178,7 +180,7 @@ func (r githubRepository) ListTeams() ([]Team, error) {
 		"login":  (githubv4.String)(r.config.Organization),
 	}
 	for {
-		err := r.client.Query(r.ctx, &query, variables)
+		err := r.client.Query(ctx, &query, variables)
 		if err != nil {
 			return nil, err
 		}
@@ -212,7 +214,7 @@ type listMembership struct {
 	} `graphql:"organization(login: $login)"`
 }
 
-func (r *githubRepository) ListMembership() ([]string, error) {
+func (r *githubRepository) ListMembership(ctx context.Context) ([]string, error) {
 	if v := r.cache.Get("githubListMembership"); v != nil {
 		return v.([]string), nil
 	}
@@ -228,7 +230,7 @@ func (r *githubRepository) ListMembership() ([]string, error) {
 		"login":  (githubv4.String)(r.config.Organization),
 	}
 	for {
-		err := r.client.Query(r.ctx, &query, variables)
+		err := r.client.Query(ctx, &query, variables)
 		if err != nil {
 			return nil, err
 		}
@@ -261,12 +263,12 @@ type listTeamMembershipsQuery struct {
 	} `graphql:"organization(login: $login)"`
 }
 
-func (r githubRepository) ListTeamMemberships() ([]string, error) {
+func (r githubRepository) ListTeamMemberships(ctx context.Context) ([]string, error) {
 	if v := r.cache.Get("githubListTeamMemberships"); v != nil {
 		return v.([]string), nil
 	}
 
-	teamList, err := r.ListTeams()
+	teamList, err := r.ListTeams(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -285,7 +287,7 @@ func (r githubRepository) ListTeamMemberships() ([]string, error) {
 		variables["slug"] = (githubv4.String)(team.Slug)
 		variables["cursor"] = (*githubv4.String)(nil)
 		for {
-			err := r.client.Query(r.ctx, &query, variables)
+			err := r.client.Query(ctx, &query, variables)
 			if err != nil {
 				return nil, err
 			}
@@ -317,12 +319,12 @@ type listBranchProtectionQuery struct {
 	} `graphql:"repository(owner: $owner, name: $name)"`
 }
 
-func (r *githubRepository) ListBranchProtection() ([]string, error) {
+func (r *githubRepository) ListBranchProtection(ctx context.Context) ([]string, error) {
 	if v := r.cache.Get("githubListBranchProtection"); v != nil {
 		return v.([]string), nil
 	}
 
-	repoList, err := r.ListRepositories()
+	repoList, err := r.ListRepositories(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -339,7 +341,7 @@ func (r *githubRepository) ListBranchProtection() ([]string, error) {
 		variables["name"] = (githubv4.String)(repo)
 		variables["cursor"] = (*githubv4.String)(nil)
 		for {
-			err := r.client.Query(r.ctx, &query, variables)
+			err := r.client.Query(ctx, &query, variables)
 			if err != nil {
 				return nil, err
 			}
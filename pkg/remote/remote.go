@@ -1,15 +1,54 @@
 package remote
 
 import (
+	"context"
+
 	"github.com/cloudskiff/driftctl/pkg/alerter"
 	"github.com/cloudskiff/driftctl/pkg/output"
 	"github.com/cloudskiff/driftctl/pkg/remote/aws"
 	"github.com/cloudskiff/driftctl/pkg/remote/github"
+	"github.com/cloudskiff/driftctl/pkg/remote/retry"
 	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/stats"
 	"github.com/cloudskiff/driftctl/pkg/terraform"
 	"github.com/pkg/errors"
 )
 
+// Options holds settings specific to each remote
+type Options struct {
+	AWS    aws.Options
+	Github github.Options
+}
+
+// DefaultConcurrency is the number of resources read at the same time from a remote when not configured
+const DefaultConcurrency = 10
+
+// Concurrency returns the number of resources read at the same time from the given remote
+func (o Options) Concurrency(remote string) int64 {
+	var concurrency int64
+	switch remote {
+	case aws.RemoteAWSTerraform:
+		concurrency = o.AWS.Concurrency
+	case github.RemoteGithubTerraform:
+		concurrency = o.Github.Concurrency
+	}
+	if concurrency <= 0 {
+		return DefaultConcurrency
+	}
+	return concurrency
+}
+
+// Retry returns how calls to the given remote are retried
+func (o Options) Retry(remote string) retry.Options {
+	switch remote {
+	case aws.RemoteAWSTerraform:
+		return o.AWS.Retry
+	case github.RemoteGithubTerraform:
+		return o.Github.Retry
+	}
+	return retry.Options{}
+}
+
 var supportedRemotes = []string{
 	aws.RemoteAWSTerraform,
 	github.RemoteGithubTerraform,
@@ -24,20 +63,35 @@ func IsSupported(remote string) bool {
 	return false
 }
 
-func Activate(remote, version string, alerter *alerter.Alerter,
+// Remote reads resources of a cloud provider, its providers are started once and reused by every scan
+type Remote interface {
+	// AddSuppliers adds suppliers reading resources for a single scan to the library
+	AddSuppliers(alerter *alerter.Alerter, supplierLibrary *resource.SupplierLibrary, factory resource.ResourceFactory) error
+}
+
+// New starts providers of a remote and initializes its schemas, providers are added to providerLibrary to be cleaned up
+func New(ctx context.Context, remote, version string,
 	providerLibrary *terraform.ProviderLibrary,
-	supplierLibrary *resource.SupplierLibrary,
 	progress output.Progress,
 	resourceSchemaRepository *resource.SchemaRepository,
-	factory resource.ResourceFactory,
-	configDir string) error {
+	configDir string,
+	opts Options,
+	recorder *stats.Recorder) (Remote, error) {
 	switch remote {
 	case aws.RemoteAWSTerraform:
-		return aws.Init(version, alerter, providerLibrary, supplierLibrary, progress, resourceSchemaRepository, factory, configDir)
+		r, err := aws.NewRemote(ctx, version, providerLibrary, progress, resourceSchemaRepository, configDir, opts.AWS, recorder)
+		if err != nil {
+			return nil, err
+		}
+		return r, nil
 	case github.RemoteGithubTerraform:
-		return github.Init(version, alerter, providerLibrary, supplierLibrary, progress, resourceSchemaRepository, factory, configDir)
+		r, err := github.NewRemote(ctx, version, providerLibrary, progress, resourceSchemaRepository, configDir, opts.Github, recorder)
+		if err != nil {
+			return nil, err
+		}
+		return r, nil
 	default:
-		return errors.Errorf("unsupported remote '%s'", remote)
+		return nil, errors.Errorf("unsupported remote '%s'", remote)
 	}
 }
 
@@ -0,0 +1,25 @@
+package remote
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/pkg/remote/aws"
+	"github.com/cloudskiff/driftctl/pkg/remote/github"
+	"github.com/cloudskiff/driftctl/pkg/remote/retry"
+)
+
+func TestOptions_Retry(t *testing.T) {
+	awsRetry := retry.Options{MaxRetries: 10, MinBackoff: time.Second}
+	githubRetry := retry.Options{MaxRetries: 3, RateLimit: 5}
+	opts := Options{
+		AWS:    aws.Options{Retry: awsRetry},
+		Github: github.Options{Retry: githubRetry},
+	}
+
+	assert.Equal(t, awsRetry, opts.Retry(aws.RemoteAWSTerraform))
+	assert.Equal(t, githubRetry, opts.Retry(github.RemoteGithubTerraform))
+	assert.Equal(t, retry.Options{}, opts.Retry("unknown"))
+}
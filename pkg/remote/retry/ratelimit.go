@@ -0,0 +1,90 @@
+package retry
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket limiting calls for each key, e.g. each service of a cloud provider.
+// A nil RateLimiter does not limit anything.
+type RateLimiter struct {
+	rate    float64
+	burst   float64
+	lock    sync.Mutex
+	buckets map[string]*bucket
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a limiter allowing rate calls per second for each key,
+// it returns nil when rate is not positive
+func NewRateLimiter(rate float64) *RateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	burst := rate
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:    rate,
+		burst:   burst,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// Wait blocks until a call for the given key is allowed
+func (l *RateLimiter) Wait(key string) {
+	if l == nil {
+		return
+	}
+	time.Sleep(l.reserve(key, time.Now()))
+}
+
+// reserve takes a token from the bucket of the key and returns how long to wait before using it
+func (l *RateLimiter) reserve(key string, now time.Time) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	b, exist := l.buckets[key]
+	if !exist {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens += now.Sub(b.last).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.last = now
+
+	// Tokens may go negative, following calls then wait for previous reservations
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / l.rate * float64(time.Second))
+}
+
+type rateLimitedTransport struct {
+	base    http.RoundTripper
+	limiter *RateLimiter
+	key     string
+}
+
+// NewRateLimitedTransport returns a transport waiting for the limiter before each request
+func NewRateLimitedTransport(base http.RoundTripper, limiter *RateLimiter, key string) http.RoundTripper {
+	if limiter == nil {
+		return base
+	}
+	return &rateLimitedTransport{base, limiter, key}
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.limiter.Wait(t.key)
+	return t.base.RoundTrip(req)
+}
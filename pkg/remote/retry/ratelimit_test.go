@@ -0,0 +1,32 @@
+package retry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRateLimiter_Unlimited(t *testing.T) {
+	var limiter *RateLimiter = NewRateLimiter(0)
+	assert.Nil(t, limiter)
+	// A nil limiter never waits
+	limiter.Wait("ec2")
+}
+
+func TestRateLimiter_reserve(t *testing.T) {
+	limiter := NewRateLimiter(2)
+	now := time.Now()
+
+	// Burst of two calls
+	assert.Equal(t, time.Duration(0), limiter.reserve("ec2", now))
+	assert.Equal(t, time.Duration(0), limiter.reserve("ec2", now))
+	assert.Equal(t, 500*time.Millisecond, limiter.reserve("ec2", now))
+	assert.Equal(t, time.Second, limiter.reserve("ec2", now))
+
+	// Each service has its own bucket
+	assert.Equal(t, time.Duration(0), limiter.reserve("s3", now))
+
+	// Tokens are refilled over time
+	assert.Equal(t, time.Duration(0), limiter.reserve("ec2", now.Add(2*time.Second)))
+}
@@ -0,0 +1,99 @@
+package retry
+
+import (
+	"errors"
+	"time"
+
+	"github.com/eapache/go-resiliency/retrier"
+)
+
+// Options tunes how calls to cloud provider APIs are retried and rate limited
+type Options struct {
+	// MaxRetries is the number of retries of a call failing with a throttling error
+	MaxRetries int
+	// MinBackoff is the delay before the first retry, it doubles on each attempt up to MaxBackoff
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// RateLimit is the maximum number of calls per second to each service, 0 means unlimited
+	RateLimit float64
+}
+
+func DefaultOptions() Options {
+	return Options{
+		MaxRetries: 10,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: 30 * time.Second,
+	}
+}
+
+// Jitter randomizes backoff delays so concurrent calls are not retried all at once
+const Jitter = 0.5
+
+// Backoff returns n exponential delays, starting at MinBackoff and capped to MaxBackoff
+func (o Options) Backoff(n int) []time.Duration {
+	backoff := make([]time.Duration, n)
+	delay := o.MinBackoff
+	for i := range backoff {
+		if o.MaxBackoff > 0 && delay > o.MaxBackoff {
+			delay = o.MaxBackoff
+		}
+		backoff[i] = delay
+		delay *= 2
+	}
+	return backoff
+}
+
+// NewRetrier returns a retrier waiting an exponential backoff with jitter between attempts.
+// Throttling errors are retried up to MaxRetries times, other errors at most otherRetries times.
+// The retrier counts attempts, so a new one is needed for each call.
+func (o Options) NewRetrier(otherRetries int) *retrier.Retrier {
+	n := o.MaxRetries
+	if otherRetries > n {
+		n = otherRetries
+	}
+	r := retrier.New(o.Backoff(n), &classifier{otherRetries: otherRetries})
+	r.SetJitter(Jitter)
+	return r
+}
+
+type classifier struct {
+	otherRetries int
+	attempts     int
+}
+
+func (c *classifier) Classify(err error) retrier.Action {
+	if err == nil {
+		return retrier.Succeed
+	}
+	c.attempts++
+	if IsRetried(err) {
+		return retrier.Fail
+	}
+	if IsThrottlingError(err) || c.attempts <= c.otherRetries {
+		return retrier.Retry
+	}
+	return retrier.Fail
+}
+
+// retriedError is an error of a call that was already retried as much as allowed
+type retriedError struct {
+	error
+}
+
+func (e *retriedError) Unwrap() error {
+	return e.error
+}
+
+// Retried marks err as returned by a call that was already retried, so that calls wrapping it do not retry it again
+func Retried(err error) error {
+	if err == nil || IsRetried(err) {
+		return err
+	}
+	return &retriedError{err}
+}
+
+// IsRetried tells whether err, or one of the errors it wraps, was marked with Retried
+func IsRetried(err error) bool {
+	var retried *retriedError
+	return errors.As(err, &retried)
+}
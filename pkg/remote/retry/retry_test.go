@@ -0,0 +1,84 @@
+package retry
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptions_Backoff(t *testing.T) {
+	opts := Options{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	assert.Equal(t, []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}, opts.Backoff(6))
+	assert.Empty(t, opts.Backoff(0))
+}
+
+func TestOptions_NewRetrier(t *testing.T) {
+	tests := []struct {
+		name          string
+		otherRetries  int
+		err           error
+		expectedCalls int
+	}{
+		{
+			name:          "throttling error is retried up to max retries",
+			err:           awserr.New("Throttling", "Rate exceeded", nil),
+			expectedCalls: 4,
+		},
+		{
+			name:          "other error is not retried",
+			err:           errors.New("access denied"),
+			expectedCalls: 1,
+		},
+		{
+			name:          "other error is retried up to other retries",
+			otherRetries:  2,
+			err:           errors.New("connection reset"),
+			expectedCalls: 3,
+		},
+		{
+			name:          "success",
+			expectedCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := Options{MaxRetries: 3, MinBackoff: time.Microsecond, MaxBackoff: time.Millisecond}
+			calls := 0
+			err := opts.NewRetrier(tt.otherRetries).Run(func() error {
+				calls++
+				return tt.err
+			})
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func TestRetried(t *testing.T) {
+	throttled := awserr.New("Throttling", "Rate exceeded", nil)
+	retried := Retried(throttled)
+	assert.True(t, IsRetried(retried))
+	assert.False(t, IsRetried(errors.New(retried.Error())))
+	assert.Equal(t, throttled.Error(), retried.Error())
+	assert.Same(t, retried, Retried(retried))
+	assert.Nil(t, Retried(nil))
+
+	opts := Options{MaxRetries: 3, MinBackoff: time.Microsecond}
+	calls := 0
+	err := opts.NewRetrier(0).Run(func() error {
+		calls++
+		return retried
+	})
+	assert.Equal(t, retried, err)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,53 @@
+package retry
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// Messages of throttling errors that are only known as text, like errors returned by terraform providers
+var throttlingMessages = []string{
+	"throttling",
+	"throttled",
+	"rate exceeded",
+	"requestlimitexceeded",
+	"toomanyrequests",
+	"too many requests",
+	"rate limit",
+	"slowdown",
+}
+
+type rootCauser interface {
+	RootCause() error
+}
+
+// IsThrottlingError tells whether an error is caused by a cloud provider API throttling our calls
+func IsThrottlingError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	for cause := err; cause != nil; {
+		if request.IsErrorThrottle(cause) {
+			return true
+		}
+		switch c := cause.(type) {
+		case rootCauser:
+			cause = c.RootCause()
+		case interface{ Cause() error }:
+			cause = c.Cause()
+		default:
+			cause = nil
+		}
+	}
+
+	// Wrapping errors contain the message of their cause
+	message := strings.ToLower(err.Error())
+	for _, throttlingMessage := range throttlingMessages {
+		if strings.Contains(message, throttlingMessage) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,30 @@
+package retry
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsThrottlingError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil", err: nil, expected: false},
+		{name: "aws throttling error", err: awserr.New("ThrottlingException", "slow down", nil), expected: true},
+		{name: "wrapped aws throttling error", err: pkgerrors.Wrap(awserr.New("RequestLimitExceeded", "", nil), "unable to list"), expected: true},
+		{name: "terraform provider error", err: errors.New("error reading instance: Rate exceeded"), expected: true},
+		{name: "github rate limit", err: errors.New("API rate limit exceeded for user"), expected: true},
+		{name: "access denied", err: awserr.New("AccessDeniedException", "not authorized", nil), expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsThrottlingError(tt.err))
+		})
+	}
+}
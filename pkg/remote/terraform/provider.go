@@ -2,15 +2,14 @@ package terraform
 
 import (
 	"context"
-	"os"
-	"os/signal"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
 	"sync"
-	"syscall"
-	"time"
 
 	"github.com/cloudskiff/driftctl/pkg/output"
 
-	"github.com/eapache/go-resiliency/retrier"
 	"github.com/hashicorp/terraform/plugin"
 	"github.com/hashicorp/terraform/plugin/discovery"
 	"github.com/hashicorp/terraform/providers"
@@ -19,8 +18,13 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/gocty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
 
 	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/cache"
+	"github.com/cloudskiff/driftctl/pkg/remote/retry"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/stats"
 	tf "github.com/cloudskiff/driftctl/pkg/terraform"
 )
 
@@ -32,51 +36,96 @@ type TerraformProviderConfig struct {
 	Name              string
 	DefaultAlias      string
 	GetProviderConfig func(alias string) interface{}
+	// Maximum number of resources read at the same time, defaults to 10
+	Concurrency int64
+	Retry       retry.Options
+	// Stats records resources read by the provider, it may be nil
+	Stats *stats.Recorder
+	// Cache holds resources read by previous runs, it may be nil
+	Cache cache.Cache
 }
 
+func init() {
+	// Resources read are cached as JSON, see readCacheKey
+	cache.RegisterType(json.RawMessage{})
+}
+
+// Errors other than throttling ones are retried a few times when reading a resource
+const readResourceRetries = 3
+
 type TerraformProvider struct {
-	lock              sync.Mutex
+	lock              *sync.Mutex
 	providerInstaller *tf.ProviderInstaller
 	grpcProviders     map[string]*plugin.GRPCProvider
 	schemas           map[string]providers.Schema
 	Config            TerraformProviderConfig
 	runner            *parallel.ParallelRunner
 	progress          output.Progress
+	// ctx cancels resources being read and their retries
+	ctx context.Context
+	// Account and region resources read by this provider are attributed to in stats
+	statsScope resource.Meta
 }
 
-func NewTerraformProvider(installer *tf.ProviderInstaller, config TerraformProviderConfig, progress output.Progress) (*TerraformProvider, error) {
+func NewTerraformProvider(ctx context.Context, installer *tf.ProviderInstaller, config TerraformProviderConfig, progress output.Progress) (*TerraformProvider, error) {
+	if config.Concurrency <= 0 {
+		config.Concurrency = 10
+	}
 	p := TerraformProvider{
+		lock:              &sync.Mutex{},
 		providerInstaller: installer,
-		runner:            parallel.NewParallelRunner(context.TODO(), 10),
+		runner:            parallel.NewParallelRunner(ctx, config.Concurrency),
 		grpcProviders:     make(map[string]*plugin.GRPCProvider),
 		Config:            config,
 		progress:          progress,
+		ctx:               ctx,
 	}
 	return &p, nil
 }
 
 func (p *TerraformProvider) Init() error {
-	stopCh := make(chan bool)
-	c := make(chan os.Signal)
-	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
-	go func() {
-		select {
-		case <-c:
-			logrus.Warn("Detected interrupt during terraform provider configuration, cleanup ...")
-			p.Cleanup()
-			os.Exit(1)
-		case <-stopCh:
-			return
-		}
-	}()
-	defer func() {
-		stopCh <- true
-	}()
-	err := p.configure(p.Config.DefaultAlias)
-	if err != nil {
-		return err
+	return p.configure(p.Config.DefaultAlias)
+}
+
+// WithAlias returns a provider sharing gRPC clients with this one, but reading resources
+// with the given alias by default. The alias is configured on its first use.
+func (p *TerraformProvider) WithAlias(alias string) *TerraformProvider {
+	aliased := *p
+	aliased.Config.DefaultAlias = alias
+	return &aliased
+}
+
+// WithStatsScope returns a provider sharing gRPC clients with this one, attributing resources it reads
+// to the given account and region in stats
+func (p *TerraformProvider) WithStatsScope(scope resource.Meta) *TerraformProvider {
+	scoped := *p
+	scoped.statsScope = scope
+	return &scoped
+}
+
+// WithCache returns a provider sharing gRPC clients with this one, reading resources from the given cache first
+func (p *TerraformProvider) WithCache(c cache.Cache) *TerraformProvider {
+	cached := *p
+	cached.Config.Cache = c
+	return &cached
+}
+
+func (p *TerraformProvider) StatsScope() resource.Meta {
+	return p.statsScope
+}
+
+// WithConfig returns a provider using the same installed plugin with another configuration.
+// Unlike WithAlias, gRPC clients are not shared so it needs to be initialized and cleaned up on its own.
+func (p *TerraformProvider) WithConfig(config TerraformProviderConfig) *TerraformProvider {
+	return &TerraformProvider{
+		lock:              &sync.Mutex{},
+		providerInstaller: p.providerInstaller,
+		runner:            p.runner,
+		grpcProviders:     make(map[string]*plugin.GRPCProvider),
+		Config:            config,
+		progress:          p.progress,
+		ctx:               p.ctx,
 	}
-	return nil
 }
 
 func (p *TerraformProvider) Schema() map[string]providers.Schema {
@@ -161,9 +210,11 @@ func (p *TerraformProvider) ReadResource(args tf.ReadResourceArgs) (*cty.Value,
 	if p.grpcProviders[alias] == nil {
 		err := p.configure(alias)
 		if err != nil {
+			p.lock.Unlock()
 			return nil, err
 		}
 	}
+	grpcProvider := p.grpcProviders[alias]
 	p.lock.Unlock()
 
 	if args.Attributes != nil && len(args.Attributes) > 0 {
@@ -176,16 +227,40 @@ func (p *TerraformProvider) ReadResource(args tf.ReadResourceArgs) (*cty.Value,
 
 	impliedType := p.schemas[typ].Block.ImpliedType()
 
+	cacheKey := readCacheKey(alias, typ, args.ID, state.Attributes)
+	if p.Config.Cache != nil {
+		if v := p.Config.Cache.Get(cacheKey); v != nil {
+			cachedState, err := ctyjson.Unmarshal(v.(json.RawMessage), impliedType)
+			if err == nil {
+				p.Config.Stats.RecordRead(p.statsScope, args.Ty, 0)
+				p.progress.Inc()
+				return &cachedState, nil
+			}
+			logrus.WithFields(logrus.Fields{
+				"id":   args.ID,
+				"type": args.Ty,
+			}).Debugf("Ignoring cached resource: %s", err)
+		}
+	}
+
 	priorState, err := state.AttrsAsObjectValue(impliedType)
 	if err != nil {
 		return nil, err
 	}
 
+	// Reads and their retries stop along with the scan, not only when the provider is cleaned up
+	ctx := p.ctx
+	if args.Ctx != nil {
+		ctx = args.Ctx
+	}
+
 	var newState cty.Value
-	r := retrier.New(retrier.ConstantBackoff(3, 100*time.Millisecond), nil)
+	r := p.Config.Retry.NewRetrier(readResourceRetries)
 
-	err = r.Run(func() error {
-		resp := p.grpcProviders[alias].ReadResource(providers.ReadResourceRequest{
+	attempts := 0
+	err = r.RunCtx(ctx, func(ctx context.Context) error {
+		attempts++
+		resp := grpcProvider.ReadResource(providers.ReadResourceRequest{
 			TypeName:     typ,
 			PriorState:   priorState,
 			Private:      []byte{},
@@ -201,14 +276,32 @@ func (p *TerraformProvider) ReadResource(args tf.ReadResourceArgs) (*cty.Value,
 		newState = resp.NewState
 		return nil
 	})
+	p.Config.Stats.RecordRead(p.statsScope, args.Ty, attempts-1)
 
 	if err != nil {
-		return nil, err
+		// Retrying the supplier would read the resource again as many times
+		return nil, retry.Retried(err)
+	}
+	if p.Config.Cache != nil {
+		if raw, err := ctyjson.Marshal(newState, impliedType); err == nil {
+			p.Config.Cache.Put(cacheKey, json.RawMessage(raw))
+		}
 	}
 	p.progress.Inc()
 	return &newState, nil
 }
 
+// readCacheKey identifies a resource read with the given alias, the provider version
+// and the account being part of the cache directory
+func readCacheKey(alias, typ, id string, attributes map[string]string) string {
+	attrs := make([]string, 0, len(attributes))
+	for k, v := range attributes {
+		attrs = append(attrs, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(attrs)
+	return fmt.Sprintf("%s_%s_%s_%s", alias, typ, id, strings.Join(attrs, ","))
+}
+
 func (p *TerraformProvider) Cleanup() {
 	for alias, client := range p.grpcProviders {
 		logrus.WithFields(logrus.Fields{
@@ -1,6 +1,7 @@
 package aws_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -25,7 +26,7 @@ func TestAcc_AwsSNSTopicPolicy(t *testing.T) {
 				PreExec: func() {
 					err := acceptance.RetryFor(60*time.Second, func(doneCh chan struct{}) error {
 						client := repository.NewSNSClient(awsutils.Session(), cache.New(0))
-						topics, err := client.ListAllTopics()
+						topics, err := client.ListAllTopics(context.Background())
 						if err != nil {
 							logrus.Warnf("Cannot list topics: %+v", err)
 							return err
@@ -1,6 +1,7 @@
 package aws_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -25,7 +26,7 @@ func TestAcc_AwsSNSTopicSubscription(t *testing.T) {
 				PreExec: func() {
 					err := acceptance.RetryFor(60*time.Second, func(doneCh chan struct{}) error {
 						client := repository.NewSNSClient(awsutils.Session(), cache.New(0))
-						topics, err := client.ListAllSubscriptions()
+						topics, err := client.ListAllSubscriptions(context.Background())
 						if err != nil {
 							logrus.Warnf("Cannot list Subscriptions: %+v", err)
 							return err
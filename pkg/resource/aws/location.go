@@ -0,0 +1,24 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/arn"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+// LocationFromArn returns the account and the region a resource lives in according to its arn attribute.
+// The returned metadata is empty for resources without ARN, global resources have no region.
+func LocationFromArn(res resource.Resource) resource.Meta {
+	if res.Attributes() == nil {
+		return resource.Meta{}
+	}
+	value := res.Attributes().GetString("arn")
+	if value == nil {
+		return resource.Meta{}
+	}
+	parsed, err := arn.Parse(*value)
+	if err != nil {
+		return resource.Meta{}
+	}
+	return resource.Meta{Account: parsed.AccountID, Region: parsed.Region}
+}
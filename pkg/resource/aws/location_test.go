@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+func TestLocationFromArn(t *testing.T) {
+	tests := []struct {
+		name  string
+		attrs *resource.Attributes
+		want  resource.Meta
+	}{
+		{
+			name:  "regional resource",
+			attrs: &resource.Attributes{"arn": "arn:aws:kms:eu-west-3:123456789012:alias/aws/ebs"},
+			want:  resource.Meta{Account: "123456789012", Region: "eu-west-3"},
+		},
+		{
+			name:  "global resource",
+			attrs: &resource.Attributes{"arn": "arn:aws:iam::123456789012:role/OrganizationAccountAccessRole"},
+			want:  resource.Meta{Account: "123456789012"},
+		},
+		{
+			name:  "invalid arn",
+			attrs: &resource.Attributes{"arn": "alias/aws/ebs"},
+			want:  resource.Meta{},
+		},
+		{
+			name:  "without arn",
+			attrs: &resource.Attributes{},
+			want:  resource.Meta{},
+		},
+		{
+			name: "without attributes",
+			want: resource.Meta{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &resource.AbstractResource{Id: "foo", Type: AwsKmsAliasResourceType, Attrs: tt.attrs}
+			assert.Equal(t, tt.want, LocationFromArn(res))
+		})
+	}
+}
@@ -9,25 +9,23 @@ import (
 
 type ChainSupplier struct {
 	suppliers []Supplier
-	runner    *parallel.ParallelRunner
 }
 
 func NewChainSupplier() *ChainSupplier {
-	return &ChainSupplier{
-		runner: parallel.NewParallelRunner(context.TODO(), int64(runtime.NumCPU())),
-	}
+	return &ChainSupplier{}
 }
 
 func (r *ChainSupplier) AddSupplier(supplier Supplier) {
 	r.suppliers = append(r.suppliers, supplier)
 }
 
-func (r *ChainSupplier) Resources() ([]Resource, error) {
+func (r *ChainSupplier) Resources(ctx context.Context) ([]Resource, error) {
+	runner := parallel.NewParallelRunner(ctx, int64(runtime.NumCPU()))
 
 	for _, supplier := range r.suppliers {
 		sup := supplier
-		r.runner.Run(func() (interface{}, error) {
-			return sup.Resources()
+		runner.Run(func() (interface{}, error) {
+			return sup.Resources(ctx)
 		})
 	}
 
@@ -36,7 +34,7 @@ func (r *ChainSupplier) Resources() ([]Resource, error) {
 ReadLoop:
 	for {
 		select {
-		case supplierResult, ok := <-r.runner.Read():
+		case supplierResult, ok := <-runner.Read():
 			if !ok || supplierResult == nil {
 				break ReadLoop
 			}
@@ -44,13 +42,13 @@ ReadLoop:
 			// by Supplier interface
 			resources, _ := supplierResult.([]Resource)
 			results = append(results, resources...)
-		case <-r.runner.DoneChan():
+		case <-runner.DoneChan():
 			break ReadLoop
 		}
 	}
 
-	if r.runner.Err() != nil {
-		return nil, r.runner.Err()
+	if runner.Err() != nil {
+		return nil, runner.Err()
 	}
 
 	return results, nil
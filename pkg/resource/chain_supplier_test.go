@@ -1,6 +1,7 @@
 package resource_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/cloudskiff/driftctl/pkg/resource"
 	testresource "github.com/cloudskiff/driftctl/test/resource"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestChainSupplier_Resources(t *testing.T) {
@@ -15,7 +17,7 @@ func TestChainSupplier_Resources(t *testing.T) {
 	assert := assert.New(t)
 
 	fakeTestSupplier := mocks.Supplier{}
-	fakeTestSupplier.On("Resources").Return(
+	fakeTestSupplier.On("Resources", mock.Anything).Return(
 		[]resource.Resource{
 			&testresource.FakeResource{
 				Id: "fake-supplier-1_fake-resource-1",
@@ -28,7 +30,7 @@ func TestChainSupplier_Resources(t *testing.T) {
 	).Once()
 
 	anotherFakeTestSupplier := mocks.Supplier{}
-	anotherFakeTestSupplier.On("Resources").Return(
+	anotherFakeTestSupplier.On("Resources", mock.Anything).Return(
 		[]resource.Resource{
 			&testresource.FakeResource{
 				Id: "fake-supplier-2_fake-resource-1",
@@ -44,7 +46,7 @@ func TestChainSupplier_Resources(t *testing.T) {
 	chain.AddSupplier(&fakeTestSupplier)
 	chain.AddSupplier(&anotherFakeTestSupplier)
 
-	res, err := chain.Resources()
+	res, err := chain.Resources(context.Background())
 
 	if err != nil {
 		t.Fatal(err)
@@ -61,7 +63,7 @@ func TestChainSupplier_Resources_WithError(t *testing.T) {
 
 	fakeTestSupplier := mocks.Supplier{}
 	fakeTestSupplier.
-		On("Resources").
+		On("Resources", mock.Anything).
 		Return([]resource.Resource{
 			&testresource.FakeResource{
 				Id: "fake-supplier-1_fake-resource-1",
@@ -75,7 +77,7 @@ func TestChainSupplier_Resources_WithError(t *testing.T) {
 
 	anotherFakeTestSupplier := mocks.Supplier{}
 	anotherFakeTestSupplier.
-		On("Resources").
+		On("Resources", mock.Anything).
 		Return(nil, errors.New("error from another supplier")).
 		Once()
 
@@ -83,7 +85,7 @@ func TestChainSupplier_Resources_WithError(t *testing.T) {
 	chain.AddSupplier(&fakeTestSupplier)
 	chain.AddSupplier(&anotherFakeTestSupplier)
 
-	res, err := chain.Resources()
+	res, err := chain.Resources(context.Background())
 
 	anotherFakeTestSupplier.AssertExpectations(t)
 	assert.Nil(res)
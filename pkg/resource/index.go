@@ -0,0 +1,130 @@
+package resource
+
+type indexKey struct {
+	ty string
+	id string
+}
+
+func keyOf(res Resource) indexKey {
+	return indexKey{ty: res.TerraformType(), id: res.TerraformId()}
+}
+
+// SameLocation returns true when two resources may have been read from the same account and region.
+// An unknown account or region matches any, as global resources and resources of some states do not carry them.
+func SameLocation(a, b Meta) bool {
+	return (a.Account == "" || b.Account == "" || a.Account == b.Account) &&
+		(a.Region == "" || b.Region == "" || a.Region == b.Region)
+}
+
+// Index allows constant time lookups of resources by type and id.
+// Resources sharing the same type and id are kept in insertion order, so Take
+// behaves like a linear scan followed by a removal of the first match.
+// Resources carrying metadata only match resources found in the same location, see SameLocation.
+type Index struct {
+	resources []Resource
+	taken     []bool
+	positions map[indexKey][]int
+}
+
+func NewIndex(resources []Resource) *Index {
+	idx := &Index{
+		resources: make([]Resource, 0, len(resources)),
+		taken:     make([]bool, 0, len(resources)),
+		positions: make(map[indexKey][]int, len(resources)),
+	}
+	for _, res := range resources {
+		idx.Add(res)
+	}
+	return idx
+}
+
+func (i *Index) Add(res Resource) {
+	key := keyOf(res)
+	i.positions[key] = append(i.positions[key], len(i.resources))
+	i.resources = append(i.resources, res)
+	i.taken = append(i.taken, false)
+}
+
+// Find returns the first resource with the same type and id as res, found in the same location
+func (i *Index) Find(res Resource) (Resource, bool) {
+	pos, found := i.find(res)
+	if !found {
+		return nil, false
+	}
+	return i.resources[pos], true
+}
+
+// Get returns the first resource of the given type and id
+func (i *Index) Get(ty, id string) (Resource, bool) {
+	positions := i.positions[indexKey{ty: ty, id: id}]
+	if len(positions) == 0 {
+		return nil, false
+	}
+	return i.resources[positions[0]], true
+}
+
+func (i *Index) Contains(res Resource) bool {
+	_, found := i.find(res)
+	return found
+}
+
+// Matches returns every resource with the same type and id as res, found in the same location
+func (i *Index) Matches(res Resource) []Resource {
+	meta := MetadataOf(res)
+	var matches []Resource
+	for _, pos := range i.positions[keyOf(res)] {
+		if SameLocation(meta, MetadataOf(i.resources[pos])) {
+			matches = append(matches, i.resources[pos])
+		}
+	}
+	return matches
+}
+
+// Take removes and returns the first resource with the same type and id as res, found in the same location
+func (i *Index) Take(res Resource) (Resource, bool) {
+	pos, found := i.find(res)
+	if !found {
+		return nil, false
+	}
+	key := keyOf(res)
+	positions := i.positions[key]
+	for j, p := range positions {
+		if p != pos {
+			continue
+		}
+		if j == 0 {
+			positions = positions[1:]
+		} else {
+			positions = append(positions[:j:j], positions[j+1:]...)
+		}
+		break
+	}
+	if len(positions) == 0 {
+		delete(i.positions, key)
+	} else {
+		i.positions[key] = positions
+	}
+	i.taken[pos] = true
+	return i.resources[pos], true
+}
+
+func (i *Index) find(res Resource) (int, bool) {
+	meta := MetadataOf(res)
+	for _, pos := range i.positions[keyOf(res)] {
+		if SameLocation(meta, MetadataOf(i.resources[pos])) {
+			return pos, true
+		}
+	}
+	return 0, false
+}
+
+// Remaining returns resources that were not taken, in insertion order
+func (i *Index) Remaining() []Resource {
+	remaining := make([]Resource, 0, len(i.resources))
+	for pos, res := range i.resources {
+		if !i.taken[pos] {
+			remaining = append(remaining, res)
+		}
+	}
+	return remaining
+}
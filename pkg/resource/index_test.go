@@ -0,0 +1,73 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndex(t *testing.T) {
+	first := &AbstractResource{Id: "foo", Type: "aws_s3_bucket", Attrs: &Attributes{"n": "first"}}
+	second := &AbstractResource{Id: "foo", Type: "aws_s3_bucket", Attrs: &Attributes{"n": "second"}}
+	other := &AbstractResource{Id: "foo", Type: "aws_iam_role"}
+	bar := &AbstractResource{Id: "bar", Type: "aws_s3_bucket"}
+
+	idx := NewIndex([]Resource{first, other, second, bar})
+
+	assert.True(t, idx.Contains(&AbstractResource{Id: "bar", Type: "aws_s3_bucket"}))
+	assert.False(t, idx.Contains(&AbstractResource{Id: "bar", Type: "aws_iam_role"}))
+
+	res, found := idx.Get("aws_iam_role", "foo")
+	assert.True(t, found)
+	assert.Same(t, other, res)
+
+	res, found = idx.Take(&AbstractResource{Id: "foo", Type: "aws_s3_bucket"})
+	assert.True(t, found)
+	assert.Same(t, first, res)
+
+	res, found = idx.Find(&AbstractResource{Id: "foo", Type: "aws_s3_bucket"})
+	assert.True(t, found)
+	assert.Same(t, second, res)
+
+	res, found = idx.Take(&AbstractResource{Id: "foo", Type: "aws_s3_bucket"})
+	assert.True(t, found)
+	assert.Same(t, second, res)
+
+	_, found = idx.Take(&AbstractResource{Id: "foo", Type: "aws_s3_bucket"})
+	assert.False(t, found)
+
+	assert.Equal(t, []Resource{other, bar}, idx.Remaining())
+}
+
+func TestIndex_Location(t *testing.T) {
+	usEast1 := &AbstractResource{Id: "alias/aws/ebs", Type: "aws_kms_alias", Meta: Meta{Region: "us-east-1"}}
+	euWest3 := &AbstractResource{Id: "alias/aws/ebs", Type: "aws_kms_alias", Meta: Meta{Region: "eu-west-3"}}
+
+	idx := NewIndex([]Resource{usEast1, euWest3})
+
+	assert.False(t, idx.Contains(&AbstractResource{Id: "alias/aws/ebs", Type: "aws_kms_alias", Meta: Meta{Region: "us-west-2"}}))
+
+	res, found := idx.Take(&AbstractResource{Id: "alias/aws/ebs", Type: "aws_kms_alias", Meta: Meta{Region: "eu-west-3"}})
+	assert.True(t, found)
+	assert.Same(t, euWest3, res)
+
+	// Resources of an unknown region match any region
+	res, found = idx.Find(&AbstractResource{Id: "alias/aws/ebs", Type: "aws_kms_alias"})
+	assert.True(t, found)
+	assert.Same(t, usEast1, res)
+
+	assert.Equal(t, []Resource{usEast1}, idx.Remaining())
+}
+
+func TestIndex_Account(t *testing.T) {
+	first := &AbstractResource{Id: "OrganizationAccountAccessRole", Type: "aws_iam_role", Meta: Meta{Account: "111111111111"}}
+	second := &AbstractResource{Id: "OrganizationAccountAccessRole", Type: "aws_iam_role", Meta: Meta{Account: "222222222222"}}
+
+	idx := NewIndex([]Resource{first, second})
+
+	res, found := idx.Find(&AbstractResource{Id: "OrganizationAccountAccessRole", Type: "aws_iam_role", Meta: Meta{Account: "222222222222"}})
+	assert.True(t, found)
+	assert.Same(t, second, res)
+	assert.Equal(t, []Resource{first, second}, idx.Matches(&AbstractResource{Id: "OrganizationAccountAccessRole", Type: "aws_iam_role"}))
+	assert.Equal(t, []Resource{first}, idx.Matches(&AbstractResource{Id: "OrganizationAccountAccessRole", Type: "aws_iam_role", Meta: Meta{Account: "111111111111", Region: "us-east-1"}}))
+}
@@ -2,20 +2,24 @@
 
 package resource
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockSupplier is an autogenerated mock type for the Supplier type
 type MockSupplier struct {
 	mock.Mock
 }
 
-// Resources provides a mock function with given fields:
-func (_m *MockSupplier) Resources() ([]Resource, error) {
-	ret := _m.Called()
+// Resources provides a mock function with given fields: ctx
+func (_m *MockSupplier) Resources(ctx context.Context) ([]Resource, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []Resource
-	if rf, ok := ret.Get(0).(func() []Resource); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []Resource); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]Resource)
@@ -23,8 +27,8 @@ func (_m *MockSupplier) Resources() ([]Resource, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -17,11 +17,37 @@ type Resource interface {
 	Schema() *Schema
 }
 
+// Meta holds information about where a resource has been found,
+// it is not part of the attributes compared during the analysis
+type Meta struct {
+	Account string `json:"account,omitempty"`
+	Region  string `json:"region,omitempty"`
+	// Address of the resource in its Terraform state (e.g. module.x.aws_s3_bucket.logs["eu"]) and the state it was read from,
+	// only set on resources read from IaC
+	Address string `json:"address,omitempty"`
+	Source  string `json:"source,omitempty"`
+}
+
+// ResourceWithMeta is implemented by resources able to carry metadata
+type ResourceWithMeta interface {
+	Resource
+	Metadata() *Meta
+}
+
+// MetadataOf returns a copy of the metadata of a resource, empty if it does not carry any
+func MetadataOf(res Resource) Meta {
+	if r, ok := res.(ResourceWithMeta); ok {
+		return *r.Metadata()
+	}
+	return Meta{}
+}
+
 type AbstractResource struct {
 	Id    string
 	Type  string
 	Attrs *Attributes
 	Sch   *Schema `json:"-" diff:"-"`
+	Meta  Meta    `json:"-" diff:"-"`
 }
 
 func (a *AbstractResource) Schema() *Schema {
@@ -40,6 +66,10 @@ func (a *AbstractResource) Attributes() *Attributes {
 	return a.Attrs
 }
 
+func (a *AbstractResource) Metadata() *Meta {
+	return &a.Meta
+}
+
 type ResourceFactory interface {
 	CreateAbstractResource(ty, id string, data map[string]interface{}) *AbstractResource
 }
@@ -51,6 +81,8 @@ type SerializableResource struct {
 type SerializedResource struct {
 	Id   string `json:"id"`
 	Type string `json:"type"`
+	Meta
+	HumanReadableAttributes map[string]string `json:"human_readable_attributes,omitempty"`
 }
 
 func (u *SerializedResource) TerraformId() string {
@@ -69,6 +101,10 @@ func (u *SerializedResource) Schema() *Schema {
 	return nil
 }
 
+func (u *SerializedResource) Metadata() *Meta {
+	return &u.Meta
+}
+
 func (s *SerializableResource) UnmarshalJSON(bytes []byte) error {
 	var res *SerializedResource
 
@@ -80,7 +116,29 @@ func (s *SerializableResource) UnmarshalJSON(bytes []byte) error {
 }
 
 func (s SerializableResource) MarshalJSON() ([]byte, error) {
-	return json.Marshal(SerializedResource{Id: s.TerraformId(), Type: s.TerraformType()})
+	return json.Marshal(SerializedResource{
+		Id:                      s.TerraformId(),
+		Type:                    s.TerraformType(),
+		Meta:                    MetadataOf(s.Resource),
+		HumanReadableAttributes: HumanReadableAttributes(s.Resource),
+	})
+}
+
+// HumanReadableAttributes returns attributes helping humans to recognize a resource (e.g. its name),
+// nil when its schema does not define any
+func HumanReadableAttributes(res Resource) map[string]string {
+	if serialized, ok := res.(*SerializedResource); ok {
+		return serialized.HumanReadableAttributes
+	}
+	abstract, ok := res.(*AbstractResource)
+	if !ok || res.Schema() == nil || res.Schema().HumanReadableAttributesFunc == nil {
+		return nil
+	}
+	attributes := res.Schema().HumanReadableAttributesFunc(abstract)
+	if len(attributes) == 0 {
+		return nil
+	}
+	return attributes
 }
 
 type NormalizedResource interface {
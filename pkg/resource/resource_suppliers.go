@@ -2,18 +2,60 @@ package resource
 
 type SupplierLibrary struct {
 	resourceSupplier []Supplier
+	supplierTypes    [][]ResourceType
 }
 
 func NewSupplierLibrary() *SupplierLibrary {
 	return &SupplierLibrary{
 		make([]Supplier, 0),
+		make([][]ResourceType, 0),
 	}
 }
 
-func (r *SupplierLibrary) AddSupplier(supplier Supplier) {
+// AddSupplier registers a supplier along with the resource types it produces.
+// A supplier registered without any type is never skipped.
+func (r *SupplierLibrary) AddSupplier(supplier Supplier, types ...ResourceType) {
 	r.resourceSupplier = append(r.resourceSupplier, supplier)
+	r.supplierTypes = append(r.supplierTypes, types)
 }
 
 func (r *SupplierLibrary) Suppliers() []Supplier {
 	return r.resourceSupplier
 }
+
+type typedSupplier struct {
+	Supplier
+	types []ResourceType
+}
+
+func (s *typedSupplier) Types() []ResourceType {
+	return s.types
+}
+
+func (s *typedSupplier) Meta() Meta {
+	return MetaOfSupplier(s.Supplier)
+}
+
+// SuppliersFor returns suppliers that produce at least one of the given types.
+// Suppliers registered with their types are returned as TypedSupplier.
+func (r *SupplierLibrary) SuppliersFor(types []ResourceType) []Supplier {
+	wanted := make(map[ResourceType]struct{}, len(types))
+	for _, ty := range types {
+		wanted[ty] = struct{}{}
+	}
+
+	suppliers := make([]Supplier, 0, len(r.resourceSupplier))
+	for i, supplier := range r.resourceSupplier {
+		if len(r.supplierTypes[i]) == 0 {
+			suppliers = append(suppliers, supplier)
+			continue
+		}
+		for _, ty := range r.supplierTypes[i] {
+			if _, exist := wanted[ty]; exist {
+				suppliers = append(suppliers, &typedSupplier{supplier, r.supplierTypes[i]})
+				break
+			}
+		}
+	}
+	return suppliers
+}
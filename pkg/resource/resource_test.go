@@ -1,6 +1,7 @@
 package resource
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -73,3 +74,30 @@ func TestSanitizeDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestSerializableResource_HumanReadableAttributes(t *testing.T) {
+	res := &AbstractResource{
+		Id:    "i-0123",
+		Type:  "aws_instance",
+		Attrs: &Attributes{"tags": map[string]interface{}{"name": "web"}},
+		Sch: &Schema{
+			HumanReadableAttributesFunc: func(res *AbstractResource) map[string]string {
+				return map[string]string{"Name": (*res.Attrs)["tags"].(map[string]interface{})["name"].(string)}
+			},
+		},
+		Meta: Meta{Region: "eu-west-3"},
+	}
+
+	content, err := json.Marshal(SerializableResource{Resource: res})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"id": "i-0123", "type": "aws_instance", "region": "eu-west-3", "human_readable_attributes": {"Name": "web"}}`, string(content))
+
+	got := SerializableResource{}
+	if err := json.Unmarshal(content, &got); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]string{"Name": "web"}, HumanReadableAttributes(got.Resource))
+	assert.Nil(t, HumanReadableAttributes(&AbstractResource{Id: "bucket", Type: "aws_s3_bucket"}))
+}
@@ -1,5 +1,7 @@
 package resource
 
+import "sort"
+
 type ResourceType string
 
 var supportedTypes = map[string]struct{}{
@@ -63,6 +65,46 @@ var supportedTypes = map[string]struct{}{
 	"github_team_membership":   {},
 }
 
+// Some middlewares need remote resources of another type to decide what to do with a
+// resource, those types have to be enumerated as well even if they are filtered out afterwards
+var typeDependencies = map[ResourceType][]ResourceType{
+	"aws_eip_association":       {"aws_nat_gateway"},
+	"aws_iam_policy_attachment": {"aws_iam_role_policy_attachment", "aws_iam_user_policy_attachment"},
+	"aws_iam_role_policy":       {"aws_iam_role"},
+	"aws_instance":              {"aws_eip", "aws_eip_association"},
+	"aws_internet_gateway":      {"aws_default_vpc"},
+	"aws_route":                 {"aws_default_vpc", "aws_internet_gateway"},
+}
+
+func GetSupportedTypes() []ResourceType {
+	types := make([]ResourceType, 0, len(supportedTypes))
+	for ty := range supportedTypes {
+		types = append(types, ResourceType(ty))
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i] < types[j]
+	})
+	return types
+}
+
+// WithDependencies returns given types along with the types they transitively depend on
+func WithDependencies(types []ResourceType) []ResourceType {
+	result := make([]ResourceType, 0, len(types))
+	seen := make(map[ResourceType]struct{}, len(types))
+	queue := append([]ResourceType{}, types...)
+	for len(queue) > 0 {
+		ty := queue[0]
+		queue = queue[1:]
+		if _, exist := seen[ty]; exist {
+			continue
+		}
+		seen[ty] = struct{}{}
+		result = append(result, ty)
+		queue = append(queue, typeDependencies[ty]...)
+	}
+	return result
+}
+
 func IsResourceTypeSupported(ty string) bool {
 	_, exist := supportedTypes[ty]
 	return exist
@@ -20,6 +20,8 @@ type Schema struct {
 	Attributes                  map[string]AttributeSchema
 	NormalizeFunc               func(res *AbstractResource)
 	HumanReadableAttributesFunc func(res *AbstractResource) map[string]string
+	// Block is the provider schema of the resource, with its nested blocks
+	Block *configschema.Block
 }
 
 func (s *Schema) IsComputedField(path []string) bool {
@@ -46,13 +48,15 @@ type SchemaRepositoryInterface interface {
 }
 
 type SchemaRepository struct {
-	schemas         map[string]*Schema
-	ProviderVersion *version.Version
+	schemas map[string]*Schema
+	// Version of each provider schemas were read from, by provider name
+	ProviderVersions map[string]*version.Version
 }
 
 func NewSchemaRepository() *SchemaRepository {
 	return &SchemaRepository{
-		schemas: make(map[string]*Schema),
+		schemas:          make(map[string]*Schema),
+		ProviderVersions: make(map[string]*version.Version),
 	}
 }
 
@@ -77,12 +81,12 @@ func (r *SchemaRepository) fetchNestedBlocks(root string, metadata map[string]At
 	}
 }
 
-func (r *SchemaRepository) Init(v string, schema map[string]providers.Schema) error {
+func (r *SchemaRepository) Init(providerName, v string, schema map[string]providers.Schema) error {
 	providerVersion, err := version.NewVersion(v)
 	if err != nil {
 		return err
 	}
-	r.ProviderVersion = providerVersion
+	r.ProviderVersions[providerName] = providerVersion
 	for typ, sch := range schema {
 		attributeMetas := map[string]AttributeSchema{}
 		for s, attribute := range sch.Block.Attributes {
@@ -94,9 +98,10 @@ func (r *SchemaRepository) Init(v string, schema map[string]providers.Schema) er
 		r.fetchNestedBlocks("", attributeMetas, sch.Block.BlockTypes)
 
 		r.schemas[typ] = &Schema{
-			ProviderVersion: r.ProviderVersion,
+			ProviderVersion: providerVersion,
 			SchemaVersion:   sch.Version,
 			Attributes:      attributeMetas,
+			Block:           sch.Block,
 		}
 	}
 	return nil
@@ -1,11 +1,29 @@
 package resource
 
+import "context"
+
 // Resource Supplier supply the list of resource.Resource, its the front to retrieve remote resources
+// Resources should stop as soon as possible with ctx's error once it is done
 type Supplier interface {
-	Resources() ([]Resource, error)
+	Resources(ctx context.Context) ([]Resource, error)
+}
+
+// TypedSupplier is a supplier that knows which resource types it returns
+type TypedSupplier interface {
+	Supplier
+	Types() []ResourceType
 }
 
-type StoppableSupplier interface {
+// SupplierWithMeta is a supplier reading resources from a single account and region
+type SupplierWithMeta interface {
 	Supplier
-	Stop()
+	Meta() Meta
+}
+
+// MetaOfSupplier returns where a supplier reads resources from, empty if it does not tell
+func MetaOfSupplier(supplier Supplier) Meta {
+	if s, ok := supplier.(SupplierWithMeta); ok {
+		return s.Meta()
+	}
+	return Meta{}
 }
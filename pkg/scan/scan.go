@@ -0,0 +1,273 @@
+// Package scan runs driftctl scans from Go code.
+// It never writes to stdout on its own, does not change global state and never exits the process,
+// so it can be embedded in other programs.
+package scan
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/cloudskiff/driftctl/pkg"
+	"github.com/cloudskiff/driftctl/pkg/alerter"
+	"github.com/cloudskiff/driftctl/pkg/analyser"
+	"github.com/cloudskiff/driftctl/pkg/cmd/scan/output"
+	"github.com/cloudskiff/driftctl/pkg/filter"
+	"github.com/cloudskiff/driftctl/pkg/iac/config"
+	"github.com/cloudskiff/driftctl/pkg/iac/supplier"
+	"github.com/cloudskiff/driftctl/pkg/iac/terraform/state/backend"
+	globaloutput "github.com/cloudskiff/driftctl/pkg/output"
+	"github.com/cloudskiff/driftctl/pkg/remote"
+	"github.com/cloudskiff/driftctl/pkg/remote/aws"
+	"github.com/cloudskiff/driftctl/pkg/remote/retry"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/stats"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+)
+
+// Options configures a scan, only an IaC source is required
+type Options struct {
+	// From lists IaC sources, e.g. tfstate://terraform.tfstate. It is ignored when IaCSupplier is set.
+	From           []config.SupplierConfig
+	BackendOptions *backend.Options
+
+	// To lists remotes to scan, aws+tf is scanned when empty unless RemoteSupplier is set.
+	// Remotes are activated even with a RemoteSupplier as their schemas are needed to read states.
+	To              []string
+	ProviderVersion string
+	// ConfigDir is where terraform providers are installed, defaults to the home directory
+	ConfigDir     string
+	RemoteOptions remote.Options
+
+	// Filter is a JMESPath expression selecting resources to analyze
+	Filter string
+	// DriftIgnorePaths are ignore files to read, .driftignore is read when empty
+	DriftIgnorePaths []string
+	StrictMode       bool
+	ContinueOnError  bool
+	// SupplierTimeout bounds the time spent enumerating each resource type, there is no limit when zero.
+	// Use a context with a deadline to bound the whole scan.
+	SupplierTimeout time.Duration
+
+	// IaCSupplier and RemoteSupplier replace resources read from From and To
+	IaCSupplier    resource.Supplier
+	RemoteSupplier resource.Supplier
+
+	// Baseline is a previous analysis drift is compared to, see analyser.Analysis.CompareToBaseline
+	Baseline *analyser.Analysis
+
+	// Outputs are written once the analysis is done
+	Outputs []output.Output
+
+	// Progress of the scan, nothing is rendered by default
+	IaCProgress  globaloutput.Progress
+	ScanProgress globaloutput.Progress
+
+	// SchemaRepository is filled with schemas of activated remotes, a new one is used by default
+	SchemaRepository *resource.SchemaRepository
+}
+
+// Scan compares resources from IaC sources with resources found on remotes.
+// Cancelling ctx stops the scan and cleans up providers, ctx's error is then returned.
+func Scan(ctx context.Context, opts Options) (*analyser.Analysis, error) {
+	session, err := NewSession(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+
+	return session.Scan(ctx)
+}
+
+// Session runs several scans with the same options, remotes are activated once
+// and their providers are reused by every scan until the session is closed.
+type Session struct {
+	opts            Options
+	ctlOptions      *pkg.ScanOptions
+	providerLibrary *terraform.ProviderLibrary
+	resFactory      resource.ResourceFactory
+	recorder        *stats.Recorder
+	remotes         []remote.Remote
+	// Only one scan runs at a time as they share the same providers and stats recorder
+	lock sync.Mutex
+}
+
+// NewSession activates remotes to scan, ctx bounds the lifetime of their providers.
+// The session must be closed to clean up providers.
+func NewSession(ctx context.Context, opts Options) (*Session, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	opts, err := withDefaults(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctlOptions := &pkg.ScanOptions{
+		FilterExpression: opts.Filter,
+		StrictMode:       opts.StrictMode,
+		DriftIgnorePaths: opts.DriftIgnorePaths,
+	}
+	if opts.Filter != "" {
+		expr, err := filter.BuildExpression(opts.Filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to parse filter expression")
+		}
+		ctlOptions.Filter = expr
+	}
+
+	s := &Session{
+		opts:            opts,
+		ctlOptions:      ctlOptions,
+		providerLibrary: terraform.NewProviderLibrary(),
+		resFactory:      terraform.NewTerraformResourceFactory(opts.SchemaRepository),
+		recorder:        stats.NewRecorder(),
+	}
+
+	// Every remote shares the same libraries, so a single analysis covers all of them
+	for _, to := range opts.To {
+		if err := ctx.Err(); err != nil {
+			s.Close()
+			return nil, err
+		}
+		r, err := remote.New(ctx, to, opts.ProviderVersion, s.providerLibrary, opts.ScanProgress, opts.SchemaRepository, opts.ConfigDir, opts.RemoteOptions, s.recorder)
+		if err != nil {
+			s.Close()
+			return nil, err
+		}
+		s.remotes = append(s.remotes, r)
+	}
+
+	return s, nil
+}
+
+// Scan compares resources from IaC sources with resources found on remotes, IaC sources are read again on each scan.
+// Cancelling ctx stops the scan and ctx's error is then returned, the session can still be used afterwards.
+func (s *Session) Scan(ctx context.Context) (*analyser.Analysis, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	opts := s.opts
+	s.recorder.Reset()
+
+	// Suppliers can only be used once, so they are created again for each scan
+	alerter := alerter.NewAlerter()
+	supplierLibrary := resource.NewSupplierLibrary()
+	for _, r := range s.remotes {
+		if err := r.AddSuppliers(alerter, supplierLibrary, s.resFactory); err != nil {
+			return nil, err
+		}
+	}
+
+	// Ignore files are read again on each scan, and rules used by the type filter are tracked along with the analysis
+	ctlOptions := *s.ctlOptions
+	ctlOptions.DriftIgnore = filter.NewDriftIgnore(opts.DriftIgnorePaths...)
+
+	remoteSupplier := opts.RemoteSupplier
+	if remoteSupplier == nil {
+		// Only enumerate resource types that could survive the filter and the .driftignore
+		typeFilter := filter.ChainTypeFilter{ctlOptions.DriftIgnore}
+		if opts.Filter != "" {
+			typeFilter = append(typeFilter, filter.NewExpressionTypeFilter(opts.Filter))
+		}
+		scannedTypes := filter.KeptTypes(typeFilter, resource.GetSupportedTypes())
+		// Suppliers of every remote share the same runner, the most permissive concurrency and retries are used
+		var concurrency int64
+		var retryOptions retry.Options
+		for i, to := range opts.To {
+			if c := opts.RemoteOptions.Concurrency(to); c > concurrency {
+				concurrency = c
+			}
+			if r := opts.RemoteOptions.Retry(to); i == 0 || r.MaxRetries > retryOptions.MaxRetries {
+				retryOptions = r
+			}
+		}
+		remoteSupplier = pkg.NewScanner(supplierLibrary.SuppliersFor(scannedTypes), alerter, pkg.ScannerOptions{
+			ContinueOnError: opts.ContinueOnError,
+			Concurrency:     concurrency,
+			Retry:           retryOptions,
+			Stats:           s.recorder,
+			SupplierTimeout: opts.SupplierTimeout,
+		})
+	}
+
+	iacSupplier := opts.IaCSupplier
+	if iacSupplier == nil {
+		var err error
+		iacSupplier, err = supplier.GetIACSupplier(opts.From, s.providerLibrary, opts.BackendOptions, opts.IaCProgress, s.resFactory)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctl := pkg.NewDriftCTL(remoteSupplier, iacSupplier, alerter, s.resFactory, &ctlOptions, opts.ScanProgress, opts.IaCProgress, opts.SchemaRepository)
+
+	analysis, err := ctl.Run(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+	analysis.SetStats(s.recorder.Report())
+	if opts.Baseline != nil {
+		analysis.CompareToBaseline(opts.Baseline)
+	}
+
+	for _, out := range opts.Outputs {
+		if err := out.Write(analysis); err != nil {
+			return nil, err
+		}
+	}
+
+	return analysis, nil
+}
+
+// Close cleans up providers of the session
+func (s *Session) Close() {
+	logrus.Trace("Cleaning up providers")
+	s.providerLibrary.Cleanup()
+}
+
+func withDefaults(opts Options) (Options, error) {
+	if opts.IaCSupplier == nil && len(opts.From) == 0 {
+		return opts, errors.New("no IaC source to scan")
+	}
+	if len(opts.To) == 0 && opts.RemoteSupplier == nil {
+		opts.To = []string{aws.RemoteAWSTerraform}
+	}
+	for _, to := range opts.To {
+		if !remote.IsSupported(to) {
+			return opts, errors.Errorf("unsupported cloud provider '%s'", to)
+		}
+	}
+	if opts.BackendOptions == nil {
+		opts.BackendOptions = &backend.Options{}
+	}
+	if opts.ConfigDir == "" {
+		configDir, err := homedir.Dir()
+		if err != nil {
+			configDir = os.TempDir()
+		}
+		opts.ConfigDir = configDir
+	}
+	if opts.IaCProgress == nil {
+		opts.IaCProgress = &globaloutput.VoidProgress{}
+	}
+	if opts.ScanProgress == nil {
+		opts.ScanProgress = &globaloutput.VoidProgress{}
+	}
+	if opts.SchemaRepository == nil {
+		opts.SchemaRepository = resource.NewSchemaRepository()
+	}
+	return opts, nil
+}
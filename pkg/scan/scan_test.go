@@ -0,0 +1,139 @@
+package scan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/cloudskiff/driftctl/pkg/analyser"
+	"github.com/cloudskiff/driftctl/pkg/cmd/scan/output"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	testresource "github.com/cloudskiff/driftctl/test/resource"
+)
+
+type fakeOutput struct {
+	written []*analyser.Analysis
+}
+
+func (o *fakeOutput) Write(a *analyser.Analysis) error {
+	o.written = append(o.written, a)
+	return nil
+}
+
+func TestScan_InjectedSuppliers(t *testing.T) {
+	iacSupplier := &resource.MockSupplier{}
+	iacSupplier.On("Resources", mock.Anything).Return([]resource.Resource{
+		&testresource.FakeResource{Id: "managed", Type: "aws_fake"},
+	}, nil)
+	remoteSupplier := &resource.MockSupplier{}
+	remoteSupplier.On("Resources", mock.Anything).Return([]resource.Resource{
+		&testresource.FakeResource{Id: "managed", Type: "aws_fake"},
+		&testresource.FakeResource{Id: "unmanaged", Type: "aws_fake"},
+	}, nil)
+	out := &fakeOutput{}
+
+	got, err := Scan(context.Background(), Options{
+		IaCSupplier:    iacSupplier,
+		RemoteSupplier: remoteSupplier,
+		Outputs:        []output.Output{out},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 1, got.Summary().TotalManaged)
+	assert.Equal(t, 1, got.Summary().TotalUnmanaged)
+	assert.Equal(t, []*analyser.Analysis{got}, out.written)
+	assert.NotNil(t, got.Stats())
+}
+
+func TestSession_ScanTwice(t *testing.T) {
+	iacSupplier := &resource.MockSupplier{}
+	iacSupplier.On("Resources", mock.Anything).Return([]resource.Resource{
+		&testresource.FakeResource{Id: "managed", Type: "aws_fake"},
+	}, nil)
+	remoteSupplier := &resource.MockSupplier{}
+	remoteSupplier.On("Resources", mock.Anything).Return([]resource.Resource{
+		&testresource.FakeResource{Id: "managed", Type: "aws_fake"},
+	}, nil).Once()
+	remoteSupplier.On("Resources", mock.Anything).Return([]resource.Resource{
+		&testresource.FakeResource{Id: "managed", Type: "aws_fake"},
+		&testresource.FakeResource{Id: "unmanaged", Type: "aws_fake"},
+	}, nil).Once()
+	out := &fakeOutput{}
+
+	session, err := NewSession(context.Background(), Options{
+		IaCSupplier:    iacSupplier,
+		RemoteSupplier: remoteSupplier,
+		Outputs:        []output.Output{out},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer session.Close()
+
+	first, err := session.Scan(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := session.Scan(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.True(t, first.IsSync())
+	assert.Equal(t, 1, second.Summary().TotalUnmanaged)
+	assert.Equal(t, []*analyser.Analysis{first, second}, out.written)
+	remoteSupplier.AssertExpectations(t)
+}
+
+func TestScan_CancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	got, err := Scan(ctx, Options{
+		IaCSupplier:    &resource.MockSupplier{},
+		RemoteSupplier: &resource.MockSupplier{},
+	})
+	assert.Nil(t, got)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestScan_InvalidOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    Options
+		wantErr string
+	}{
+		{
+			name:    "no IaC source",
+			opts:    Options{RemoteSupplier: &resource.MockSupplier{}},
+			wantErr: "no IaC source to scan",
+		},
+		{
+			name: "unsupported remote",
+			opts: Options{
+				IaCSupplier: &resource.MockSupplier{},
+				To:          []string{"foo+tf"},
+			},
+			wantErr: "unsupported cloud provider 'foo+tf'",
+		},
+		{
+			name: "invalid filter",
+			opts: Options{
+				IaCSupplier:    &resource.MockSupplier{},
+				RemoteSupplier: &resource.MockSupplier{},
+				Filter:         "Type ==",
+			},
+			wantErr: "unable to parse filter expression: SyntaxError: Invalid token: tRbracket",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Scan(context.Background(), tt.opts)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -2,39 +2,88 @@ package pkg
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/cloudskiff/driftctl/pkg/alerter"
+	"github.com/cloudskiff/driftctl/pkg/analyser"
 	"github.com/cloudskiff/driftctl/pkg/parallel"
 	"github.com/cloudskiff/driftctl/pkg/remote"
+	"github.com/cloudskiff/driftctl/pkg/remote/retry"
 	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/stats"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
+type ScannerOptions struct {
+	// ContinueOnError keeps scanning when a supplier fails, resources of its types are then ignored
+	ContinueOnError bool
+	// Concurrency is the maximum number of suppliers enumerating resources at the same time
+	Concurrency int64
+	// Retry is used to retry suppliers failing with a throttling error
+	Retry retry.Options
+	// Stats records how long each supplier took, it may be nil
+	Stats *stats.Recorder
+	// SupplierTimeout is the deadline of each supplier, retries included. There is none when zero.
+	SupplierTimeout time.Duration
+}
+
 type Scanner struct {
 	resourceSuppliers []resource.Supplier
-	runner            *parallel.ParallelRunner
 	alerter           *alerter.Alerter
+	options           ScannerOptions
 }
 
-func NewScanner(resourceSuppliers []resource.Supplier, alerter *alerter.Alerter) *Scanner {
+func NewScanner(resourceSuppliers []resource.Supplier, alerter *alerter.Alerter, options ScannerOptions) *Scanner {
+	if options.Concurrency <= 0 {
+		options.Concurrency = remote.DefaultConcurrency
+	}
 	return &Scanner{
 		resourceSuppliers: resourceSuppliers,
-		runner:            parallel.NewParallelRunner(context.TODO(), 10),
 		alerter:           alerter,
+		options:           options,
 	}
 }
 
-func (s *Scanner) Resources() ([]resource.Resource, error) {
+func (s *Scanner) Resources(ctx context.Context) ([]resource.Resource, error) {
+	runner := parallel.NewParallelRunner(ctx, s.options.Concurrency)
 	for _, resourceProvider := range s.resourceSuppliers {
 		supplier := resourceProvider
-		s.runner.Run(func() (interface{}, error) {
-			res, err := supplier.Resources()
+		runner.Run(func() (interface{}, error) {
+			var res []resource.Resource
+			start := time.Now()
+			attempts := 0
+			supplierCtx := ctx
+			if s.options.SupplierTimeout > 0 {
+				var cancel context.CancelFunc
+				supplierCtx, cancel = context.WithTimeout(ctx, s.options.SupplierTimeout)
+				defer cancel()
+			}
+			// Only throttling errors are worth retrying, others would fail again
+			err := s.options.Retry.NewRetrier(0).RunCtx(supplierCtx, func(ctx context.Context) error {
+				attempts++
+				var err error
+				res, err = supplier.Resources(ctx)
+				return err
+			})
+			s.recordStats(supplier, time.Since(start), len(res), attempts-1)
 			if err != nil {
+				// The whole scan is interrupted, there is no point in handling the error
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				if supplierCtx.Err() != nil {
+					err = errors.Errorf("supplier %s timed out after %s", describeSupplier(supplier), s.options.SupplierTimeout)
+				}
 				err := remote.HandleResourceEnumerationError(err, s.alerter)
 				if err == nil {
 					return []resource.Resource{}, nil
 				}
+				if s.options.ContinueOnError && s.skipFailedSupplier(supplier, err) {
+					return []resource.Resource{}, nil
+				}
 				return nil, err
 			}
 			for _, resource := range res {
@@ -51,19 +100,52 @@ func (s *Scanner) Resources() ([]resource.Resource, error) {
 loop:
 	for {
 		select {
-		case resources, ok := <-s.runner.Read():
+		case resources, ok := <-runner.Read():
 			if !ok || resources == nil {
 				break loop
 			}
 			results = append(results, resources.([]resource.Resource)...)
-		case <-s.runner.DoneChan():
+		case <-runner.DoneChan():
 			break loop
 		}
 	}
-	return results, s.runner.Err()
+	return results, runner.Err()
 }
 
-func (s *Scanner) Stop() {
-	logrus.Debug("Stopping scanner")
-	s.runner.Stop(errors.New("interrupted"))
+// skipFailedSupplier ignores types of a failed supplier, it returns false when
+// those types are unknown as we would not be able to avoid false positives
+func (s *Scanner) skipFailedSupplier(supplier resource.Supplier, err error) bool {
+	typedSupplier, ok := supplier.(resource.TypedSupplier)
+	if !ok || len(typedSupplier.Types()) == 0 {
+		return false
+	}
+	for _, ty := range typedSupplier.Types() {
+		logrus.WithFields(logrus.Fields{
+			"type":  ty,
+			"error": err,
+		}).Warn("Unable to enumerate resources, they will be ignored")
+		s.alerter.SendAlert(ty.String(), analyser.NewScanIncompleteAlert(ty.String(), resource.MetaOfSupplier(supplier), err))
+	}
+	return true
+}
+
+func (s *Scanner) recordStats(supplier resource.Supplier, duration time.Duration, resources, retries int) {
+	var types []resource.ResourceType
+	if typedSupplier, ok := supplier.(resource.TypedSupplier); ok {
+		types = typedSupplier.Types()
+	}
+	name := strings.TrimPrefix(fmt.Sprintf("%T", supplier), "*")
+	s.options.Stats.RecordSupplier(name, types, resource.MetaOfSupplier(supplier), duration, resources, retries)
+}
+
+// describeSupplier names a supplier after the types it returns when they are known
+func describeSupplier(supplier resource.Supplier) string {
+	if typedSupplier, ok := supplier.(resource.TypedSupplier); ok && len(typedSupplier.Types()) > 0 {
+		types := make([]string, 0, len(typedSupplier.Types()))
+		for _, ty := range typedSupplier.Types() {
+			types = append(types, ty.String())
+		}
+		return strings.Join(types, ",")
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", supplier), "*")
 }
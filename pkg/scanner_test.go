@@ -0,0 +1,176 @@
+package pkg
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/cloudskiff/driftctl/pkg/alerter"
+	"github.com/cloudskiff/driftctl/pkg/analyser"
+	"github.com/cloudskiff/driftctl/pkg/parallel"
+	"github.com/cloudskiff/driftctl/pkg/remote/retry"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+	"github.com/cloudskiff/driftctl/pkg/terraform"
+)
+
+func TestScanner_Resources(t *testing.T) {
+	bucket := &resource.AbstractResource{Id: "bucket", Type: "aws_s3_bucket"}
+
+	tests := []struct {
+		name            string
+		continueOnError bool
+		typed           bool
+		want            []resource.Resource
+		wantErr         string
+		wantAlerts      alerter.Alerts
+	}{
+		{
+			name:    "stop on first error",
+			typed:   true,
+			wantErr: "throttled",
+		},
+		{
+			name:            "continue on error",
+			continueOnError: true,
+			typed:           true,
+			want:            []resource.Resource{bucket},
+			wantAlerts: alerter.Alerts{
+				"aws_iam_role": []alerter.Alert{
+					analyser.NewScanIncompleteAlert("aws_iam_role", resource.Meta{}, errors.New("throttled")),
+				},
+				"aws_iam_role_policy": []alerter.Alert{
+					analyser.NewScanIncompleteAlert("aws_iam_role_policy", resource.Meta{}, errors.New("throttled")),
+				},
+			},
+		},
+		{
+			name:            "continue on error with unknown types",
+			continueOnError: true,
+			wantErr:         "throttled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			working := &resource.MockSupplier{}
+			working.On("Resources", mock.Anything).Return([]resource.Resource{bucket}, nil)
+			failing := &resource.MockSupplier{}
+			failing.On("Resources", mock.Anything).Return(nil, errors.New("throttled"))
+
+			library := resource.NewSupplierLibrary()
+			library.AddSupplier(working, "aws_s3_bucket")
+			if tt.typed {
+				library.AddSupplier(failing, "aws_iam_role", "aws_iam_role_policy")
+			} else {
+				library.AddSupplier(failing)
+			}
+
+			alr := alerter.NewAlerter()
+			scanner := NewScanner(
+				library.SuppliersFor([]resource.ResourceType{"aws_s3_bucket", "aws_iam_role"}),
+				alr,
+				ScannerOptions{ContinueOnError: tt.continueOnError},
+			)
+			got, err := scanner.Resources(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantAlerts, alr.Retrieve())
+		})
+	}
+}
+
+func TestScanner_SupplierTimeout(t *testing.T) {
+	slow := &resource.MockSupplier{}
+	slow.On("Resources", mock.Anything).Run(func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil, context.DeadlineExceeded)
+
+	library := resource.NewSupplierLibrary()
+	library.AddSupplier(slow, "aws_iam_role")
+
+	alr := alerter.NewAlerter()
+	scanner := NewScanner(library.SuppliersFor([]resource.ResourceType{"aws_iam_role"}), alr, ScannerOptions{
+		ContinueOnError: true,
+		SupplierTimeout: 10 * time.Millisecond,
+	})
+	got, err := scanner.Resources(context.Background())
+	assert.Nil(t, err)
+	assert.Empty(t, got)
+	alerts := alr.Retrieve()
+	assert.Len(t, alerts, 1)
+	assert.Len(t, alerts["aws_iam_role"], 1)
+	assert.Equal(t, "Ignoring aws_iam_role from drift calculation: Scan incomplete: supplier aws_iam_role timed out after 10ms", alerts["aws_iam_role"][0].Message())
+}
+
+func TestScanner_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	blocking := &resource.MockSupplier{}
+	blocking.On("Resources", mock.Anything).Run(func(args mock.Arguments) {
+		cancel()
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil, context.Canceled)
+
+	scanner := NewScanner([]resource.Supplier{blocking}, alerter.NewAlerter(), ScannerOptions{ContinueOnError: true})
+	_, err := scanner.Resources(ctx)
+	assert.Equal(t, context.Canceled, err)
+}
+
+// readingSupplier reads a bucket with a reader created along with the supplier, like suppliers of remotes do
+type readingSupplier struct {
+	reader *terraform.ParallelResourceReader
+	reads  int
+}
+
+func (s *readingSupplier) Resources(ctx context.Context) ([]resource.Resource, error) {
+	s.reads++
+	failing := s.reads == 1
+	s.reader.Run(func() (cty.Value, error) {
+		if failing {
+			return cty.NilVal, errors.New("Rate exceeded")
+		}
+		return cty.StringVal("bucket"), nil
+	})
+	values, err := s.reader.Wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]resource.Resource, 0, len(values))
+	for _, value := range values {
+		resources = append(resources, &resource.AbstractResource{Id: value.AsString(), Type: "aws_s3_bucket"})
+	}
+	return resources, nil
+}
+
+func TestScanner_RetryFailedRead(t *testing.T) {
+	supplier := &readingSupplier{
+		reader: terraform.NewParallelResourceReader(parallel.NewParallelRunner(context.Background(), 10)),
+	}
+
+	scanner := NewScanner([]resource.Supplier{supplier}, alerter.NewAlerter(), ScannerOptions{
+		Retry: retry.Options{MaxRetries: 2, MinBackoff: time.Millisecond},
+	})
+	got, err := scanner.Resources(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []resource.Resource{&resource.AbstractResource{Id: "bucket", Type: "aws_s3_bucket"}}, got)
+	assert.Equal(t, 2, supplier.reads)
+}
+
+func TestScanner_NoRetryOfRetriedRead(t *testing.T) {
+	failing := &resource.MockSupplier{}
+	failing.On("Resources", mock.Anything).Return(nil, retry.Retried(errors.New("Rate exceeded"))).Once()
+
+	scanner := NewScanner([]resource.Supplier{failing}, alerter.NewAlerter(), ScannerOptions{
+		Retry: retry.Options{MaxRetries: 2, MinBackoff: time.Millisecond},
+	})
+	_, err := scanner.Resources(context.Background())
+	assert.EqualError(t, err, "Rate exceeded")
+	failing.AssertExpectations(t)
+}
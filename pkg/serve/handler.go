@@ -0,0 +1,135 @@
+package serve
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ValidateFunc rejects a job request before it is queued
+type ValidateFunc func(req JobRequest) error
+
+type handler struct {
+	queue    *Queue
+	validate ValidateFunc
+}
+
+// NewHandler serves the job API:
+//   - POST /jobs queues a job and GET /jobs lists jobs
+//   - GET /jobs/{id} returns the status of a job and DELETE /jobs/{id} cancels it
+//   - GET /jobs/{id}/analysis returns the analysis of a done job in the JSON output format
+func NewHandler(queue *Queue, validate ValidateFunc) http.Handler {
+	h := &handler{queue: queue, validate: validate}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/jobs", h.serveJobs)
+	mux.HandleFunc("/jobs/", h.serveJob)
+	return mux
+}
+
+// RequireToken only lets requests through when they carry the given token as "Authorization: Bearer <token>"
+func RequireToken(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization := r.Header.Get("Authorization")
+		given := strings.TrimPrefix(authorization, "Bearer ")
+		if given == authorization || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			writeError(w, http.StatusUnauthorized, "missing or invalid token")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func (h *handler) serveJobs(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, h.queue.List())
+	case http.MethodPost:
+		req := JobRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid job request: "+err.Error())
+			return
+		}
+		if h.validate != nil {
+			if err := h.validate(req); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		job, err := h.queue.Submit(req)
+		if err == ErrQueueFull {
+			writeError(w, http.StatusServiceUnavailable, err.Error())
+			return
+		}
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeJSON(w, http.StatusAccepted, job)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (h *handler) serveJob(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/jobs/"), "/")
+	id := parts[0]
+
+	switch {
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		job, err := h.queue.Get(id)
+		if err != nil {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSON(w, http.StatusOK, job)
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		job, err := h.queue.Cancel(id)
+		if err == ErrJobNotFound {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		if err == ErrJobFinished {
+			writeError(w, http.StatusConflict, err.Error())
+			return
+		}
+		writeJSON(w, http.StatusAccepted, job)
+	case len(parts) == 2 && parts[1] == "analysis" && r.Method == http.MethodGet:
+		job, err := h.queue.Get(id)
+		if err != nil {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		if job.Status != JobDone {
+			writeError(w, http.StatusConflict, "job is "+string(job.Status))
+			return
+		}
+		writeJSON(w, http.StatusOK, job.Analysis)
+	case len(parts) == 1 || (len(parts) == 2 && parts[1] == "analysis"):
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, errorResponse{Error: message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	content, err := json.MarshalIndent(value, "", "\t")
+	if err != nil {
+		logrus.WithField("err", err).Debug("Unable to serialize response")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(content)
+}
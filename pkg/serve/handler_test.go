@@ -0,0 +1,117 @@
+package serve
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/pkg/analyser"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+func request(t *testing.T, handler http.Handler, method, path, body string) (int, string) {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(method, path, strings.NewReader(body)))
+	return rec.Code, rec.Body.String()
+}
+
+func TestHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	started := make(chan JobRequest, 1)
+	release := make(chan error)
+	q := NewQueue(ctx, func(ctx context.Context, req JobRequest) (*analyser.Analysis, error) {
+		started <- req
+		<-release
+		analysis := &analyser.Analysis{}
+		analysis.AddUnmanaged(&resource.AbstractResource{Id: "bucket", Type: "aws_s3_bucket"})
+		return analysis, nil
+	}, 1, 10)
+	go q.Run(1)
+
+	handler := NewHandler(q, func(req JobRequest) error {
+		if len(req.From) == 0 {
+			return errors.New("no IaC source to scan")
+		}
+		return nil
+	})
+
+	code, body := request(t, handler, http.MethodPost, "/jobs", "{")
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, body, "invalid job request")
+
+	code, body = request(t, handler, http.MethodPost, "/jobs", `{"to": ["aws+tf"]}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.JSONEq(t, `{"error": "no IaC source to scan"}`, body)
+
+	code, body = request(t, handler, http.MethodPost, "/jobs", `{"from": ["tfstate://terraform.tfstate"], "filter": "Type=='aws_s3_bucket'"}`)
+	assert.Equal(t, http.StatusAccepted, code)
+	job := Job{}
+	assert.Nil(t, json.Unmarshal([]byte(body), &job))
+	assert.Equal(t, JobRequest{From: []string{"tfstate://terraform.tfstate"}, Filter: "Type=='aws_s3_bucket'"}, job.Request)
+	<-started
+
+	code, body = request(t, handler, http.MethodGet, "/jobs/"+job.ID+"/analysis", "")
+	assert.Equal(t, http.StatusConflict, code)
+	assert.JSONEq(t, `{"error": "job is running"}`, body)
+
+	release <- nil
+	waitForStatus(t, q, job.ID, JobDone)
+
+	code, body = request(t, handler, http.MethodGet, "/jobs/"+job.ID, "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, `"status": "done"`)
+
+	code, body = request(t, handler, http.MethodGet, "/jobs/"+job.ID+"/analysis", "")
+	assert.Equal(t, http.StatusOK, code)
+	analysis := &analyser.Analysis{}
+	assert.Nil(t, json.Unmarshal([]byte(body), analysis))
+	assert.Equal(t, 1, analysis.Summary().TotalUnmanaged)
+
+	code, body = request(t, handler, http.MethodGet, "/jobs", "")
+	assert.Equal(t, http.StatusOK, code)
+	var jobs []Job
+	assert.Nil(t, json.Unmarshal([]byte(body), &jobs))
+	assert.Len(t, jobs, 1)
+
+	code, _ = request(t, handler, http.MethodDelete, "/jobs/"+job.ID, "")
+	assert.Equal(t, http.StatusConflict, code)
+	code, _ = request(t, handler, http.MethodDelete, "/jobs/unknown", "")
+	assert.Equal(t, http.StatusNotFound, code)
+	code, _ = request(t, handler, http.MethodPut, "/jobs", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+	code, _ = request(t, handler, http.MethodGet, "/jobs/"+job.ID+"/unknown", "")
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestRequireToken(t *testing.T) {
+	handler := RequireToken("s3cr3t", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	tests := []struct {
+		authorization string
+		want          int
+	}{
+		{authorization: "", want: http.StatusUnauthorized},
+		{authorization: "s3cr3t", want: http.StatusUnauthorized},
+		{authorization: "Bearer wrong", want: http.StatusUnauthorized},
+		{authorization: "Bearer s3cr3t", want: http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/jobs", nil)
+		if tt.authorization != "" {
+			req.Header.Set("Authorization", tt.authorization)
+		}
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, tt.want, rec.Code, tt.authorization)
+	}
+}
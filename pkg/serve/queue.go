@@ -0,0 +1,282 @@
+package serve
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/cloudskiff/driftctl/pkg/analyser"
+)
+
+type JobStatus string
+
+const (
+	JobQueued    JobStatus = "queued"
+	JobRunning   JobStatus = "running"
+	JobDone      JobStatus = "done"
+	JobFailed    JobStatus = "failed"
+	JobCancelled JobStatus = "cancelled"
+)
+
+// IsFinished returns true when the job will not change anymore
+func (s JobStatus) IsFinished() bool {
+	return s == JobDone || s == JobFailed || s == JobCancelled
+}
+
+var (
+	ErrQueueFull   = errors.New("job queue is full")
+	ErrJobNotFound = errors.New("job not found")
+	ErrJobFinished = errors.New("job is already finished")
+)
+
+// JobRequest describes what a job scans
+type JobRequest struct {
+	From   []string `json:"from"`
+	To     []string `json:"to,omitempty"`
+	Filter string   `json:"filter,omitempty"`
+}
+
+// ScanFunc runs the scan of a job, it must stop when ctx is cancelled
+type ScanFunc func(ctx context.Context, req JobRequest) (*analyser.Analysis, error)
+
+type job struct {
+	id         string
+	request    JobRequest
+	status     JobStatus
+	err        error
+	analysis   *analyser.Analysis
+	createdAt  time.Time
+	startedAt  time.Time
+	finishedAt time.Time
+	ctx        context.Context
+	cancel     context.CancelFunc
+}
+
+// Job is a snapshot of a job
+type Job struct {
+	ID         string     `json:"id"`
+	Request    JobRequest `json:"request"`
+	Status     JobStatus  `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// Analysis is only set once the job is done
+	Analysis *analyser.Analysis `json:"-"`
+}
+
+func (j *job) snapshot() Job {
+	s := Job{
+		ID:        j.id,
+		Request:   j.request,
+		Status:    j.status,
+		CreatedAt: j.createdAt,
+		Analysis:  j.analysis,
+	}
+	if j.err != nil {
+		s.Error = j.err.Error()
+	}
+	if !j.startedAt.IsZero() {
+		startedAt := j.startedAt
+		s.StartedAt = &startedAt
+	}
+	if !j.finishedAt.IsZero() {
+		finishedAt := j.finishedAt
+		s.FinishedAt = &finishedAt
+	}
+	return s
+}
+
+// Queue runs scan jobs in the order they were submitted.
+// At most size jobs wait to be run, further submissions are rejected until a job starts.
+// Only the last retained finished jobs are kept, older ones are forgotten along with their analysis.
+type Queue struct {
+	ctx      context.Context
+	scan     ScanFunc
+	pending  chan *job
+	retained int
+
+	lock sync.Mutex
+	jobs map[string]*job
+	// Ids of jobs in submission order
+	order []string
+}
+
+// NewQueue creates a queue of the given size keeping retained finished jobs, cancelling ctx cancels every job
+func NewQueue(ctx context.Context, scan ScanFunc, size, retained int) *Queue {
+	return &Queue{
+		ctx:      ctx,
+		scan:     scan,
+		pending:  make(chan *job, size),
+		retained: retained,
+		jobs:     make(map[string]*job),
+	}
+}
+
+// Run runs queued jobs with the given number of workers until the queue context is cancelled
+func (q *Queue) Run(workers int) {
+	wg := sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-q.ctx.Done():
+					return
+				case j := <-q.pending:
+					q.run(j)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Submit queues a new job, ErrQueueFull is returned when too many jobs are waiting
+func (q *Queue) Submit(req JobRequest) (Job, error) {
+	id, err := newJobId()
+	if err != nil {
+		return Job{}, err
+	}
+	ctx, cancel := context.WithCancel(q.ctx)
+	j := &job{
+		id:        id,
+		request:   req,
+		status:    JobQueued,
+		createdAt: time.Now(),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	select {
+	case q.pending <- j:
+	default:
+		cancel()
+		return Job{}, ErrQueueFull
+	}
+	q.jobs[id] = j
+	q.order = append(q.order, id)
+	logrus.WithFields(logrus.Fields{"job": id, "from": req.From, "to": req.To}).Debug("Job queued")
+
+	return j.snapshot(), nil
+}
+
+// Get returns the job with the given id
+func (q *Queue) Get(id string) (Job, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	j, exist := q.jobs[id]
+	if !exist {
+		return Job{}, ErrJobNotFound
+	}
+	return j.snapshot(), nil
+}
+
+// List returns every job in submission order
+func (q *Queue) List() []Job {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	jobs := make([]Job, 0, len(q.order))
+	for _, id := range q.order {
+		jobs = append(jobs, q.jobs[id].snapshot())
+	}
+	return jobs
+}
+
+// Cancel stops a running job or prevents a queued one from running
+func (q *Queue) Cancel(id string) (Job, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	j, exist := q.jobs[id]
+	if !exist {
+		return Job{}, ErrJobNotFound
+	}
+	if j.status.IsFinished() {
+		return j.snapshot(), ErrJobFinished
+	}
+	j.cancel()
+	// A running job is marked as cancelled once its scan returned
+	if j.status == JobQueued {
+		j.status = JobCancelled
+		j.finishedAt = time.Now()
+		defer q.evict()
+	}
+	logrus.WithField("job", id).Debug("Job cancelled")
+	return j.snapshot(), nil
+}
+
+// evict forgets the oldest finished jobs beyond the retained number, the lock must be held
+func (q *Queue) evict() {
+	finished := 0
+	for _, id := range q.order {
+		if q.jobs[id].status.IsFinished() {
+			finished++
+		}
+	}
+	if finished <= q.retained {
+		return
+	}
+	order := make([]string, 0, len(q.order))
+	for _, id := range q.order {
+		if finished > q.retained && q.jobs[id].status.IsFinished() {
+			delete(q.jobs, id)
+			finished--
+			logrus.WithField("job", id).Debug("Job evicted")
+			continue
+		}
+		order = append(order, id)
+	}
+	q.order = order
+}
+
+func (q *Queue) run(j *job) {
+	q.lock.Lock()
+	if j.status != JobQueued {
+		q.lock.Unlock()
+		return
+	}
+	j.status = JobRunning
+	j.startedAt = time.Now()
+	q.lock.Unlock()
+
+	logrus.WithField("job", j.id).Debug("Job started")
+	analysis, err := q.scan(j.ctx, j.request)
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	defer j.cancel()
+	defer q.evict()
+
+	j.finishedAt = time.Now()
+	switch {
+	case j.ctx.Err() != nil:
+		j.status = JobCancelled
+	case err != nil:
+		j.status = JobFailed
+		j.err = err
+	default:
+		j.status = JobDone
+		j.analysis = analysis
+	}
+	logrus.WithFields(logrus.Fields{"job": j.id, "status": j.status}).Debug("Job finished")
+}
+
+func newJobId() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "unable to generate job id")
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,152 @@
+package serve
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/pkg/analyser"
+)
+
+// blockingScan returns a scan function that waits for a value on release before returning it
+func blockingScan(started chan<- JobRequest, release <-chan error) ScanFunc {
+	return func(ctx context.Context, req JobRequest) (*analyser.Analysis, error) {
+		started <- req
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case err := <-release:
+			if err != nil {
+				return nil, err
+			}
+			return &analyser.Analysis{}, nil
+		}
+	}
+}
+
+func waitForStatus(t *testing.T, q *Queue, id string, status JobStatus) Job {
+	for i := 0; i < 100; i++ {
+		job, err := q.Get(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.Status == status {
+			return job
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("job %s never reached status %s", id, status)
+	return Job{}
+}
+
+func TestQueue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	started := make(chan JobRequest)
+	release := make(chan error)
+	q := NewQueue(ctx, blockingScan(started, release), 2, 10)
+	done := make(chan struct{})
+	go func() {
+		q.Run(1)
+		close(done)
+	}()
+
+	first, err := q.Submit(JobRequest{From: []string{"tfstate://first.tfstate"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "tfstate://first.tfstate", (<-started).From[0])
+	waitForStatus(t, q, first.ID, JobRunning)
+
+	// The only worker is busy, so jobs wait until the queue is full
+	second, err := q.Submit(JobRequest{From: []string{"tfstate://second.tfstate"}})
+	assert.Nil(t, err)
+	third, err := q.Submit(JobRequest{From: []string{"tfstate://third.tfstate"}})
+	assert.Nil(t, err)
+	_, err = q.Submit(JobRequest{From: []string{"tfstate://fourth.tfstate"}})
+	assert.Equal(t, ErrQueueFull, err)
+
+	// A queued job is cancelled right away and never runs
+	got, err := q.Cancel(second.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, JobCancelled, got.Status)
+	_, err = q.Cancel(second.ID)
+	assert.Equal(t, ErrJobFinished, err)
+
+	release <- nil
+	got = waitForStatus(t, q, first.ID, JobDone)
+	assert.NotNil(t, got.Analysis)
+	assert.NotNil(t, got.FinishedAt)
+
+	assert.Equal(t, "tfstate://third.tfstate", (<-started).From[0])
+	release <- errors.New("unable to read state")
+	got = waitForStatus(t, q, third.ID, JobFailed)
+	assert.Equal(t, "unable to read state", got.Error)
+	assert.Nil(t, got.Analysis)
+
+	// A running job is cancelled through its context
+	fifth, err := q.Submit(JobRequest{From: []string{"tfstate://fifth.tfstate"}})
+	assert.Nil(t, err)
+	<-started
+	waitForStatus(t, q, fifth.ID, JobRunning)
+	_, err = q.Cancel(fifth.ID)
+	assert.Nil(t, err)
+	waitForStatus(t, q, fifth.ID, JobCancelled)
+
+	var ids []string
+	for _, job := range q.List() {
+		ids = append(ids, job.ID)
+	}
+	assert.Equal(t, []string{first.ID, second.ID, third.ID, fifth.ID}, ids)
+
+	_, err = q.Get("unknown")
+	assert.Equal(t, ErrJobNotFound, err)
+	_, err = q.Cancel("unknown")
+	assert.Equal(t, ErrJobNotFound, err)
+
+	cancel()
+	<-done
+}
+
+func TestQueue_Eviction(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	started := make(chan JobRequest)
+	release := make(chan error)
+	q := NewQueue(ctx, blockingScan(started, release), 5, 2)
+	done := make(chan struct{})
+	go func() {
+		q.Run(1)
+		close(done)
+	}()
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		job, err := q.Submit(JobRequest{From: []string{"tfstate://terraform.tfstate"}})
+		assert.Nil(t, err)
+		<-started
+		release <- nil
+		waitForStatus(t, q, job.ID, JobDone)
+		ids = append(ids, job.ID)
+	}
+
+	// Unfinished jobs are never evicted
+	running, err := q.Submit(JobRequest{From: []string{"tfstate://terraform.tfstate"}})
+	assert.Nil(t, err)
+	<-started
+	waitForStatus(t, q, running.ID, JobRunning)
+
+	var listed []string
+	for _, job := range q.List() {
+		listed = append(listed, job.ID)
+	}
+	assert.Equal(t, []string{ids[1], ids[2], running.ID}, listed)
+	_, err = q.Get(ids[0])
+	assert.Equal(t, ErrJobNotFound, err)
+
+	cancel()
+	<-done
+}
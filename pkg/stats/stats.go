@@ -0,0 +1,280 @@
+package stats
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudskiff/driftctl/pkg/remote/cache"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+// SupplierStats describes how resources of a supplier were enumerated
+type SupplierStats struct {
+	Name              string `json:"name"`
+	Account           string `json:"account,omitempty"`
+	Region            string `json:"region,omitempty"`
+	DurationMs        int64  `json:"duration_ms"`
+	Resources         int    `json:"resources"`
+	ReadResourceCalls int    `json:"read_resource_calls"`
+	Retries           int    `json:"retries"`
+}
+
+// RepositoryStats describes calls made to a cloud provider API, e.g. an AWS service.
+// DurationMs is the cumulated time spent in calls, they may have been made concurrently.
+type RepositoryStats struct {
+	Name        string  `json:"name"`
+	DurationMs  int64   `json:"duration_ms"`
+	APICalls    int     `json:"api_calls"`
+	Retries     int     `json:"retries"`
+	CacheHits   int     `json:"cache_hits"`
+	CacheMisses int     `json:"cache_misses"`
+	CacheHit    float64 `json:"cache_hit_rate"`
+}
+
+// Report holds statistics of a scan, slowest suppliers and repositories first
+type Report struct {
+	Suppliers    []SupplierStats   `json:"suppliers"`
+	Repositories []RepositoryStats `json:"repositories"`
+}
+
+type readKey struct {
+	meta resource.Meta
+	ty   string
+}
+
+type readStats struct {
+	calls   int
+	retries int
+}
+
+type supplierRecord struct {
+	types     []string
+	meta      resource.Meta
+	name      string
+	duration  time.Duration
+	resources int
+	retries   int
+}
+
+type repositoryRecord struct {
+	duration    time.Duration
+	calls       int
+	retries     int
+	cacheHits   int
+	cacheMisses int
+}
+
+// Recorder collects statistics while scanning, it is safe for concurrent use.
+// A nil Recorder does not record anything.
+type Recorder struct {
+	lock         sync.Mutex
+	suppliers    []supplierRecord
+	reads        map[readKey]*readStats
+	repositories map[string]*repositoryRecord
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{
+		reads:        make(map[readKey]*readStats),
+		repositories: make(map[string]*repositoryRecord),
+	}
+}
+
+// Reset forgets statistics recorded so far, so that a recorder shared by several scans reports each one on its own
+func (r *Recorder) Reset() {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.suppliers = nil
+	r.reads = make(map[readKey]*readStats)
+	r.repositories = make(map[string]*repositoryRecord)
+}
+
+// RecordSupplier records a call to a supplier. Resources read through the terraform provider
+// are attributed to the supplier using its types, so they are unknown for untyped suppliers.
+func (r *Recorder) RecordSupplier(name string, types []resource.ResourceType, meta resource.Meta, duration time.Duration, resources, retries int) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	typeNames := make([]string, 0, len(types))
+	for _, ty := range types {
+		typeNames = append(typeNames, ty.String())
+	}
+	if len(typeNames) > 0 {
+		name = strings.Join(typeNames, ",")
+	}
+	r.suppliers = append(r.suppliers, supplierRecord{
+		types:     typeNames,
+		meta:      meta,
+		name:      name,
+		duration:  duration,
+		resources: resources,
+		retries:   retries,
+	})
+}
+
+// RecordRead records a ReadResource call made for a resource of the given type and scope
+func (r *Recorder) RecordRead(meta resource.Meta, ty resource.ResourceType, retries int) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := readKey{meta, ty.String()}
+	read, exist := r.reads[key]
+	if !exist {
+		read = &readStats{}
+		r.reads[key] = read
+	}
+	read.calls++
+	read.retries += retries
+}
+
+// RecordAPICall records a call made to a cloud provider API by a repository
+func (r *Recorder) RecordAPICall(repository string, duration time.Duration, retries int) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	repo := r.repository(repository)
+	repo.calls++
+	repo.retries += retries
+	repo.duration += duration
+}
+
+func (r *Recorder) recordCacheLookup(repository string, hit bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	repo := r.repository(repository)
+	if hit {
+		repo.cacheHits++
+		return
+	}
+	repo.cacheMisses++
+}
+
+func (r *Recorder) repository(name string) *repositoryRecord {
+	repo, exist := r.repositories[name]
+	if !exist {
+		repo = &repositoryRecord{}
+		r.repositories[name] = repo
+	}
+	return repo
+}
+
+// Cache returns a cache counting hits and misses of the given repository
+func (r *Recorder) Cache(repository string, c cache.Cache) cache.Cache {
+	if r == nil {
+		return c
+	}
+	return &countingCache{c, r, repository}
+}
+
+// Transport returns a transport recording each request as an API call of the given repository
+func (r *Recorder) Transport(base http.RoundTripper, repository string) http.RoundTripper {
+	if r == nil {
+		return base
+	}
+	return &recordingTransport{base, r, repository}
+}
+
+func (r *Recorder) Report() *Report {
+	if r == nil {
+		return nil
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	report := &Report{
+		Suppliers:    make([]SupplierStats, 0, len(r.suppliers)),
+		Repositories: make([]RepositoryStats, 0, len(r.repositories)),
+	}
+
+	for _, supplier := range r.suppliers {
+		s := SupplierStats{
+			Name:       supplier.name,
+			Account:    supplier.meta.Account,
+			Region:     supplier.meta.Region,
+			DurationMs: supplier.duration.Milliseconds(),
+			Resources:  supplier.resources,
+			Retries:    supplier.retries,
+		}
+		for _, ty := range supplier.types {
+			if read, exist := r.reads[readKey{supplier.meta, ty}]; exist {
+				s.ReadResourceCalls += read.calls
+				s.Retries += read.retries
+			}
+		}
+		report.Suppliers = append(report.Suppliers, s)
+	}
+	sort.SliceStable(report.Suppliers, func(i, j int) bool {
+		a, b := report.Suppliers[i], report.Suppliers[j]
+		if a.DurationMs != b.DurationMs {
+			return a.DurationMs > b.DurationMs
+		}
+		return fmt.Sprintf("%s/%s/%s", a.Name, a.Account, a.Region) < fmt.Sprintf("%s/%s/%s", b.Name, b.Account, b.Region)
+	})
+
+	for name, repo := range r.repositories {
+		s := RepositoryStats{
+			Name:        name,
+			DurationMs:  repo.duration.Milliseconds(),
+			APICalls:    repo.calls,
+			Retries:     repo.retries,
+			CacheHits:   repo.cacheHits,
+			CacheMisses: repo.cacheMisses,
+		}
+		if lookups := repo.cacheHits + repo.cacheMisses; lookups > 0 {
+			s.CacheHit = float64(repo.cacheHits) / float64(lookups)
+		}
+		report.Repositories = append(report.Repositories, s)
+	}
+	sort.SliceStable(report.Repositories, func(i, j int) bool {
+		a, b := report.Repositories[i], report.Repositories[j]
+		if a.DurationMs != b.DurationMs {
+			return a.DurationMs > b.DurationMs
+		}
+		return a.Name < b.Name
+	})
+
+	return report
+}
+
+type countingCache struct {
+	cache.Cache
+	recorder   *Recorder
+	repository string
+}
+
+func (c *countingCache) Get(key string) interface{} {
+	v := c.Cache.Get(key)
+	c.recorder.recordCacheLookup(c.repository, v != nil)
+	return v
+}
+
+type recordingTransport struct {
+	base       http.RoundTripper
+	recorder   *Recorder
+	repository string
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	res, err := t.base.RoundTrip(req)
+	t.recorder.RecordAPICall(t.repository, time.Since(start), 0)
+	return res, err
+}
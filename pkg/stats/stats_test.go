@@ -0,0 +1,105 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/pkg/remote/cache"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+func TestRecorder_Report(t *testing.T) {
+	recorder := NewRecorder()
+	east := resource.Meta{Account: "123456789012", Region: "us-east-1"}
+	west := resource.Meta{Account: "123456789012", Region: "us-west-1"}
+
+	recorder.RecordSupplier("EC2InstanceSupplier", []resource.ResourceType{"aws_instance"}, east, 2*time.Second, 2, 0)
+	recorder.RecordSupplier("EC2InstanceSupplier", []resource.ResourceType{"aws_instance"}, west, time.Second, 1, 1)
+	recorder.RecordSupplier("FakeSupplier", nil, resource.Meta{}, time.Second, 0, 0)
+	recorder.RecordRead(east, "aws_instance", 0)
+	recorder.RecordRead(east, "aws_instance", 2)
+	recorder.RecordRead(west, "aws_instance", 0)
+
+	recorder.RecordAPICall("ec2", 300*time.Millisecond, 1)
+	recorder.RecordAPICall("ec2", 200*time.Millisecond, 0)
+	recorder.RecordAPICall("iam", 100*time.Millisecond, 0)
+
+	c := recorder.Cache("ec2", cache.New(10))
+	c.Put("key", "value")
+	c.Get("key")
+	c.Get("key")
+	c.Get("other")
+
+	assert.Equal(t, &Report{
+		Suppliers: []SupplierStats{
+			{
+				Name:              "aws_instance",
+				Account:           "123456789012",
+				Region:            "us-east-1",
+				DurationMs:        2000,
+				Resources:         2,
+				ReadResourceCalls: 2,
+				Retries:           2,
+			},
+			{
+				Name:       "FakeSupplier",
+				DurationMs: 1000,
+			},
+			{
+				Name:              "aws_instance",
+				Account:           "123456789012",
+				Region:            "us-west-1",
+				DurationMs:        1000,
+				Resources:         1,
+				ReadResourceCalls: 1,
+				Retries:           1,
+			},
+		},
+		Repositories: []RepositoryStats{
+			{
+				Name:        "ec2",
+				DurationMs:  500,
+				APICalls:    2,
+				Retries:     1,
+				CacheHits:   2,
+				CacheMisses: 1,
+				CacheHit:    2.0 / 3.0,
+			},
+			{
+				Name:       "iam",
+				DurationMs: 100,
+				APICalls:   1,
+			},
+		},
+	}, recorder.Report())
+}
+
+func TestRecorder_Reset(t *testing.T) {
+	recorder := NewRecorder()
+	recorder.RecordSupplier("FakeSupplier", nil, resource.Meta{}, time.Second, 1, 0)
+	recorder.RecordAPICall("ec2", time.Second, 0)
+
+	recorder.Reset()
+	recorder.RecordAPICall("iam", time.Second, 0)
+
+	assert.Equal(t, &Report{
+		Suppliers: []SupplierStats{},
+		Repositories: []RepositoryStats{
+			{Name: "iam", DurationMs: 1000, APICalls: 1},
+		},
+	}, recorder.Report())
+}
+
+func TestRecorder_Nil(t *testing.T) {
+	var recorder *Recorder
+	c := cache.New(1)
+
+	recorder.RecordSupplier("FakeSupplier", nil, resource.Meta{}, time.Second, 0, 0)
+	recorder.RecordRead(resource.Meta{}, "aws_instance", 0)
+	recorder.RecordAPICall("ec2", time.Second, 0)
+	recorder.Reset()
+	assert.Equal(t, c, recorder.Cache("ec2", c))
+	assert.Nil(t, recorder.Report())
+}
@@ -1,27 +1,37 @@
 package terraform
 
 import (
+	"context"
+	"sync"
+
 	"github.com/cloudskiff/driftctl/pkg/parallel"
 
 	"github.com/zclconf/go-cty/cty"
 )
 
+// ParallelResourceReader reads resources concurrently, it can be used again once Wait returned,
+// e.g. when the supplier it belongs to is retried
 type ParallelResourceReader struct {
+	parent *parallel.ParallelRunner
+	lock   sync.Mutex
 	runner *parallel.ParallelRunner
 }
 
 func NewParallelResourceReader(runner *parallel.ParallelRunner) *ParallelResourceReader {
 	return &ParallelResourceReader{
-		runner: runner,
+		parent: runner,
+		runner: runner.SubRunner(),
 	}
 }
 
-func (p *ParallelResourceReader) Wait() ([]cty.Value, error) {
+// Wait returns values read so far, reading stops with an error when ctx is done
+func (p *ParallelResourceReader) Wait(ctx context.Context) ([]cty.Value, error) {
+	runner := p.currentRunner()
 	results := make([]cty.Value, 0)
 Loop:
 	for {
 		select {
-		case res, ok := <-p.runner.Read():
+		case res, ok := <-runner.Read():
 			if !ok {
 				break Loop
 			}
@@ -29,15 +39,31 @@ Loop:
 			if !ctyVal.IsNull() {
 				results = append(results, ctyVal)
 			}
-		case <-p.runner.DoneChan():
+		case <-runner.DoneChan():
+			break Loop
+		case <-ctx.Done():
+			runner.Stop(ctx.Err())
 			break Loop
 		}
 	}
-	return results, p.runner.Err()
+	err := runner.Err()
+
+	// A stopped runner would return the same error again, resources read next use a new one
+	p.lock.Lock()
+	p.runner = p.parent.SubRunner()
+	p.lock.Unlock()
+
+	return results, err
 }
 
 func (p *ParallelResourceReader) Run(runnable func() (cty.Value, error)) {
-	p.runner.Run(func() (interface{}, error) {
+	p.currentRunner().Run(func() (interface{}, error) {
 		return runnable()
 	})
 }
+
+func (p *ParallelResourceReader) currentRunner() *parallel.ParallelRunner {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.runner
+}
@@ -61,7 +61,7 @@ func TestParallelResourceReader_Wait(t *testing.T) {
 				p.Run(fun)
 			}
 
-			got, err := p.Wait()
+			got, err := p.Wait(context.Background())
 			assert.Equal(tt.wantErr, err != nil)
 			if tt.want != nil {
 				changelog, err := diff.Diff(got, tt.want)
@@ -77,3 +77,19 @@ func TestParallelResourceReader_Wait(t *testing.T) {
 		})
 	}
 }
+
+func TestParallelResourceReader_WaitCancelled(t *testing.T) {
+	p := NewParallelResourceReader(parallel.NewParallelRunner(context.TODO(), 10))
+	ctx, cancel := context.WithCancel(context.Background())
+
+	block := make(chan struct{})
+	defer close(block)
+	p.Run(func() (cty.Value, error) {
+		<-block
+		return cty.BoolVal(true), nil
+	})
+	cancel()
+
+	_, err := p.Wait(ctx)
+	assert.Equal(t, context.Canceled, err)
+}
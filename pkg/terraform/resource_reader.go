@@ -1,6 +1,8 @@
 package terraform
 
 import (
+	"context"
+
 	"github.com/cloudskiff/driftctl/pkg/resource"
 
 	"github.com/zclconf/go-cty/cty"
@@ -11,6 +13,8 @@ type ResourceReader interface {
 }
 
 type ReadResourceArgs struct {
+	// Ctx cancels the read and its retries, the context of the provider is used when nil
+	Ctx        context.Context
 	Ty         resource.ResourceType
 	ID         string
 	Attributes map[string]string
@@ -0,0 +1,103 @@
+package watch
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudskiff/driftctl/pkg/analyser"
+)
+
+// gauge is a metric exposed in the Prometheus text format, labelled by resource type
+type gauge struct {
+	name  string
+	help  string
+	value func(summary analyser.Summary) float64
+}
+
+var typeGauges = []gauge{
+	{
+		name:  "driftctl_managed_resources",
+		help:  "Number of resources managed by IaC",
+		value: func(s analyser.Summary) float64 { return float64(s.TotalManaged) },
+	},
+	{
+		name:  "driftctl_unmanaged_resources",
+		help:  "Number of resources not managed by IaC",
+		value: func(s analyser.Summary) float64 { return float64(s.TotalUnmanaged) },
+	},
+	{
+		name:  "driftctl_missing_resources",
+		help:  "Number of resources found in IaC but missing on the remote",
+		value: func(s analyser.Summary) float64 { return float64(s.TotalDeleted) },
+	},
+	{
+		name:  "driftctl_changed_resources",
+		help:  "Number of managed resources that drifted from IaC",
+		value: func(s analyser.Summary) float64 { return float64(s.TotalDrifted) },
+	},
+	{
+		name: "driftctl_coverage_percent",
+		help: "Percentage of resources managed by IaC",
+		value: func(s analyser.Summary) float64 {
+			if s.TotalResources == 0 {
+				return 0
+			}
+			return float64(s.TotalManaged) / float64(s.TotalResources) * 100
+		},
+	},
+}
+
+// state is what metrics are computed from
+type state struct {
+	analysis     *analyser.Analysis
+	lastSuccess  time.Time
+	scans        int
+	failedScans  int
+	lastDuration time.Duration
+}
+
+// writeMetrics writes metrics of the state in the Prometheus text exposition format.
+// Gauges labelled by resource type are only written once an analysis succeeded.
+func writeMetrics(w io.Writer, s state) error {
+	b := &strings.Builder{}
+
+	if s.analysis != nil {
+		summaries := s.analysis.SummaryByType()
+		types := make([]string, 0, len(summaries))
+		for typ := range summaries {
+			types = append(types, typ)
+		}
+		sort.Strings(types)
+
+		for _, g := range typeGauges {
+			writeHeader(b, g.name, g.help, "gauge")
+			for _, typ := range types {
+				fmt.Fprintf(b, "%s{type=%q} %s\n", g.name, typ, formatValue(g.value(summaries[typ])))
+			}
+		}
+		writeHeader(b, "driftctl_last_success_timestamp_seconds", "Time of the last successful scan", "gauge")
+		fmt.Fprintf(b, "driftctl_last_success_timestamp_seconds %d\n", s.lastSuccess.Unix())
+	}
+
+	writeHeader(b, "driftctl_scan_duration_seconds", "Duration of the last scan", "gauge")
+	fmt.Fprintf(b, "driftctl_scan_duration_seconds %s\n", formatValue(s.lastDuration.Seconds()))
+	writeHeader(b, "driftctl_scans_total", "Number of scans run", "counter")
+	fmt.Fprintf(b, "driftctl_scans_total %d\n", s.scans)
+	writeHeader(b, "driftctl_scan_errors_total", "Number of scans that failed", "counter")
+	fmt.Fprintf(b, "driftctl_scan_errors_total %d\n", s.failedScans)
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeHeader(b *strings.Builder, name, help, kind string) {
+	fmt.Fprintf(b, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(b, "# TYPE %s %s\n", name, kind)
+}
+
+func formatValue(value float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.4f", value), "0"), ".")
+}
@@ -0,0 +1,144 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/cloudskiff/driftctl/pkg/analyser"
+)
+
+// Scanner runs a scan, each call returns a new analysis
+type Scanner interface {
+	Scan(ctx context.Context) (*analyser.Analysis, error)
+}
+
+// Watcher scans on an interval and keeps the last analysis to serve it over HTTP
+type Watcher struct {
+	scanner  Scanner
+	interval time.Duration
+	// Timeout of each scan, zero means no timeout
+	timeout time.Duration
+
+	lock    sync.RWMutex
+	state   state
+	lastErr error
+}
+
+func NewWatcher(scanner Scanner, interval, timeout time.Duration) *Watcher {
+	return &Watcher{
+		scanner:  scanner,
+		interval: interval,
+		timeout:  timeout,
+	}
+}
+
+// Run scans immediately then on every interval until ctx is cancelled.
+// A failed scan does not stop the watcher, the error is reported by /healthz until a scan succeeds.
+func (w *Watcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.RunOnce(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce runs a single scan and records its result
+func (w *Watcher) RunOnce(ctx context.Context) {
+	if w.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	analysis, err := w.scanner.Scan(ctx)
+	duration := time.Since(start)
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.state.scans++
+	w.state.lastDuration = duration
+	w.lastErr = err
+	if err != nil {
+		w.state.failedScans++
+		logrus.WithField("duration", duration).Errorf("Scan failed: %s", err)
+		return
+	}
+	w.state.analysis = analysis
+	w.state.lastSuccess = time.Now()
+	logrus.WithFields(logrus.Fields{
+		"duration":  duration,
+		"managed":   analysis.Summary().TotalManaged,
+		"unmanaged": analysis.Summary().TotalUnmanaged,
+		"missing":   analysis.Summary().TotalDeleted,
+		"changed":   analysis.Summary().TotalDrifted,
+	}).Info("Scan done")
+}
+
+// Handler serves /metrics, /healthz and /last-analysis
+func (w *Watcher) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", w.serveMetrics)
+	mux.HandleFunc("/healthz", w.serveHealth)
+	mux.HandleFunc("/last-analysis", w.serveLastAnalysis)
+	return mux
+}
+
+func (w *Watcher) serveMetrics(rw http.ResponseWriter, _ *http.Request) {
+	w.lock.RLock()
+	s := w.state
+	w.lock.RUnlock()
+
+	rw.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := writeMetrics(rw, s); err != nil {
+		logrus.WithField("err", err).Debug("Unable to write metrics")
+	}
+}
+
+// serveHealth reports whether the last scan succeeded, the watcher is not healthy before its first scan
+func (w *Watcher) serveHealth(rw http.ResponseWriter, _ *http.Request) {
+	w.lock.RLock()
+	scans, lastErr := w.state.scans, w.lastErr
+	w.lock.RUnlock()
+
+	switch {
+	case scans == 0:
+		http.Error(rw, "no scan done yet", http.StatusServiceUnavailable)
+	case lastErr != nil:
+		http.Error(rw, lastErr.Error(), http.StatusServiceUnavailable)
+	default:
+		_, _ = rw.Write([]byte("ok\n"))
+	}
+}
+
+// serveLastAnalysis writes the last successful analysis in the JSON output format
+func (w *Watcher) serveLastAnalysis(rw http.ResponseWriter, _ *http.Request) {
+	w.lock.RLock()
+	analysis := w.state.analysis
+	w.lock.RUnlock()
+
+	if analysis == nil {
+		http.Error(rw, "no analysis available yet", http.StatusNotFound)
+		return
+	}
+
+	content, err := json.MarshalIndent(analysis, "", "\t")
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_, _ = rw.Write(content)
+}
@@ -0,0 +1,117 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudskiff/driftctl/pkg/analyser"
+	"github.com/cloudskiff/driftctl/pkg/resource"
+)
+
+type fakeScanner struct {
+	results []error
+	calls   int
+}
+
+func (s *fakeScanner) Scan(ctx context.Context) (*analyser.Analysis, error) {
+	err := s.results[s.calls%len(s.results)]
+	s.calls++
+	if err != nil {
+		return nil, err
+	}
+	res := func(typ, id string) *resource.AbstractResource {
+		return &resource.AbstractResource{Id: id, Type: typ}
+	}
+	analysis := &analyser.Analysis{}
+	analysis.AddManaged(res("aws_s3_bucket", "managed"), res("aws_s3_bucket", "drifted"), res("aws_iam_user", "user"))
+	analysis.AddDifference(analyser.Difference{Res: res("aws_s3_bucket", "drifted")})
+	analysis.AddUnmanaged(res("aws_s3_bucket", "unmanaged"))
+	analysis.AddDeleted(res("aws_iam_user", "deleted"))
+	return analysis, nil
+}
+
+func get(t *testing.T, handler http.Handler, path string) (int, string) {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	body, err := io.ReadAll(rec.Result().Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rec.Code, string(body)
+}
+
+func TestWatcher_Handler(t *testing.T) {
+	scanner := &fakeScanner{results: []error{nil, errors.New("remote unreachable")}}
+	w := NewWatcher(scanner, time.Minute, 0)
+	handler := w.Handler()
+
+	code, _ := get(t, handler, "/healthz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	code, _ = get(t, handler, "/last-analysis")
+	assert.Equal(t, http.StatusNotFound, code)
+	code, body := get(t, handler, "/metrics")
+	assert.Equal(t, http.StatusOK, code)
+	assert.NotContains(t, body, "driftctl_managed_resources")
+	assert.Contains(t, body, "driftctl_scans_total 0\n")
+
+	w.RunOnce(context.Background())
+
+	code, body = get(t, handler, "/healthz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ok\n", body)
+
+	code, body = get(t, handler, "/last-analysis")
+	assert.Equal(t, http.StatusOK, code)
+	analysis := &analyser.Analysis{}
+	assert.Nil(t, json.Unmarshal([]byte(body), analysis))
+	assert.Equal(t, 5, analysis.Summary().TotalResources)
+
+	_, body = get(t, handler, "/metrics")
+	for _, line := range []string{
+		"# TYPE driftctl_managed_resources gauge",
+		`driftctl_managed_resources{type="aws_iam_user"} 1`,
+		`driftctl_managed_resources{type="aws_s3_bucket"} 2`,
+		`driftctl_unmanaged_resources{type="aws_s3_bucket"} 1`,
+		`driftctl_missing_resources{type="aws_iam_user"} 1`,
+		`driftctl_changed_resources{type="aws_s3_bucket"} 1`,
+		`driftctl_coverage_percent{type="aws_iam_user"} 50`,
+		`driftctl_coverage_percent{type="aws_s3_bucket"} 66.6667`,
+		"driftctl_scans_total 1",
+		"driftctl_scan_errors_total 0",
+	} {
+		assert.Contains(t, strings.Split(body, "\n"), line)
+	}
+
+	// A failed scan keeps the last analysis but makes the watcher unhealthy
+	w.RunOnce(context.Background())
+
+	code, body = get(t, handler, "/healthz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "remote unreachable\n", body)
+	code, _ = get(t, handler, "/last-analysis")
+	assert.Equal(t, http.StatusOK, code)
+	_, body = get(t, handler, "/metrics")
+	assert.Contains(t, body, "driftctl_scans_total 2\n")
+	assert.Contains(t, body, "driftctl_scan_errors_total 1\n")
+	assert.Contains(t, body, `driftctl_managed_resources{type="aws_s3_bucket"} 2`)
+}
+
+func TestWatcher_Run(t *testing.T) {
+	scanner := &fakeScanner{results: []error{nil}}
+	w := NewWatcher(scanner, time.Millisecond, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	assert.Equal(t, context.DeadlineExceeded, w.Run(ctx))
+	assert.Greater(t, scanner.calls, 1)
+}